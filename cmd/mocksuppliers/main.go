@@ -0,0 +1,85 @@
+// Command mocksuppliers serves the Acme, Patagonia and Paperflies payloads locally, following a scenario,
+// so that the application can be run without the live supplier API:
+//
+//	go run ./cmd/mocksuppliers -scenario flaky
+//	HOTELS_SUPPLIERS_URLS_ACME=http://127.0.0.1:9000/suppliers/acme ... go run main.go
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"hotelsDataMerge/internal/lifecycle"
+	"hotelsDataMerge/internal/mocksuppliers"
+	"hotelsDataMerge/internal/suppliers/utils"
+)
+
+const shutdownTimeout = 5 * time.Second
+
+func main() {
+	os.Exit(run(os.Args[1:]))
+}
+
+func run(args []string) int {
+	flags := flag.NewFlagSet("mocksuppliers", flag.ContinueOnError)
+	address := flags.String("address", "127.0.0.1:9000", "address to listen on")
+	scenarioName := flags.String("scenario", "healthy", fmt.Sprintf("built-in scenario (%s) or YAML scenario file", strings.Join(scenarioNames(), ", ")))
+	fixturesDir := flags.String("fixtures", "", "directory of <supplier>.json payloads (default the bundled fixtures)")
+	verbose := flags.Bool("v", false, "log every request")
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return lifecycle.ExitOK
+		}
+		return lifecycle.ExitConfig
+	}
+	scenario, err := mocksuppliers.LoadScenario(*scenarioName)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Invalid scenario:", err)
+		return lifecycle.ExitConfig
+	}
+	fixtures := mocksuppliers.Fixtures()
+	if len(*fixturesDir) > 0 {
+		fixtures = os.DirFS(*fixturesDir)
+	}
+
+	level := slog.LevelInfo
+	if *verbose {
+		level = slog.LevelDebug
+	}
+	logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: level}))
+	lis, err := net.Listen("tcp", *address)
+	if err != nil {
+		logger.Error("Failed to listen", "address", *address, "error", err)
+		return lifecycle.ExitFailure
+	}
+
+	app := lifecycle.Initialize(logger, shutdownTimeout)
+	svr := &http.Server{
+		Handler:           mocksuppliers.Initialize(logger, fixtures, scenario),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	app.Add(lifecycle.HTTPServer("mock suppliers", svr, lis))
+	for _, supplierName := range utils.SupplierPriority {
+		logger.Info("[MockSuppliers] Serving supplier", "supplier", supplierName, "url", fmt.Sprintf("http://%s/suppliers/%s", lis.Addr(), supplierName))
+	}
+	logger.Info("[MockSuppliers] Scenario set", "scenario", scenario.Name)
+	return lifecycle.ExitCode(app.Run(context.Background()))
+}
+
+func scenarioNames() []string {
+	names := make([]string, 0, len(mocksuppliers.Scenarios))
+	for name := range mocksuppliers.Scenarios {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
@@ -1,19 +1,56 @@
 package external
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
 	"sync"
+	"time"
+
+	"hotelsDataMerge/internal/suppliers/utils"
+	"hotelsDataMerge/internal/tracing"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.34.0"
+	"go.opentelemetry.io/otel/trace"
 )
 
 var (
 	FetchSuppliersMutex sync.RWMutex
 )
 
-func (e *externalHandler) GetSuppliersRawInfo(supplierURL string) (json.RawMessage, error) {
-	resp, err := http.Get(supplierURL)
+var tracer = otel.Tracer("hotelsDataMerge/external")
+
+func (e *externalHandler) GetSuppliersRawInfo(ctx context.Context, supplierName utils.Suppliers) (respRawData json.RawMessage, err error) {
+	supplierURL, ok := e.suppliersURLMap[supplierName]
+	if !ok {
+		return nil, fmt.Errorf("unknown supplier %q", supplierName)
+	}
+	ctx, span := tracer.Start(ctx, "external.GetSuppliersRawInfo",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("supplier", string(supplierName)),
+			semconv.HTTPRequestMethodGet,
+			semconv.URLFull(supplierURL),
+		),
+	)
+	defer func() { tracing.EndSpan(span, err) }()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, supplierURL, nil)
 	if err != nil {
+		e.logger.Error("[suppliers] Error in creating the suppliers request", "error", err)
+		return nil, err
+	}
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+	startedAt := time.Now()
+	resp, err := e.client.Do(req)
+	if err != nil {
+		e.metrics.ObserveSupplierResponse(string(supplierName), 0, 0, time.Since(startedAt))
 		e.logger.Error("[suppliers] Error in getting the suppliers info", "error", err)
 		return nil, err
 	}
@@ -26,12 +63,17 @@ func (e *externalHandler) GetSuppliersRawInfo(supplierURL string) (json.RawMessa
 	}(resp.Body)
 
 	respBody, err := io.ReadAll(resp.Body)
+	e.metrics.ObserveSupplierResponse(string(supplierName), resp.StatusCode, len(respBody), time.Since(startedAt))
+	span.SetAttributes(semconv.HTTPResponseStatusCode(resp.StatusCode), semconv.HTTPResponseBodySize(len(respBody)))
 	if err != nil {
 		e.logger.Error("[suppliers] Error in reading the response body", "error", err)
 		return nil, err
 	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		e.logger.Error("[suppliers] Unexpected response status", "supplier", supplierName, "status", resp.StatusCode)
+		return nil, fmt.Errorf("%w %d", ErrUnexpectedStatus, resp.StatusCode)
+	}
 
-	var respRawData json.RawMessage
 	if err := json.Unmarshal(respBody, &respRawData); err != nil {
 		e.logger.Error("[suppliers] Error in unmarshalling the response body", "error", err)
 		return nil, err
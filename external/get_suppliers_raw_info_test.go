@@ -1,12 +1,15 @@
 package external
 
 import (
+	"context"
 	"encoding/json"
 	"log/slog"
 	"net/http"
 	"net/http/httptest"
 	"reflect"
 	"testing"
+
+	"hotelsDataMerge/internal/suppliers/utils"
 )
 
 func Test_externalHandler_GetSuppliersRawInfo(t *testing.T) {
@@ -14,7 +17,8 @@ func Test_externalHandler_GetSuppliersRawInfo(t *testing.T) {
 		logger *slog.Logger
 	}
 	type args struct {
-		supplierURL string
+		supplierName utils.Suppliers
+		supplierURL  string
 	}
 	tests := []struct {
 		name        string
@@ -30,7 +34,7 @@ func Test_externalHandler_GetSuppliersRawInfo(t *testing.T) {
 				logger: slog.Default(),
 			},
 			args: args{
-				supplierURL: "",
+				supplierName: utils.Acme,
 			},
 			want:    json.RawMessage(`{"hotels":[{"id":"hotel1","name":"Test Hotel"}]}`),
 			wantErr: false,
@@ -49,7 +53,7 @@ func Test_externalHandler_GetSuppliersRawInfo(t *testing.T) {
 				logger: slog.Default(),
 			},
 			args: args{
-				supplierURL: "",
+				supplierName: utils.Acme,
 			},
 			want:    json.RawMessage(`{}`),
 			wantErr: false,
@@ -68,7 +72,7 @@ func Test_externalHandler_GetSuppliersRawInfo(t *testing.T) {
 				logger: slog.Default(),
 			},
 			args: args{
-				supplierURL: "",
+				supplierName: utils.Acme,
 			},
 			want:    json.RawMessage(`[{"id":"hotel1"},{"id":"hotel2"}]`),
 			wantErr: false,
@@ -87,7 +91,7 @@ func Test_externalHandler_GetSuppliersRawInfo(t *testing.T) {
 				logger: slog.Default(),
 			},
 			args: args{
-				supplierURL: "",
+				supplierName: utils.Acme,
 			},
 			want:    nil,
 			wantErr: true,
@@ -101,17 +105,18 @@ func Test_externalHandler_GetSuppliersRawInfo(t *testing.T) {
 			},
 		},
 		{
-			name: "Success - HTTP error response still returns valid JSON",
+			name: "Error - 5xx response with a JSON body",
 			fields: fields{
 				logger: slog.Default(),
 			},
 			args: args{
-				supplierURL: "",
+				supplierName: utils.Acme,
 			},
-			want:    json.RawMessage(`{"error":"Internal Server Error"}`),
-			wantErr: false,
+			want:    nil,
+			wantErr: true,
 			setupServer: func() (*httptest.Server, string) {
 				server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					w.Header().Set("Content-Type", "application/json")
 					w.WriteHeader(http.StatusInternalServerError)
 					_, _ = w.Write([]byte(`{"error":"Internal Server Error"}`))
 				}))
@@ -124,7 +129,20 @@ func Test_externalHandler_GetSuppliersRawInfo(t *testing.T) {
 				logger: slog.Default(),
 			},
 			args: args{
-				supplierURL: "invalid://url",
+				supplierName: utils.Acme,
+				supplierURL:  "invalid://url",
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "Error - Unknown supplier",
+			fields: fields{
+				logger: slog.Default(),
+			},
+			args: args{
+				supplierName: utils.Suppliers("unknown"),
+				supplierURL:  "http://localhost/unknown",
 			},
 			want:    nil,
 			wantErr: true,
@@ -142,9 +160,11 @@ func Test_externalHandler_GetSuppliersRawInfo(t *testing.T) {
 			}
 
 			e := &externalHandler{
-				logger: tt.fields.logger,
+				logger:          tt.fields.logger,
+				client:          http.DefaultClient,
+				suppliersURLMap: map[utils.Suppliers]string{utils.Acme: tt.args.supplierURL},
 			}
-			got, err := e.GetSuppliersRawInfo(tt.args.supplierURL)
+			got, err := e.GetSuppliersRawInfo(context.Background(), tt.args.supplierName)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("GetSuppliersRawInfo() error = %v, wantErr %v", err, tt.wantErr)
 				return
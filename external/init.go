@@ -1,33 +1,59 @@
 package external
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"log/slog"
+	"maps"
+	"net/http"
+	"time"
 
+	"hotelsDataMerge/internal/metrics"
 	"hotelsDataMerge/internal/suppliers/utils"
 )
 
-var suppliersURLMap = map[utils.Suppliers]string{
+var defaultSuppliersURLMap = map[utils.Suppliers]string{
 	utils.Acme:       "https://5f2be0b4ffc88500167b85a0.mockapi.io/suppliers/acme",
 	utils.Patagonia:  "https://5f2be0b4ffc88500167b85a0.mockapi.io/suppliers/patagonia",
 	utils.Paperflies: "https://5f2be0b4ffc88500167b85a0.mockapi.io/suppliers/paperflies",
 }
 
+// ErrUnexpectedStatus is returned when a supplier answers with a status other than 2xx
+var ErrUnexpectedStatus = errors.New("unexpected supplier response status")
+
 type ExtSuppliers interface {
-	GetSuppliersRawInfo(supplierURL string) (json.RawMessage, error)
+	GetSuppliersRawInfo(ctx context.Context, supplierName utils.Suppliers) (json.RawMessage, error)
 }
 
 type externalHandler struct {
-	logger *slog.Logger
+	logger          *slog.Logger
+	metrics         *metrics.Metrics
+	client          *http.Client
+	suppliersURLMap map[utils.Suppliers]string
+}
+
+type Options struct {
+	// SupplierURLs overrides the URL of the given suppliers
+	SupplierURLs map[utils.Suppliers]string
+	// Timeout bounds each supplier request; 0 means no timeout
+	Timeout time.Duration
 }
 
-func Initialize(logger *slog.Logger) ExtSuppliers {
+// Initialize returns the suppliers client, which calls the supplier URLs given in options instead of the defaults
+func Initialize(logger *slog.Logger, appMetrics *metrics.Metrics, options Options) ExtSuppliers {
 	extHandler := &externalHandler{
-		logger: logger,
+		logger:          logger,
+		metrics:         appMetrics,
+		client:          &http.Client{Timeout: options.Timeout},
+		suppliersURLMap: SuppliersURLMap(options.SupplierURLs),
 	}
 	return extHandler
 }
 
-func GetSuppliersURLMap() map[utils.Suppliers]string {
+// SuppliersURLMap returns the URL of every supplier, the default ones replaced by the given overrides
+func SuppliersURLMap(overrides map[utils.Suppliers]string) map[utils.Suppliers]string {
+	suppliersURLMap := maps.Clone(defaultSuppliersURLMap)
+	maps.Copy(suppliersURLMap, overrides)
 	return suppliersURLMap
 }
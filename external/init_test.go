@@ -2,30 +2,45 @@ package external
 
 import (
 	"log/slog"
+	"net/http"
 	"reflect"
 	"testing"
+	"time"
 
 	"hotelsDataMerge/internal/suppliers/utils"
 )
 
-func TestGetSuppliersURLMap(t *testing.T) {
+func TestSuppliersURLMap(t *testing.T) {
 	tests := []struct {
-		name string
-		want map[utils.Suppliers]string
+		name      string
+		overrides map[utils.Suppliers]string
+		want      map[utils.Suppliers]string
 	}{
 		{
-			name: "Success - Get suppliers URL map",
+			name: "Success - Default suppliers URL map",
 			want: map[utils.Suppliers]string{
 				utils.Acme:       "https://5f2be0b4ffc88500167b85a0.mockapi.io/suppliers/acme",
 				utils.Patagonia:  "https://5f2be0b4ffc88500167b85a0.mockapi.io/suppliers/patagonia",
 				utils.Paperflies: "https://5f2be0b4ffc88500167b85a0.mockapi.io/suppliers/paperflies",
 			},
 		},
+		{
+			name:      "Success - Overridden supplier URL",
+			overrides: map[utils.Suppliers]string{utils.Acme: "http://localhost:9000/acme"},
+			want: map[utils.Suppliers]string{
+				utils.Acme:       "http://localhost:9000/acme",
+				utils.Patagonia:  "https://5f2be0b4ffc88500167b85a0.mockapi.io/suppliers/patagonia",
+				utils.Paperflies: "https://5f2be0b4ffc88500167b85a0.mockapi.io/suppliers/paperflies",
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := GetSuppliersURLMap(); !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("GetSuppliersURLMap() = %v, want %v", got, tt.want)
+			if got := SuppliersURLMap(tt.overrides); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SuppliersURLMap() = %v, want %v", got, tt.want)
+			}
+			if defaultSuppliersURLMap[utils.Acme] != "https://5f2be0b4ffc88500167b85a0.mockapi.io/suppliers/acme" {
+				t.Errorf("SuppliersURLMap() changed the default URLs to %v", defaultSuppliersURLMap)
 			}
 		})
 	}
@@ -33,7 +48,8 @@ func TestGetSuppliersURLMap(t *testing.T) {
 
 func TestInitialize(t *testing.T) {
 	type args struct {
-		logger *slog.Logger
+		logger  *slog.Logger
+		options Options
 	}
 	tests := []struct {
 		name string
@@ -46,7 +62,28 @@ func TestInitialize(t *testing.T) {
 				logger: slog.Default(),
 			},
 			want: &externalHandler{
+				logger:          slog.Default(),
+				client:          &http.Client{},
+				suppliersURLMap: defaultSuppliersURLMap,
+			},
+		},
+		{
+			name: "Success - Initialize with supplier URL and timeout",
+			args: args{
 				logger: slog.Default(),
+				options: Options{
+					SupplierURLs: map[utils.Suppliers]string{utils.Acme: "http://localhost:9000/acme"},
+					Timeout:      5 * time.Second,
+				},
+			},
+			want: &externalHandler{
+				logger: slog.Default(),
+				client: &http.Client{Timeout: 5 * time.Second},
+				suppliersURLMap: map[utils.Suppliers]string{
+					utils.Acme:       "http://localhost:9000/acme",
+					utils.Patagonia:  "https://5f2be0b4ffc88500167b85a0.mockapi.io/suppliers/patagonia",
+					utils.Paperflies: "https://5f2be0b4ffc88500167b85a0.mockapi.io/suppliers/paperflies",
+				},
 			},
 		},
 		{
@@ -55,13 +92,15 @@ func TestInitialize(t *testing.T) {
 				logger: nil,
 			},
 			want: &externalHandler{
-				logger: nil,
+				logger:          nil,
+				client:          &http.Client{},
+				suppliersURLMap: defaultSuppliersURLMap,
 			},
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := Initialize(tt.args.logger); !reflect.DeepEqual(got, tt.want) {
+			if got := Initialize(tt.args.logger, nil, tt.args.options); !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("Initialize() = %v, want %v", got, tt.want)
 			}
 		})
@@ -0,0 +1,121 @@
+package auth
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"fmt"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// tokenClaims accepts scopes both as the space-separated scope claim and as the scp array
+type tokenClaims struct {
+	jwt.RegisteredClaims
+	ClientID string   `json:"client_id"`
+	Scope    string   `json:"scope"`
+	Scp      []string `json:"scp"`
+}
+
+func (a *intAuthenticator) Authenticate(credentials Credentials) (Principal, error) {
+	switch {
+	case len(credentials.APIKey) > 0:
+		return a.authenticateAPIKey(credentials.APIKey)
+	case len(credentials.BearerToken) > 0:
+		return a.authenticateJWT(credentials.BearerToken)
+	default:
+		return Principal{}, ErrNoCredentials
+	}
+}
+
+func (a *intAuthenticator) authenticateAPIKey(key string) (Principal, error) {
+	hash := sha256.Sum256([]byte(key))
+	for _, apiKey := range a.apiKeys {
+		if subtle.ConstantTimeCompare(hash[:], apiKey.hash) == 1 {
+			return Principal{
+				ClientID: apiKey.clientID,
+				Method:   MethodAPIKey,
+				Scopes:   apiKey.scopes,
+			}, nil
+		}
+	}
+	return Principal{}, fmt.Errorf("%w: unknown api key", ErrInvalidCredentials)
+}
+
+func (a *intAuthenticator) authenticateJWT(rawToken string) (Principal, error) {
+	if a.jwt == nil {
+		return Principal{}, fmt.Errorf("%w: bearer tokens are not accepted", ErrInvalidCredentials)
+	}
+
+	options := []jwt.ParserOption{
+		jwt.WithValidMethods(a.jwt.validMethods()),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(a.jwt.leeway),
+		jwt.WithTimeFunc(a.now),
+	}
+	if len(a.jwt.issuer) > 0 {
+		options = append(options, jwt.WithIssuer(a.jwt.issuer))
+	}
+	if len(a.jwt.audience) > 0 {
+		options = append(options, jwt.WithAudience(a.jwt.audience))
+	}
+
+	claims := &tokenClaims{}
+	if _, err := jwt.NewParser(options...).ParseWithClaims(rawToken, claims, a.jwt.key); err != nil {
+		return Principal{}, fmt.Errorf("%w: %s", ErrInvalidCredentials, err)
+	}
+
+	clientID := claims.ClientID
+	if len(clientID) == 0 {
+		clientID = claims.Subject
+	}
+	if len(clientID) == 0 {
+		return Principal{}, fmt.Errorf("%w: token has no client_id or sub claim", ErrInvalidCredentials)
+	}
+	return Principal{
+		ClientID: clientID,
+		Method:   MethodJWT,
+		Scopes:   append(strings.Fields(claims.Scope), claims.Scp...),
+	}, nil
+}
+
+func (v *jwtValidator) validMethods() []string {
+	var methods []string
+	if len(v.secret) > 0 {
+		methods = append(methods, jwt.SigningMethodHS256.Alg())
+	}
+	if len(v.rsaKeys) > 0 {
+		methods = append(methods, jwt.SigningMethodRS256.Alg())
+	}
+	return methods
+}
+
+// key returns the key that verifies the token: the HS256 secret, or the RS256 key named by its kid.
+// A token without kid is accepted when the key set has a single key.
+func (v *jwtValidator) key(token *jwt.Token) (any, error) {
+	if token.Method.Alg() == jwt.SigningMethodHS256.Alg() {
+		return v.secret, nil
+	}
+	kid, _ := token.Header["kid"].(string)
+	if len(kid) == 0 && len(v.rsaKeys) == 1 {
+		for _, key := range v.rsaKeys {
+			return key, nil
+		}
+	}
+	key, ok := v.rsaKeys[kid]
+	if !ok {
+		return nil, fmt.Errorf("unknown key id %q", kid)
+	}
+	return key, nil
+}
+
+func (a *intAuthenticator) Methods() []Method {
+	var methods []Method
+	if len(a.apiKeys) > 0 {
+		methods = append(methods, MethodAPIKey)
+	}
+	if a.jwt != nil {
+		methods = append(methods, MethodJWT)
+	}
+	return methods
+}
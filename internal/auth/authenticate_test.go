@@ -0,0 +1,196 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	testAPIKey = "partner-a-key"
+	testSecret = "hs256-test-secret"
+	testKid    = "key-1"
+)
+
+var testNow = time.Date(2026, 10, 19, 8, 0, 0, 0, time.UTC)
+
+func sha256Hex(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
+
+func newTestAuthenticator(t *testing.T, rsaKey *rsa.PrivateKey) *intAuthenticator {
+	t.Helper()
+	authenticator, err := Initialize(Config{
+		APIKeys: []APIKeyConfig{
+			{ClientID: "partner-a", KeySHA256: sha256Hex(testAPIKey), Scopes: []string{ScopeHotelsRead}},
+		},
+		JWT: &JWTConfig{
+			Issuer:      "https://auth.example.com",
+			Audience:    "hotels-api",
+			HS256Secret: testSecret,
+			JWKSFile:    writeJWKS(t, map[string]*rsa.PublicKey{testKid: &rsaKey.PublicKey}),
+		},
+	})
+	if err != nil {
+		t.Fatalf("Initialize() error = %v", err)
+	}
+	a := authenticator.(*intAuthenticator)
+	a.now = func() time.Time { return testNow }
+	return a
+}
+
+func signToken(t *testing.T, method jwt.SigningMethod, key any, kid string, claims jwt.MapClaims) string {
+	t.Helper()
+	token := jwt.NewWithClaims(method, claims)
+	if len(kid) > 0 {
+		token.Header["kid"] = kid
+	}
+	signed, err := token.SignedString(key)
+	if err != nil {
+		t.Fatalf("SignedString() error = %v", err)
+	}
+	return signed
+}
+
+func validClaims(overrides jwt.MapClaims) jwt.MapClaims {
+	claims := jwt.MapClaims{
+		"iss":   "https://auth.example.com",
+		"aud":   "hotels-api",
+		"sub":   "partner-b",
+		"exp":   testNow.Add(time.Hour).Unix(),
+		"scope": "hotels:read admin:read",
+	}
+	for key, value := range overrides {
+		if value == nil {
+			delete(claims, key)
+			continue
+		}
+		claims[key] = value
+	}
+	return claims
+}
+
+func Test_intAuthenticator_Authenticate(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("GenerateKey() error = %v", err)
+	}
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("GenerateKey() error = %v", err)
+	}
+	a := newTestAuthenticator(t, rsaKey)
+
+	tests := []struct {
+		name        string
+		credentials Credentials
+		want        Principal
+		wantErr     error
+	}{
+		{
+			name:        "Success - API key",
+			credentials: Credentials{APIKey: testAPIKey},
+			want:        Principal{ClientID: "partner-a", Method: MethodAPIKey, Scopes: []string{ScopeHotelsRead}},
+		},
+		{
+			name:        "Success - HS256 token",
+			credentials: Credentials{BearerToken: signToken(t, jwt.SigningMethodHS256, []byte(testSecret), "", validClaims(nil))},
+			want:        Principal{ClientID: "partner-b", Method: MethodJWT, Scopes: []string{ScopeHotelsRead, ScopeAdminRead}},
+		},
+		{
+			name:        "Success - RS256 token with kid and scp claim",
+			credentials: Credentials{BearerToken: signToken(t, jwt.SigningMethodRS256, rsaKey, testKid, validClaims(jwt.MapClaims{"scope": nil, "scp": []string{ScopeAdminRefresh}}))},
+			want:        Principal{ClientID: "partner-b", Method: MethodJWT, Scopes: []string{ScopeAdminRefresh}},
+		},
+		{
+			name:        "Success - client_id claim takes precedence over sub",
+			credentials: Credentials{BearerToken: signToken(t, jwt.SigningMethodHS256, []byte(testSecret), "", validClaims(jwt.MapClaims{"client_id": "partner-c"}))},
+			want:        Principal{ClientID: "partner-c", Method: MethodJWT, Scopes: []string{ScopeHotelsRead, ScopeAdminRead}},
+		},
+		{
+			name:        "Error - No credentials",
+			credentials: Credentials{},
+			wantErr:     ErrNoCredentials,
+		},
+		{
+			name:        "Error - Unknown API key",
+			credentials: Credentials{APIKey: "guess"},
+			wantErr:     ErrInvalidCredentials,
+		},
+		{
+			name:        "Error - Expired token",
+			credentials: Credentials{BearerToken: signToken(t, jwt.SigningMethodHS256, []byte(testSecret), "", validClaims(jwt.MapClaims{"exp": testNow.Add(-time.Hour).Unix()}))},
+			wantErr:     ErrInvalidCredentials,
+		},
+		{
+			name:        "Error - Token without expiry",
+			credentials: Credentials{BearerToken: signToken(t, jwt.SigningMethodHS256, []byte(testSecret), "", validClaims(jwt.MapClaims{"exp": nil}))},
+			wantErr:     ErrInvalidCredentials,
+		},
+		{
+			name:        "Error - Wrong audience",
+			credentials: Credentials{BearerToken: signToken(t, jwt.SigningMethodHS256, []byte(testSecret), "", validClaims(jwt.MapClaims{"aud": "other-api"}))},
+			wantErr:     ErrInvalidCredentials,
+		},
+		{
+			name:        "Error - Wrong issuer",
+			credentials: Credentials{BearerToken: signToken(t, jwt.SigningMethodHS256, []byte(testSecret), "", validClaims(jwt.MapClaims{"iss": "https://evil.example.com"}))},
+			wantErr:     ErrInvalidCredentials,
+		},
+		{
+			name:        "Error - Wrong HS256 secret",
+			credentials: Credentials{BearerToken: signToken(t, jwt.SigningMethodHS256, []byte("guess"), "", validClaims(nil))},
+			wantErr:     ErrInvalidCredentials,
+		},
+		{
+			name:        "Error - RS256 token signed with an unknown key",
+			credentials: Credentials{BearerToken: signToken(t, jwt.SigningMethodRS256, otherKey, testKid, validClaims(nil))},
+			wantErr:     ErrInvalidCredentials,
+		},
+		{
+			name:        "Error - RS256 token with unknown kid",
+			credentials: Credentials{BearerToken: signToken(t, jwt.SigningMethodRS256, rsaKey, "key-2", validClaims(nil))},
+			wantErr:     ErrInvalidCredentials,
+		},
+		{
+			name:        "Error - Algorithm not allowed",
+			credentials: Credentials{BearerToken: signToken(t, jwt.SigningMethodHS384, []byte(testSecret), "", validClaims(nil))},
+			wantErr:     ErrInvalidCredentials,
+		},
+		{
+			name:        "Error - Malformed token",
+			credentials: Credentials{BearerToken: "not-a-jwt"},
+			wantErr:     ErrInvalidCredentials,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := a.Authenticate(tt.credentials)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Authenticate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Authenticate() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_intAuthenticator_Authenticate_NoJWT(t *testing.T) {
+	a, err := Initialize(Config{})
+	if err != nil {
+		t.Fatalf("Initialize() error = %v", err)
+	}
+	token := signToken(t, jwt.SigningMethodHS256, []byte(testSecret), "", validClaims(nil))
+	if _, err := a.Authenticate(Credentials{BearerToken: token}); !errors.Is(err, ErrInvalidCredentials) {
+		t.Errorf("Authenticate() error = %v, wantErr %v", err, ErrInvalidCredentials)
+	}
+}
@@ -0,0 +1,48 @@
+package auth
+
+import (
+	"context"
+	"slices"
+)
+
+const (
+	ScopeHotelsRead   = "hotels:read"
+	ScopeAdminRead    = "admin:read"
+	ScopeAdminRefresh = "admin:refresh"
+)
+
+type Method string
+
+const (
+	MethodAPIKey Method = "api_key"
+	MethodJWT    Method = "jwt"
+)
+
+// Credentials are the credentials presented with a request; at most one of them is expected
+type Credentials struct {
+	APIKey      string
+	BearerToken string
+}
+
+// Principal is the authenticated client of a request
+type Principal struct {
+	ClientID string
+	Method   Method
+	Scopes   []string
+}
+
+func (p Principal) HasScope(scope string) bool {
+	return slices.Contains(p.Scopes, scope)
+}
+
+type principalKey struct{}
+
+func NewContext(ctx context.Context, principal Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+// FromContext returns the principal of the request being served, if it was authenticated
+func FromContext(ctx context.Context) (Principal, bool) {
+	principal, ok := ctx.Value(principalKey{}).(Principal)
+	return principal, ok
+}
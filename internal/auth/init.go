@@ -0,0 +1,121 @@
+package auth
+
+import (
+	"crypto/rsa"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+)
+
+var (
+	ErrNoCredentials      = errors.New("no credentials")
+	ErrInvalidCredentials = errors.New("invalid credentials")
+)
+
+// Config is the authentication config file
+type Config struct {
+	APIKeys []APIKeyConfig `json:"api_keys"`
+	JWT     *JWTConfig     `json:"jwt"`
+}
+
+type APIKeyConfig struct {
+	ClientID string `json:"client_id"`
+	// KeySHA256 is the hex SHA-256 of the key, so that the config file does not hold the keys themselves
+	KeySHA256 string   `json:"key_sha256"`
+	Scopes    []string `json:"scopes"`
+}
+
+type JWTConfig struct {
+	// Issuer and Audience are checked against the iss and aud claims when set
+	Issuer   string `json:"issuer"`
+	Audience string `json:"audience"`
+	// HS256Secret enables HS256 tokens
+	HS256Secret string `json:"hs256_secret"`
+	// JWKSFile is a local JSON Web Key Set whose RSA keys validate RS256 tokens
+	JWKSFile string `json:"jwks_file"`
+	// LeewaySeconds is the clock skew tolerated on exp, nbf and iat
+	LeewaySeconds int `json:"leeway_seconds"`
+}
+
+type IntAuthenticator interface {
+	// Authenticate returns the client the credentials belong to. It returns ErrNoCredentials
+	// when none are given and wraps ErrInvalidCredentials when they are not valid.
+	Authenticate(credentials Credentials) (Principal, error)
+	// Methods returns the configured authentication methods, API keys first
+	Methods() []Method
+}
+
+type intAuthenticator struct {
+	apiKeys []apiKey
+	jwt     *jwtValidator
+	now     func() time.Time
+}
+
+type apiKey struct {
+	clientID string
+	hash     []byte
+	scopes   []string
+}
+
+type jwtValidator struct {
+	issuer   string
+	audience string
+	secret   []byte
+	rsaKeys  map[string]*rsa.PublicKey
+	leeway   time.Duration
+}
+
+// LoadConfig reads the authentication config file
+func LoadConfig(path string) (Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Config{}, err
+	}
+	var config Config
+	if err := json.Unmarshal(data, &config); err != nil {
+		return Config{}, fmt.Errorf("invalid auth config %s: %w", path, err)
+	}
+	return config, nil
+}
+
+func Initialize(config Config) (IntAuthenticator, error) {
+	a := &intAuthenticator{now: time.Now}
+	for _, keyConfig := range config.APIKeys {
+		hash, err := hex.DecodeString(keyConfig.KeySHA256)
+		if err != nil || len(hash) != 32 {
+			return nil, fmt.Errorf("api key of client %q: key_sha256 must be a hex SHA-256", keyConfig.ClientID)
+		}
+		if len(keyConfig.ClientID) == 0 {
+			return nil, fmt.Errorf("api key %s has no client_id", keyConfig.KeySHA256)
+		}
+		a.apiKeys = append(a.apiKeys, apiKey{
+			clientID: keyConfig.ClientID,
+			hash:     hash,
+			scopes:   keyConfig.Scopes,
+		})
+	}
+
+	if config.JWT != nil {
+		validator := &jwtValidator{
+			issuer:   config.JWT.Issuer,
+			audience: config.JWT.Audience,
+			secret:   []byte(config.JWT.HS256Secret),
+			leeway:   time.Duration(config.JWT.LeewaySeconds) * time.Second,
+		}
+		if len(config.JWT.JWKSFile) > 0 {
+			rsaKeys, err := loadJWKS(config.JWT.JWKSFile)
+			if err != nil {
+				return nil, err
+			}
+			validator.rsaKeys = rsaKeys
+		}
+		if len(validator.secret) == 0 && len(validator.rsaKeys) == 0 {
+			return nil, fmt.Errorf("jwt needs an hs256_secret or a jwks_file")
+		}
+		a.jwt = validator
+	}
+	return a, nil
+}
@@ -0,0 +1,146 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func writeFile(t *testing.T, name string, data []byte) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	return path
+}
+
+func writeJWKS(t *testing.T, keys map[string]*rsa.PublicKey) string {
+	t.Helper()
+	set := jwks{}
+	for kid, key := range keys {
+		set.Keys = append(set.Keys, jwk{
+			Kty: "RSA",
+			Kid: kid,
+			Use: "sig",
+			Alg: "RS256",
+			N:   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		})
+	}
+	data, err := json.Marshal(set)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	return writeFile(t, "jwks.json", data)
+}
+
+func TestLoadConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    int
+		wantErr bool
+	}{
+		{
+			name:    "Success - Load API keys",
+			data:    `{"api_keys": [{"client_id": "partner-a", "key_sha256": "` + sha256Hex(testAPIKey) + `", "scopes": ["hotels:read"]}]}`,
+			want:    1,
+			wantErr: false,
+		},
+		{
+			name:    "Error - Invalid JSON",
+			data:    `{"api_keys": [`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := LoadConfig(writeFile(t, "auth.json", []byte(tt.data)))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("LoadConfig() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(got.APIKeys) != tt.want {
+				t.Errorf("LoadConfig() api keys = %d, want %d", len(got.APIKeys), tt.want)
+			}
+		})
+	}
+}
+
+func TestInitialize(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("GenerateKey() error = %v", err)
+	}
+	jwksPath := writeJWKS(t, map[string]*rsa.PublicKey{testKid: &rsaKey.PublicKey})
+
+	tests := []struct {
+		name        string
+		config      Config
+		wantMethods []Method
+		wantErr     bool
+	}{
+		{
+			name:    "Success - Empty config",
+			config:  Config{},
+			wantErr: false,
+		},
+		{
+			name:        "Success - JWKS file",
+			config:      Config{JWT: &JWTConfig{JWKSFile: jwksPath}},
+			wantMethods: []Method{MethodJWT},
+			wantErr:     false,
+		},
+		{
+			name: "Success - API keys and JWT",
+			config: Config{
+				APIKeys: []APIKeyConfig{{ClientID: "partner-a", KeySHA256: sha256Hex(testAPIKey)}},
+				JWT:     &JWTConfig{HS256Secret: "secret"},
+			},
+			wantMethods: []Method{MethodAPIKey, MethodJWT},
+			wantErr:     false,
+		},
+		{
+			name:    "Error - API key is not a SHA-256",
+			config:  Config{APIKeys: []APIKeyConfig{{ClientID: "partner-a", KeySHA256: testAPIKey}}},
+			wantErr: true,
+		},
+		{
+			name:    "Error - API key without client",
+			config:  Config{APIKeys: []APIKeyConfig{{KeySHA256: sha256Hex(testAPIKey)}}},
+			wantErr: true,
+		},
+		{
+			name:    "Error - JWT without keys",
+			config:  Config{JWT: &JWTConfig{Issuer: "https://auth.example.com"}},
+			wantErr: true,
+		},
+		{
+			name:    "Error - Missing JWKS file",
+			config:  Config{JWT: &JWTConfig{JWKSFile: filepath.Join(t.TempDir(), "missing.json")}},
+			wantErr: true,
+		},
+		{
+			name:    "Error - JWKS without RSA keys",
+			config:  Config{JWT: &JWTConfig{JWKSFile: writeFile(t, "jwks.json", []byte(`{"keys": [{"kty": "EC", "kid": "ec-1"}]}`))}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Initialize(tt.config)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Initialize() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && !reflect.DeepEqual(got.Methods(), tt.wantMethods) {
+				t.Errorf("Initialize() methods = %v, want %v", got.Methods(), tt.wantMethods)
+			}
+		})
+	}
+}
@@ -0,0 +1,141 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+
+	"hotelsDataMerge/internal/hotels"
+)
+
+// fieldChange is a field of a hotel that differs between two snapshots, with its JSON values
+type fieldChange struct {
+	Path string
+	Old  string
+	New  string
+}
+
+// diffSnapshots prints the hotels added to ("+ <id>"), removed from ("- <id>") and changed between
+// two snapshots ("~ <id> <field path>: <old JSON value> -> <new JSON value>"), ordered by ID.
+// It exits with ExitFailure when the snapshots differ, like diff(1).
+func (c *intCLI) diffSnapshots(ctx context.Context, args []string) error {
+	flags := c.newFlagSet("diff", "<old.json> <new.json>")
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+	if flags.NArg() != 2 {
+		return usageError{err: fmt.Errorf("expected two snapshot files, got %d", flags.NArg())}
+	}
+	oldSnapshot, err := readSnapshot(flags.Arg(0))
+	if err != nil {
+		return err
+	}
+	newSnapshot, err := readSnapshot(flags.Arg(1))
+	if err != nil {
+		return err
+	}
+
+	hotelIDs := make([]string, 0, len(oldSnapshot)+len(newSnapshot))
+	for hotelID := range oldSnapshot {
+		hotelIDs = append(hotelIDs, hotelID)
+	}
+	for hotelID := range newSnapshot {
+		if _, ok := oldSnapshot[hotelID]; !ok {
+			hotelIDs = append(hotelIDs, hotelID)
+		}
+	}
+	sort.Strings(hotelIDs)
+
+	var added, removed, changed int
+	for _, hotelID := range hotelIDs {
+		oldHotel, inOld := oldSnapshot[hotelID]
+		newHotel, inNew := newSnapshot[hotelID]
+		switch {
+		case !inOld:
+			added++
+			fmt.Fprintf(c.stdout, "+ %s\n", hotelID)
+		case !inNew:
+			removed++
+			fmt.Fprintf(c.stdout, "- %s\n", hotelID)
+		default:
+			changes, err := diffHotels(oldHotel, newHotel)
+			if err != nil {
+				return err
+			}
+			if len(changes) > 0 {
+				changed++
+			}
+			for _, change := range changes {
+				fmt.Fprintf(c.stdout, "~ %s %s: %s -> %s\n", hotelID, change.Path, change.Old, change.New)
+			}
+		}
+	}
+	fmt.Fprintf(c.stdout, "%d added, %d removed, %d changed\n", added, removed, changed)
+	if added+removed+changed > 0 {
+		return errDifferences
+	}
+	return nil
+}
+
+// diffHotels returns the fields that differ between two versions of a hotel, by their dotted JSON path.
+// Lists are compared as a whole, since their items have no identity.
+func diffHotels(oldHotel hotels.Hotel, newHotel hotels.Hotel) ([]fieldChange, error) {
+	oldValue, err := toJSONValue(oldHotel)
+	if err != nil {
+		return nil, err
+	}
+	newValue, err := toJSONValue(newHotel)
+	if err != nil {
+		return nil, err
+	}
+	var changes []fieldChange
+	diffValues("", oldValue, newValue, &changes)
+	return changes, nil
+}
+
+func diffValues(path string, oldValue any, newValue any, changes *[]fieldChange) {
+	oldObject, oldIsObject := oldValue.(map[string]any)
+	newObject, newIsObject := newValue.(map[string]any)
+	if oldIsObject && newIsObject {
+		keys := make([]string, 0, len(oldObject)+len(newObject))
+		for key := range oldObject {
+			keys = append(keys, key)
+		}
+		for key := range newObject {
+			if _, ok := oldObject[key]; !ok {
+				keys = append(keys, key)
+			}
+		}
+		slices.Sort(keys)
+		for _, key := range keys {
+			fieldPath := key
+			if len(path) > 0 {
+				fieldPath = path + "." + key
+			}
+			diffValues(fieldPath, oldObject[key], newObject[key], changes)
+		}
+		return
+	}
+	if !reflect.DeepEqual(oldValue, newValue) {
+		*changes = append(*changes, fieldChange{Path: path, Old: compactJSON(oldValue), New: compactJSON(newValue)})
+	}
+}
+
+// toJSONValue returns hotel as decoded JSON, so that it compares like it is saved in a snapshot
+func toJSONValue(hotel hotels.Hotel) (any, error) {
+	data, err := json.Marshal(hotel)
+	if err != nil {
+		return nil, err
+	}
+	var value any
+	err = json.Unmarshal(data, &value)
+	return value, err
+}
+
+func compactJSON(value any) string {
+	data, _ := json.Marshal(value)
+	return string(data)
+}
@@ -0,0 +1,72 @@
+package cli
+
+import (
+	"reflect"
+	"testing"
+
+	"hotelsDataMerge/internal/hotels"
+)
+
+func Test_diffHotels(t *testing.T) {
+	hotel := hotels.Hotel{
+		Id:            "iJhz",
+		DestinationId: 5432,
+		Name:          "Beach Villas Singapore",
+		Location:      &hotels.HotelLocation{Lat: 1.264751, Lng: 103.824006, City: "Singapore"},
+		Amenities:     &hotels.HotelAmenities{General: []string{"pool"}},
+	}
+
+	tests := []struct {
+		name   string
+		update func(hotel *hotels.Hotel)
+		want   []fieldChange
+	}{
+		{
+			name:   "Success - Same hotel",
+			update: func(hotel *hotels.Hotel) {},
+		},
+		{
+			name: "Success - Nested fields by path",
+			update: func(hotel *hotels.Hotel) {
+				hotel.Name = "Beach Villas"
+				hotel.Location = &hotels.HotelLocation{Lat: 1.3, Lng: 103.824006, City: "Sentosa"}
+			},
+			want: []fieldChange{
+				{Path: "location.city", Old: `"Singapore"`, New: `"Sentosa"`},
+				{Path: "location.lat", Old: "1.264751", New: "1.3"},
+				{Path: "name", Old: `"Beach Villas Singapore"`, New: `"Beach Villas"`},
+			},
+		},
+		{
+			name: "Success - Lists as a whole",
+			update: func(hotel *hotels.Hotel) {
+				hotel.Amenities = &hotels.HotelAmenities{General: []string{"pool", "wifi"}}
+			},
+			want: []fieldChange{
+				{Path: "amenities.general", Old: `["pool"]`, New: `["pool","wifi"]`},
+			},
+		},
+		{
+			name: "Success - Missing object",
+			update: func(hotel *hotels.Hotel) {
+				hotel.Location = nil
+			},
+			want: []fieldChange{
+				{Path: "location", Old: `{"address":"","city":"Singapore","country":"","lat":1.264751,"lng":103.824006}`, New: "null"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			newHotel := hotel
+			tt.update(&newHotel)
+			got, err := diffHotels(hotel, newHotel)
+			if err != nil {
+				t.Fatalf("diffHotels() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("diffHotels() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"hotelsDataMerge/external"
+	"hotelsDataMerge/internal/config"
+	"hotelsDataMerge/internal/suppliers"
+	"hotelsDataMerge/internal/suppliers/fetcher"
+	"hotelsDataMerge/internal/suppliers/utils"
+)
+
+// fetchPayloads writes the raw payload of each supplier to <out>/<supplier>.json, unchanged, so that
+// it can be parsed later or shared to reproduce an issue. The supplier and archive settings are loaded
+// from the config file and environment like the server does, so payloads are recorded in archive.dir,
+// or read from a recording with -replay.
+func (c *intCLI) fetchPayloads(ctx context.Context, args []string) error {
+	flags := c.newFlagSet("fetch", "")
+	configFile := flags.String("config", "", "YAML config file (env HOTELS_CONFIG)")
+	out := flags.String("out", "", "directory the payloads are written to (required)")
+	supplierList := flags.String("suppliers", "", "comma separated suppliers to fetch (default all)")
+	replay := flags.String("replay", "", "ID of a recording in archive.dir, or latest, to write instead of calling the suppliers")
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+	if flags.NArg() > 0 {
+		return usageError{err: fmt.Errorf("unexpected arguments: %v", flags.Args())}
+	}
+	if len(*out) == 0 {
+		return usageError{err: fmt.Errorf("-out is required")}
+	}
+	supplierNames, err := parseSuppliers(*supplierList)
+	if err != nil {
+		return err
+	}
+
+	loaded, err := c.loadConfig(*configFile)
+	if err != nil {
+		return err
+	}
+	if len(*replay) > 0 {
+		loaded.Config.Archive.Replay = *replay
+	}
+	if len(loaded.Config.Archive.Replay) > 0 && len(loaded.Config.Archive.Dir) == 0 {
+		return usageError{err: fmt.Errorf("-replay requires archive.dir")}
+	}
+	var extSuppliers external.ExtSuppliers = external.Initialize(c.logger, nil, external.Options{
+		SupplierURLs: loaded.Config.Suppliers.SupplierURLs(),
+		Timeout:      loaded.Config.Suppliers.Timeout,
+	})
+	extSuppliers, recorder, err := loaded.Config.Archive.SetupSuppliers(extSuppliers, c.logger)
+	if err != nil {
+		return err
+	}
+	intSuppliers := suppliers.Initialize(c.logger, extSuppliers, recorder, loaded.Config.SuppliersOptions())
+	// The payloads of one fetch are recorded together, like those of a refresh run
+	ctx = fetcher.WithRun(ctx, fetcher.Run{StartedAt: time.Now()})
+
+	if err := os.MkdirAll(*out, 0o755); err != nil {
+		return err
+	}
+	for _, supplierName := range supplierNames {
+		rawResp, err := intSuppliers.Fetcher.GetSupplierData(ctx, supplierName)
+		if err != nil {
+			return fmt.Errorf("failed to fetch %s: %w", supplierName, err)
+		}
+		path := filepath.Join(*out, string(supplierName)+".json")
+		if err := os.WriteFile(path, rawResp, 0o644); err != nil {
+			return err
+		}
+		fmt.Fprintf(c.stdout, "%s\t%d bytes\t%s\n", supplierName, len(rawResp), path)
+	}
+	return nil
+}
+
+// parseSuppliers returns the suppliers of a comma separated list in priority order, or every known supplier
+func parseSuppliers(value string) ([]utils.Suppliers, error) {
+	names := splitList(value)
+	if len(names) == 0 {
+		return utils.SupplierPriority, nil
+	}
+	supplierNames := make([]utils.Suppliers, 0, len(names))
+	for _, name := range names {
+		if !isKnownSupplier(utils.Suppliers(name)) {
+			return nil, usageError{err: fmt.Errorf("unknown supplier %q", name)}
+		}
+		supplierNames = append(supplierNames, utils.Suppliers(name))
+	}
+	return utils.SortByPriority(supplierNames), nil
+}
+
+func isKnownSupplier(supplierName utils.Suppliers) bool {
+	for _, known := range utils.SupplierPriority {
+		if known == supplierName {
+			return true
+		}
+	}
+	return false
+}
+
+// loadConfig loads the settings from configFile, or HOTELS_CONFIG, and the environment
+func (c *intCLI) loadConfig(configFile string) (config.Loaded, error) {
+	var configArgs []string
+	if len(configFile) > 0 {
+		configArgs = []string{"-config", configFile}
+	}
+	loaded, err := config.Load(configArgs, c.lookupEnv)
+	if err != nil {
+		return config.Loaded{}, usageError{err: fmt.Errorf("invalid configuration: %w", err)}
+	}
+	return loaded, nil
+}
@@ -0,0 +1,148 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"hotelsDataMerge/internal/config"
+	"hotelsDataMerge/internal/lifecycle"
+)
+
+// errDifferences is returned by diff when the snapshots differ, to exit with ExitFailure like diff(1)
+var errDifferences = errors.New("snapshots differ")
+
+// usageError is an invalid flag or argument, reported with ExitConfig
+type usageError struct {
+	err error
+}
+
+func (e usageError) Error() string {
+	return e.err.Error()
+}
+
+func (e usageError) Unwrap() error {
+	return e.err
+}
+
+type command struct {
+	summary string
+	run     func(c *intCLI, ctx context.Context, args []string) error
+}
+
+// commands run each stage of the suppliers pipeline on its own, reading and writing files, so that
+// a production merge can be reproduced from captured supplier payloads
+var commands = map[string]command{
+	"fetch":      {summary: "fetch the raw supplier payloads into a directory", run: (*intCLI).fetchPayloads},
+	"parse":      {summary: "parse raw supplier payloads into hotels", run: (*intCLI).parsePayloads},
+	"merge":      {summary: "merge parsed hotels into a snapshot", run: (*intCLI).mergeHotels},
+	"query":      {summary: "print the hotels of a snapshot by ID or destination", run: (*intCLI).querySnapshot},
+	"diff":       {summary: "compare two snapshots hotel by hotel", run: (*intCLI).diffSnapshots},
+	"recordings": {summary: "list the recorded supplier payloads of archive.dir", run: (*intCLI).listRecordings},
+}
+
+type IntCLI interface {
+	// Run runs the command named by args[0] with the remaining arguments and returns the process exit code
+	Run(ctx context.Context, args []string) int
+}
+
+type intCLI struct {
+	logger    *slog.Logger
+	stdout    io.Writer
+	stderr    io.Writer
+	lookupEnv config.LookupEnv
+}
+
+// Initialize returns the offline commands. Results are written to stdout, while logs and errors go to stderr;
+// lookupEnv is used to load the settings of the config file and environment like the server does.
+func Initialize(stdout io.Writer, stderr io.Writer, lookupEnv config.LookupEnv) IntCLI {
+	return &intCLI{
+		logger:    slog.New(slog.NewTextHandler(stderr, &slog.HandlerOptions{Level: slog.LevelWarn})),
+		stdout:    stdout,
+		stderr:    stderr,
+		lookupEnv: lookupEnv,
+	}
+}
+
+// IsCommand reports whether name is one of the offline commands
+func IsCommand(name string) bool {
+	_, ok := commands[name]
+	return ok
+}
+
+// Usage writes the list of commands
+func Usage(w io.Writer) {
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	fmt.Fprintln(w, "Commands:")
+	fmt.Fprintf(w, "  %-10s %s\n", "serve", "run the gRPC, admin and REST servers (default)")
+	for _, name := range names {
+		fmt.Fprintf(w, "  %-10s %s\n", name, commands[name].summary)
+	}
+	fmt.Fprintln(w, "Run a command with -h for its flags.")
+}
+
+func (c *intCLI) Run(ctx context.Context, args []string) int {
+	if len(args) == 0 || !IsCommand(args[0]) {
+		Usage(c.stderr)
+		return lifecycle.ExitConfig
+	}
+	name := args[0]
+	err := commands[name].run(c, ctx, args[1:])
+	var usageErr usageError
+	switch {
+	case err == nil:
+		return lifecycle.ExitOK
+	case errors.Is(err, flag.ErrHelp):
+		return lifecycle.ExitOK
+	case errors.Is(err, errDifferences):
+		return lifecycle.ExitFailure
+	case errors.As(err, &usageErr):
+		fmt.Fprintf(c.stderr, "%s: %s\n", name, err)
+		return lifecycle.ExitConfig
+	default:
+		fmt.Fprintf(c.stderr, "%s: %s\n", name, err)
+		return lifecycle.ExitFailure
+	}
+}
+
+// newFlagSet returns the flags of a command, printing errors and -h to stderr
+func (c *intCLI) newFlagSet(name string, arguments string) *flag.FlagSet {
+	flags := flag.NewFlagSet(name, flag.ContinueOnError)
+	flags.SetOutput(c.stderr)
+	flags.Usage = func() {
+		fmt.Fprintf(c.stderr, "Usage: hotelsDataMerge %s [flags] %s\n", name, arguments)
+		flags.PrintDefaults()
+	}
+	return flags
+}
+
+// parseFlags parses args, wrapping invalid flags in a usageError. The flag package already printed them.
+func parseFlags(flags *flag.FlagSet, args []string) error {
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return err
+		}
+		return usageError{err: err}
+	}
+	return nil
+}
+
+// splitList splits a comma separated flag value, ignoring empty items
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); len(item) > 0 {
+			items = append(items, item)
+		}
+	}
+	return items
+}
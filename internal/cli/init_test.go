@@ -0,0 +1,297 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"hotelsDataMerge/internal/hotels"
+	"hotelsDataMerge/internal/lifecycle"
+	"hotelsDataMerge/internal/mocksuppliers"
+	"hotelsDataMerge/internal/mocksuppliers/mockstest"
+	"hotelsDataMerge/internal/suppliers/utils"
+)
+
+// supplierEnv points the suppliers to the mock at urls
+func supplierEnv(urls map[utils.Suppliers]string) map[string]string {
+	env := make(map[string]string)
+	for supplierName, supplierURL := range urls {
+		env["HOTELS_SUPPLIERS_URLS_"+strings.ToUpper(string(supplierName))] = supplierURL
+	}
+	return env
+}
+
+func runCLI(t *testing.T, env map[string]string, args ...string) (int, string, string) {
+	t.Helper()
+	var stdout, stderr bytes.Buffer
+	lookupEnv := func(key string) (string, bool) {
+		value, ok := env[key]
+		return value, ok
+	}
+	code := Initialize(&stdout, &stderr, lookupEnv).Run(context.Background(), args)
+	return code, stdout.String(), stderr.String()
+}
+
+func decodeHotels(t *testing.T, data []byte) []hotels.Hotel {
+	t.Helper()
+	var hotelsList []hotels.Hotel
+	if err := json.Unmarshal(data, &hotelsList); err != nil {
+		t.Fatalf("invalid hotels %s: %v", data, err)
+	}
+	return hotelsList
+}
+
+func hotelIDs(hotelsList []hotels.Hotel) []string {
+	ids := make([]string, 0, len(hotelsList))
+	for _, hotel := range hotelsList {
+		ids = append(ids, hotel.Id)
+	}
+	return ids
+}
+
+// takeSnapshot runs every stage on the output of the previous one in dir, as done to reproduce a merge,
+// and returns the snapshot file
+func takeSnapshot(t *testing.T, env map[string]string, dir string) string {
+	t.Helper()
+	payloadsDir := filepath.Join(dir, "payloads")
+	parsedFile := filepath.Join(dir, "parsed.json")
+	snapshotFile := filepath.Join(dir, "snapshot.json")
+	stages := [][]string{
+		{"fetch", "-out", payloadsDir},
+		{"parse", "-out", parsedFile, payloadsDir},
+		{"merge", "-out", snapshotFile, "-quality", filepath.Join(dir, "quality.json"), parsedFile},
+	}
+	for _, args := range stages {
+		if code, _, stderr := runCLI(t, env, args...); code != lifecycle.ExitOK {
+			t.Fatalf("%s exit code = %v, want %v: %s", args[0], code, lifecycle.ExitOK, stderr)
+		}
+	}
+	return snapshotFile
+}
+
+func Test_intCLI_Run_stages(t *testing.T) {
+	_, urls := mockstest.NewServer(t, mocksuppliers.Scenarios["changing"])
+	env := supplierEnv(urls)
+	dir := t.TempDir()
+	before := takeSnapshot(t, env, filepath.Join(dir, "before"))
+
+	for _, supplierName := range utils.SupplierPriority {
+		got, err := os.ReadFile(filepath.Join(dir, "before", "payloads", string(supplierName)+".json"))
+		want, _ := fs.ReadFile(mocksuppliers.Fixtures(), string(supplierName)+".json")
+		if err != nil || !bytes.Equal(got, bytes.TrimSpace(want)) {
+			t.Errorf("fetch %s payload = %s, %v, want the raw payload", supplierName, got, err)
+		}
+	}
+	parsed, _ := os.ReadFile(filepath.Join(dir, "before", "parsed.json"))
+	if got := hotelIDs(decodeHotels(t, parsed)); !reflect.DeepEqual(got, []string{"iJhz", "SjyX", "iJhz", "f8c9", "iJhz", "SjyX", "f8c9"}) {
+		t.Errorf("parse hotels = %v, want the hotels of paperflies, patagonia then acme", got)
+	}
+	snapshot, _ := os.ReadFile(before)
+	if got := hotelIDs(decodeHotels(t, snapshot)); !reflect.DeepEqual(got, []string{"SjyX", "f8c9", "iJhz"}) {
+		t.Errorf("merge hotels = %v, want the merged hotels ordered by ID", got)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "before", "quality.json")); err != nil {
+		t.Errorf("merge did not write the quality report: %v", err)
+	}
+
+	code, stdout, stderr := runCLI(t, env, "query", "-destination", "1122", before)
+	if code != lifecycle.ExitOK {
+		t.Fatalf("query exit code = %v, want %v: %s", code, lifecycle.ExitOK, stderr)
+	}
+	if got := hotelIDs(decodeHotels(t, []byte(stdout))); !reflect.DeepEqual(got, []string{"f8c9"}) {
+		t.Errorf("query hotels = %v, want [f8c9]", got)
+	}
+
+	if code, stdout, _ := runCLI(t, env, "diff", before, before); code != lifecycle.ExitOK || stdout != "0 added, 0 removed, 0 changed\n" {
+		t.Errorf("diff of the same snapshot = %v %q, want no difference", code, stdout)
+	}
+	// The suppliers serve updated data from their second call
+	after := takeSnapshot(t, env, filepath.Join(dir, "after"))
+	code, stdout, _ = runCLI(t, env, "diff", before, after)
+	if code != lifecycle.ExitFailure || !strings.Contains(stdout, "+ Kd7w\n") || !strings.HasSuffix(stdout, "1 added, 0 removed, 3 changed\n") {
+		t.Errorf("diff of the updated snapshot = %v %q, want Kd7w added and 3 hotels changed", code, stdout)
+	}
+}
+
+func Test_intCLI_Run(t *testing.T) {
+	dir := t.TempDir()
+	snapshotFile := filepath.Join(dir, "snapshot.json")
+	if err := os.WriteFile(snapshotFile, []byte(`[{"id":"a","destination_id":1},{"id":"b","destination_id":2}]`), 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	invalidConfigFile := filepath.Join(dir, "config.yaml")
+	if err := os.WriteFile(invalidConfigFile, []byte("merge:\n  description_strategy: shortest\n"), 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	otherSnapshotFile := filepath.Join(dir, "other.json")
+	if err := os.WriteFile(otherSnapshotFile, []byte(`[{"id":"b","destination_id":3},{"id":"c","destination_id":2}]`), 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		args       []string
+		wantCode   int
+		wantStdout string
+		wantStderr string
+	}{
+		{
+			name:       "Success - Query by ID",
+			args:       []string{"query", "-id", "b,unknown", snapshotFile},
+			wantCode:   lifecycle.ExitOK,
+			wantStdout: `"id": "b"`,
+		},
+		{
+			name:       "Success - Query without filter prints every hotel",
+			args:       []string{"query", snapshotFile},
+			wantCode:   lifecycle.ExitOK,
+			wantStdout: `"id": "a"`,
+		},
+		{
+			name:       "Success - Query without match prints an empty list",
+			args:       []string{"query", "-destination", "9", snapshotFile},
+			wantCode:   lifecycle.ExitOK,
+			wantStdout: "[]",
+		},
+		{
+			name:       "Success - Help",
+			args:       []string{"merge", "-h"},
+			wantCode:   lifecycle.ExitOK,
+			wantStderr: "Usage: hotelsDataMerge merge",
+		},
+		{
+			name:       "Error - Differences",
+			args:       []string{"diff", snapshotFile, otherSnapshotFile},
+			wantCode:   lifecycle.ExitFailure,
+			wantStdout: "- a\n~ b destination_id: 2 -> 3\n+ c\n1 added, 1 removed, 1 changed\n",
+		},
+		{
+			name:       "Error - Unknown command",
+			args:       []string{"compact"},
+			wantCode:   lifecycle.ExitConfig,
+			wantStderr: "Commands:",
+		},
+		{
+			name:       "Error - Unknown flag",
+			args:       []string{"query", "-country", "SG", snapshotFile},
+			wantCode:   lifecycle.ExitConfig,
+			wantStderr: "flag provided but not defined",
+		},
+		{
+			name:       "Error - Fetch without output directory",
+			args:       []string{"fetch"},
+			wantCode:   lifecycle.ExitConfig,
+			wantStderr: "-out is required",
+		},
+		{
+			name:       "Error - Fetch unknown supplier",
+			args:       []string{"fetch", "-out", dir, "-suppliers", "acme,expedia"},
+			wantCode:   lifecycle.ExitConfig,
+			wantStderr: `unknown supplier "expedia"`,
+		},
+		{
+			name:       "Error - Parse file not named after a supplier",
+			args:       []string{"parse", snapshotFile},
+			wantCode:   lifecycle.ExitConfig,
+			wantStderr: "is not named after a supplier",
+		},
+		{
+			name:       "Error - Merge with an invalid config",
+			args:       []string{"merge", "-config", invalidConfigFile, snapshotFile},
+			wantCode:   lifecycle.ExitConfig,
+			wantStderr: "merge.description_strategy",
+		},
+		{
+			name:       "Error - Diff of a single snapshot",
+			args:       []string{"diff", snapshotFile},
+			wantCode:   lifecycle.ExitConfig,
+			wantStderr: "expected two snapshot files",
+		},
+		{
+			name:       "Error - Missing snapshot",
+			args:       []string{"query", filepath.Join(dir, "missing.json")},
+			wantCode:   lifecycle.ExitFailure,
+			wantStderr: "no such file",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, stdout, stderr := runCLI(t, nil, tt.args...)
+			if code != tt.wantCode {
+				t.Errorf("Run() exit code = %v, want %v: %s", code, tt.wantCode, stderr)
+			}
+			if !strings.Contains(stdout, tt.wantStdout) {
+				t.Errorf("Run() stdout = %q, want %q", stdout, tt.wantStdout)
+			}
+			if !strings.Contains(stderr, tt.wantStderr) {
+				t.Errorf("Run() stderr = %q, want %q", stderr, tt.wantStderr)
+			}
+		})
+	}
+}
+
+func Test_intCLI_Run_recordings(t *testing.T) {
+	_, urls := mockstest.NewServer(t, mocksuppliers.Scenarios["changing"])
+	env := supplierEnv(urls)
+	env["HOTELS_ARCHIVE_DIR"] = t.TempDir()
+	dir := t.TempDir()
+
+	if code, _, stderr := runCLI(t, env, "fetch", "-out", filepath.Join(dir, "recorded")); code != lifecycle.ExitOK {
+		t.Fatalf("fetch exit code = %v, want %v: %s", code, lifecycle.ExitOK, stderr)
+	}
+	code, stdout, stderr := runCLI(t, env, "recordings")
+	if code != lifecycle.ExitOK {
+		t.Fatalf("recordings exit code = %v, want %v: %s", code, lifecycle.ExitOK, stderr)
+	}
+	if lines := strings.Split(strings.TrimSpace(stdout), "\n"); len(lines) != 2 || !strings.Contains(lines[1], "acme:") {
+		t.Errorf("recordings = %q, want one recording with the payload of each supplier", stdout)
+	}
+
+	// The suppliers serve updated data from their second call, while the replay returns the recorded payloads
+	if code, _, stderr := runCLI(t, env, "fetch", "-replay", "latest", "-out", filepath.Join(dir, "replayed")); code != lifecycle.ExitOK {
+		t.Fatalf("fetch -replay exit code = %v, want %v: %s", code, lifecycle.ExitOK, stderr)
+	}
+	for _, supplierName := range utils.SupplierPriority {
+		recorded, _ := os.ReadFile(filepath.Join(dir, "recorded", string(supplierName)+".json"))
+		replayed, err := os.ReadFile(filepath.Join(dir, "replayed", string(supplierName)+".json"))
+		if err != nil || !bytes.Equal(replayed, recorded) {
+			t.Errorf("fetch -replay %s payload = %s, %v, want the recorded payload", supplierName, replayed, err)
+		}
+	}
+	if code, _, _ := runCLI(t, env, "fetch", "-replay", "20261019T080000.000Z-run9", "-out", filepath.Join(dir, "missing")); code != lifecycle.ExitFailure {
+		t.Errorf("fetch -replay of an unknown recording exit code = %v, want %v", code, lifecycle.ExitFailure)
+	}
+}
+
+func Test_intCLI_Run_textNormalization(t *testing.T) {
+	payloadFile := filepath.Join(t.TempDir(), "acme.json")
+	if err := os.WriteFile(payloadFile, []byte(`[{"Id":"iJhz","DestinationId":5432,"Name":"<b>Beach Villas</b>"}]`), 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		env      map[string]string
+		wantName string
+	}{
+		{name: "Success - Every step enabled by default", wantName: "Beach Villas"},
+		{name: "Success - HTML kept when disabled", env: map[string]string{"HOTELS_TEXT_NORMALIZATION_STRIP_HTML": "false"}, wantName: "<b>Beach Villas</b>"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, stdout, stderr := runCLI(t, tt.env, "parse", payloadFile)
+			if code != lifecycle.ExitOK {
+				t.Fatalf("parse exit code = %v, want %v: %s", code, lifecycle.ExitOK, stderr)
+			}
+			if got := decodeHotels(t, []byte(stdout)); len(got) != 1 || got[0].Name != tt.wantName {
+				t.Errorf("parse hotels = %+v, want the name %q", got, tt.wantName)
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+// listRecordings prints the recordings of archive.dir, oldest first, with the hash of each payload
+func (c *intCLI) listRecordings(ctx context.Context, args []string) error {
+	flags := c.newFlagSet("recordings", "")
+	configFile := flags.String("config", "", "YAML config file (env HOTELS_CONFIG)")
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+	if flags.NArg() > 0 {
+		return usageError{err: fmt.Errorf("unexpected arguments: %v", flags.Args())}
+	}
+	loaded, err := c.loadConfig(*configFile)
+	if err != nil {
+		return err
+	}
+	if len(loaded.Config.Archive.Dir) == 0 {
+		return usageError{err: fmt.Errorf("archive.dir is not set")}
+	}
+	intArchive, err := loaded.Config.Archive.OpenArchive(c.logger)
+	if err != nil {
+		return err
+	}
+	recordings, err := intArchive.List()
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(c.stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tRUN\tSTARTED\tPAYLOADS")
+	for _, recording := range recordings {
+		payloads := make([]string, 0, len(recording.Payloads))
+		for _, payload := range recording.Payloads {
+			payloads = append(payloads, fmt.Sprintf("%s:%.12s", payload.Supplier, payload.SHA256))
+		}
+		fmt.Fprintf(w, "%s\t%d\t%s\t%s\n", recording.ID, recording.Run, recording.StartedAt.Format(time.RFC3339), strings.Join(payloads, " "))
+	}
+	return w.Flush()
+}
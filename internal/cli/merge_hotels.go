@@ -0,0 +1,41 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"hotelsDataMerge/internal/suppliers"
+)
+
+// mergeHotels merges the hotels written by parse into a snapshot ordered by hotel ID, and optionally
+// writes the data-quality report of the merge. The merge settings are loaded like the server does.
+func (c *intCLI) mergeHotels(ctx context.Context, args []string) error {
+	flags := c.newFlagSet("merge", "<parsed.json>")
+	configFile := flags.String("config", "", "YAML config file (env HOTELS_CONFIG)")
+	out := flags.String("out", "", "file the snapshot is written to (default stdout)")
+	qualityOut := flags.String("quality", "", "file the data-quality report is written to")
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+	if flags.NArg() != 1 {
+		return usageError{err: fmt.Errorf("expected one parsed hotels file, got %d", flags.NArg())}
+	}
+	parsedHotels, err := readHotels(flags.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	loaded, err := c.loadConfig(*configFile)
+	if err != nil {
+		return err
+	}
+	intSuppliers := suppliers.Initialize(c.logger, nil, nil, loaded.Config.SuppliersOptions())
+	mergedHotels := intSuppliers.Merger.MergeHotelsData(ctx, parsedHotels)
+	if err := c.writeJSON(*out, sortedHotels(mergedHotels)); err != nil {
+		return err
+	}
+	if len(*qualityOut) > 0 {
+		return c.writeJSON(*qualityOut, intSuppliers.Merger.GetQualityReport())
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"hotelsDataMerge/internal/suppliers"
+	"hotelsDataMerge/internal/suppliers/utils"
+)
+
+// parsePayloads parses raw supplier payloads, given as <supplier>.json files or directories holding
+// them, and writes the hotels in the order the merger would see them. The parse settings are loaded
+// like the server does.
+func (c *intCLI) parsePayloads(ctx context.Context, args []string) error {
+	flags := c.newFlagSet("parse", "<dir|supplier.json>...")
+	configFile := flags.String("config", "", "YAML config file (env HOTELS_CONFIG)")
+	out := flags.String("out", "", "file the parsed hotels are written to (default stdout)")
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+	if flags.NArg() == 0 {
+		return usageError{err: fmt.Errorf("no payload given")}
+	}
+	payloads, err := readPayloads(flags.Args())
+	if err != nil {
+		return err
+	}
+
+	loaded, err := c.loadConfig(*configFile)
+	if err != nil {
+		return err
+	}
+	intSuppliers := suppliers.Initialize(c.logger, nil, nil, loaded.Config.SuppliersOptions())
+	parsedHotels, err := intSuppliers.Parser.ParseSuppliersData(ctx, payloads)
+	if err != nil {
+		return fmt.Errorf("failed to parse: %w", err)
+	}
+	return c.writeJSON(*out, parsedHotels)
+}
+
+// readPayloads reads the payload of each supplier from its <supplier>.json file. A directory
+// contributes every file named after a known supplier.
+func readPayloads(paths []string) (map[utils.Suppliers]json.RawMessage, error) {
+	payloads := make(map[utils.Suppliers]json.RawMessage)
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+		files := []string{path}
+		if info.IsDir() {
+			files = nil
+			for _, supplierName := range utils.SupplierPriority {
+				file := filepath.Join(path, string(supplierName)+".json")
+				if _, err := os.Stat(file); err == nil {
+					files = append(files, file)
+				}
+			}
+		}
+		for _, file := range files {
+			supplierName := utils.Suppliers(strings.TrimSuffix(filepath.Base(file), filepath.Ext(file)))
+			if !isKnownSupplier(supplierName) {
+				return nil, usageError{err: fmt.Errorf("%s is not named after a supplier", file)}
+			}
+			if _, ok := payloads[supplierName]; ok {
+				return nil, usageError{err: fmt.Errorf("%s payload given twice", supplierName)}
+			}
+			data, err := os.ReadFile(file)
+			if err != nil {
+				return nil, err
+			}
+			if !json.Valid(data) {
+				return nil, fmt.Errorf("%s is not valid JSON", file)
+			}
+			payloads[supplierName] = data
+		}
+	}
+	if len(payloads) == 0 {
+		return nil, usageError{err: fmt.Errorf("no supplier payload found in %v", paths)}
+	}
+	return payloads, nil
+}
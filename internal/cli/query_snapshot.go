@@ -0,0 +1,40 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"hotelsDataMerge/internal/hotels"
+)
+
+// querySnapshot prints the hotels of a snapshot matching the IDs and destination, with the same
+// filtering as GetHotels, or every hotel when neither is given
+func (c *intCLI) querySnapshot(ctx context.Context, args []string) error {
+	flags := c.newFlagSet("query", "<snapshot.json>")
+	ids := flags.String("id", "", "comma separated hotel IDs")
+	destination := flags.Uint64("destination", 0, "destination ID")
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+	if flags.NArg() != 1 {
+		return usageError{err: fmt.Errorf("expected one snapshot file, got %d", flags.NArg())}
+	}
+	snapshot, err := readSnapshot(flags.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	hotelIDs := splitList(*ids)
+	if len(hotelIDs) == 0 && *destination == 0 {
+		return c.writeJSON("", sortedHotels(snapshot))
+	}
+	hotels.SaveMaps(ctx, snapshot)
+	found, err := hotels.Initialize(c.logger).GetHotels(hotelIDs, *destination)
+	if err != nil {
+		return err
+	}
+	if found == nil {
+		found = []hotels.Hotel{}
+	}
+	return c.writeJSON("", found)
+}
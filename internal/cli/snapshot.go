@@ -0,0 +1,74 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+
+	"hotelsDataMerge/internal/hotels"
+)
+
+// readHotels reads a file written by parse or merge: a JSON array of hotels
+func readHotels(path string) ([]hotels.Hotel, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var hotelsList []hotels.Hotel
+	if err := json.Unmarshal(data, &hotelsList); err != nil {
+		return nil, fmt.Errorf("invalid hotels file %s: %w", path, err)
+	}
+	return hotelsList, nil
+}
+
+// readSnapshot reads a snapshot written by merge, keyed by hotel ID
+func readSnapshot(path string) (map[string]hotels.Hotel, error) {
+	hotelsList, err := readHotels(path)
+	if err != nil {
+		return nil, err
+	}
+	snapshot := make(map[string]hotels.Hotel, len(hotelsList))
+	for _, hotel := range hotelsList {
+		if _, ok := snapshot[hotel.Id]; ok {
+			return nil, fmt.Errorf("invalid snapshot %s: hotel %q is listed twice", path, hotel.Id)
+		}
+		snapshot[hotel.Id] = hotel
+	}
+	return snapshot, nil
+}
+
+// sortedHotels returns the hotels of snapshot ordered by ID, so that snapshots of the same data are identical
+func sortedHotels(snapshot map[string]hotels.Hotel) []hotels.Hotel {
+	hotelsList := make([]hotels.Hotel, 0, len(snapshot))
+	for _, hotel := range snapshot {
+		hotelsList = append(hotelsList, hotel)
+	}
+	sort.Slice(hotelsList, func(i, j int) bool {
+		return hotelsList[i].Id < hotelsList[j].Id
+	})
+	return hotelsList
+}
+
+// writeJSON writes value as indented JSON to path, or to stdout when path is empty
+func (c *intCLI) writeJSON(path string, value any) error {
+	if len(path) == 0 {
+		return encodeJSON(c.stdout, value)
+	}
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := encodeJSON(file, value); err != nil {
+		_ = file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+func encodeJSON(w io.Writer, value any) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(value)
+}
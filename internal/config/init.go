@@ -0,0 +1,305 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"hotelsDataMerge/proto"
+
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"gopkg.in/yaml.v3"
+)
+
+// Config is the configuration of the application, layered from defaults, a YAML file,
+// HOTELS_* environment variables and command-line flags, in increasing precedence
+type Config struct {
+	Server    ServerConfig    `yaml:"server"`
+	Refresh   RefreshConfig   `yaml:"refresh"`
+	Suppliers SuppliersConfig `yaml:"suppliers"`
+	Log       LogConfig       `yaml:"log"`
+	TLS       TLSConfig       `yaml:"tls"`
+	Auth      AuthConfig      `yaml:"auth"`
+	RateLimit RateLimitConfig `yaml:"rate_limit"`
+	Tracing   TracingConfig   `yaml:"tracing"`
+	Health    HealthConfig    `yaml:"health"`
+	Archive   ArchiveConfig   `yaml:"archive"`
+	Merge     MergeConfig     `yaml:"merge"`
+	// TextNormalization switches the steps of the text cleanup applied to supplier values
+	TextNormalization TextNormalizationConfig `yaml:"text_normalization"`
+}
+
+type ServerConfig struct {
+	// GRPCAddress is the listen address of the public gRPC server
+	GRPCAddress string `yaml:"grpc_address"`
+	// AdminAddress is the listen address of the admin gRPC server, loopback-only by default
+	AdminAddress string `yaml:"admin_address"`
+	// GatewayAddress is the listen address of the REST gateway
+	GatewayAddress string `yaml:"gateway_address"`
+	// GatewayTarget is the address the gateway dials to reach the public gRPC server
+	GatewayTarget string `yaml:"gateway_target"`
+	// ShutdownTimeout bounds the graceful shutdown, after which in-flight calls are cancelled
+	ShutdownTimeout time.Duration `yaml:"shutdown_timeout"`
+	// Reflection registers gRPC server reflection, which lets tools such as grpcurl list the services
+	Reflection bool `yaml:"reflection"`
+}
+
+type RefreshConfig struct {
+	// Interval between scheduled refreshes of all suppliers; 0 only refreshes at startup
+	Interval time.Duration `yaml:"interval"`
+}
+
+type SuppliersConfig struct {
+	// URLs overrides the URL of each supplier, keyed by supplier name
+	URLs map[string]string `yaml:"urls"`
+	// Timeout bounds each supplier request
+	Timeout time.Duration `yaml:"timeout"`
+}
+
+type LogConfig struct {
+	// Level is debug, info, warn or error
+	Level string `yaml:"level"`
+	// Format is text or json
+	Format string `yaml:"format"`
+	// Access configures the access log line written for every gRPC call
+	Access AccessLogConfig `yaml:"access"`
+}
+
+type AccessLogConfig struct {
+	// MethodLevels sets the level of the calls to a full method name, e.g. "/proto.HotelDataMerge/GetHotels";
+	// other calls are logged at info. The file's entries are added to the defaults.
+	MethodLevels map[string]string `yaml:"method_levels"`
+	// MaxPayloadBytes bounds the logged request summary; longer summaries are truncated
+	MaxPayloadBytes int `yaml:"max_payload_bytes"`
+	// RedactFields lists the request field names, at any depth, whose values are not logged
+	RedactFields []string `yaml:"redact_fields"`
+}
+
+type TLSConfig struct {
+	// CertFile and KeyFile enable TLS on the gRPC servers and the gateway when both are set
+	CertFile string `yaml:"cert_file"`
+	KeyFile  string `yaml:"key_file"`
+	// ClientCAFile is the CA bundle client certificates are verified against; it enables mutual TLS
+	ClientCAFile string `yaml:"client_ca_file"`
+	// ClientAuth is require or verify_if_given, which also accepts clients without a certificate
+	ClientAuth string `yaml:"client_auth"`
+	// CAFile is the CA bundle the gateway verifies the gRPC server against; the server certificate by default
+	CAFile string `yaml:"ca_file"`
+	// GatewayCertFile and GatewayKeyFile are the client certificate the gateway presents under mutual TLS;
+	// the server certificate by default
+	GatewayCertFile string `yaml:"gateway_cert_file"`
+	GatewayKeyFile  string `yaml:"gateway_key_file"`
+	// ReloadInterval is how often the files are checked for changes; 0 disables reloading
+	ReloadInterval time.Duration `yaml:"reload_interval"`
+}
+
+type AuthConfig struct {
+	// ConfigFile is the JSON file of API keys and JWT settings; authentication is disabled without it
+	ConfigFile string `yaml:"config_file"`
+}
+
+type RateLimitConfig struct {
+	// ConfigFile is the JSON file of rate limits; the default limits apply without it
+	ConfigFile string `yaml:"config_file"`
+}
+
+type TracingConfig struct {
+	// Exporter is otlp, stdout or none
+	Exporter string `yaml:"exporter"`
+}
+
+type HealthConfig struct {
+	// MaxSnapshotAge is how old the served data may be before the service is no longer ready; 0 means no maximum
+	MaxSnapshotAge time.Duration `yaml:"max_snapshot_age"`
+	// CriticalSuppliers must have succeeded in their last refresh for the service to be ready
+	CriticalSuppliers []string `yaml:"critical_suppliers"`
+}
+
+type ArchiveConfig struct {
+	// Dir is where the raw supplier payloads of every refresh are recorded; recording is disabled without it
+	Dir string `yaml:"dir"`
+	// MaxAge is how long recordings are kept; 0 means no maximum
+	MaxAge time.Duration `yaml:"max_age"`
+	// MaxRecordings is how many recordings are kept, the oldest being removed first; 0 means no maximum
+	MaxRecordings int `yaml:"max_recordings"`
+	// Replay is the ID of a recording in Dir whose payloads are served instead of calling the suppliers,
+	// or latest for the last one. Nothing is recorded while replaying.
+	Replay string `yaml:"replay"`
+}
+
+type MergeConfig struct {
+	// DescriptionStrategy is longest, which keeps the longest supplier description, or combine,
+	// which keeps every distinct sentence of every supplier
+	DescriptionStrategy string `yaml:"description_strategy"`
+}
+
+type TextNormalizationConfig struct {
+	// StripHTML converts HTML to plain text, decoding entities
+	StripHTML bool `yaml:"strip_html"`
+	// UnicodeNFC composes characters to Unicode normalization form C
+	UnicodeNFC bool `yaml:"unicode_nfc"`
+	// RemoveControlChars removes control and invisible formatting characters such as zero-width spaces
+	RemoveControlChars bool `yaml:"remove_control_chars"`
+	// FixPunctuation replaces typographic quotes and ellipses with ASCII and removes spaces before punctuation
+	FixPunctuation bool `yaml:"fix_punctuation"`
+	// CollapseWhitespace replaces every run of whitespace with a single space
+	CollapseWhitespace bool `yaml:"collapse_whitespace"`
+}
+
+// Loaded is the result of Load
+type Loaded struct {
+	Config Config
+	// File is the YAML file the config was read from, if any
+	File string
+	// PrintOnly is set by -print-config: the effective config should be printed instead of serving
+	PrintOnly bool
+}
+
+// LookupEnv looks up an environment variable, as os.LookupEnv does
+type LookupEnv func(key string) (string, bool)
+
+// Default returns the configuration used when nothing is overridden
+func Default() Config {
+	return Config{
+		Server: ServerConfig{
+			GRPCAddress:     ":8080",
+			AdminAddress:    "127.0.0.1:8081",
+			GatewayAddress:  ":8090",
+			GatewayTarget:   "localhost:8080",
+			ShutdownTimeout: 15 * time.Second,
+		},
+		Refresh: RefreshConfig{
+			Interval: 10 * time.Minute,
+		},
+		Suppliers: SuppliersConfig{
+			Timeout: 10 * time.Second,
+		},
+		Log: LogConfig{
+			Level:  "info",
+			Format: "text",
+			Access: AccessLogConfig{
+				MethodLevels: map[string]string{
+					proto.HotelDataMergeAdmin_GetRefreshStatus_FullMethodName: "debug",
+					healthpb.Health_Check_FullMethodName:                      "debug",
+				},
+				MaxPayloadBytes: 512,
+				RedactFields:    []string{"token", "password", "secret", "api_key", "authorization"},
+			},
+		},
+		TLS: TLSConfig{
+			ClientAuth:     "require",
+			ReloadInterval: 30 * time.Second,
+		},
+		Tracing: TracingConfig{
+			Exporter: "none",
+		},
+		Health: HealthConfig{
+			MaxSnapshotAge: 30 * time.Minute,
+		},
+		Archive: ArchiveConfig{
+			MaxAge:        7 * 24 * time.Hour,
+			MaxRecordings: 100,
+		},
+		Merge: MergeConfig{
+			DescriptionStrategy: "longest",
+		},
+		TextNormalization: TextNormalizationConfig{
+			StripHTML:          true,
+			UnicodeNFC:         true,
+			RemoveControlChars: true,
+			FixPunctuation:     true,
+			CollapseWhitespace: true,
+		},
+	}
+}
+
+// Load builds the configuration from the defaults, the YAML file given by -config or HOTELS_CONFIG,
+// the environment and the flags in args, and validates it
+func Load(args []string, lookupEnv LookupEnv) (Loaded, error) {
+	flags := newFlagSet(io.Discard)
+	if err := flags.Parse(args); err != nil {
+		return Loaded{}, err
+	}
+	if flags.NArg() > 0 {
+		return Loaded{}, fmt.Errorf("unexpected arguments: %v", flags.Args())
+	}
+
+	loaded := Loaded{Config: Default(), File: *flags.configFile, PrintOnly: *flags.printOnly}
+	if len(loaded.File) == 0 {
+		loaded.File, _ = lookupEnv("HOTELS_CONFIG")
+	}
+	if len(loaded.File) > 0 {
+		if err := loadFile(loaded.File, &loaded.Config); err != nil {
+			return Loaded{}, err
+		}
+	}
+
+	for _, s := range settings {
+		for _, name := range append([]string{s.envName()}, s.envAliases...) {
+			if value, ok := lookupEnv(name); ok {
+				if err := s.apply(&loaded.Config, value); err != nil {
+					return Loaded{}, fmt.Errorf("env %s: %w", name, err)
+				}
+				break
+			}
+		}
+	}
+	var flagErr error
+	flags.Visit(func(f *flag.Flag) {
+		if s, ok := settingsByKey[f.Name]; ok && flagErr == nil {
+			if err := s.apply(&loaded.Config, f.Value.String()); err != nil {
+				flagErr = fmt.Errorf("flag -%s: %w", f.Name, err)
+			}
+		}
+	})
+	if flagErr != nil {
+		return Loaded{}, flagErr
+	}
+
+	if err := loaded.Config.Validate(); err != nil {
+		return Loaded{}, err
+	}
+	return loaded, nil
+}
+
+// Usage writes the flags accepted by Load
+func Usage(w io.Writer) {
+	newFlagSet(w).PrintDefaults()
+}
+
+type flagSet struct {
+	*flag.FlagSet
+	configFile *string
+	printOnly  *bool
+}
+
+// newFlagSet declares -config, -print-config and one string flag per setting. Settings are
+// applied from the raw flag values, so that only the flags actually given override the config.
+func newFlagSet(output io.Writer) flagSet {
+	flags := flagSet{FlagSet: flag.NewFlagSet("hotelsDataMerge", flag.ContinueOnError)}
+	flags.SetOutput(output)
+	flags.configFile = flags.String("config", "", "YAML config file (env HOTELS_CONFIG)")
+	flags.printOnly = flags.Bool("print-config", false, "print the effective config, with secrets redacted, and exit")
+	for _, s := range settings {
+		flags.String(s.key, "", fmt.Sprintf("%s (env %s)", s.usage, s.envName()))
+	}
+	return flags
+}
+
+// loadFile overlays the YAML file on config; unknown keys are rejected so that typos are not ignored
+func loadFile(path string, config *Config) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(config); err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("invalid config file %s: %w", path, err)
+	}
+	return nil
+}
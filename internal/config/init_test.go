@@ -0,0 +1,236 @@
+package config
+
+import (
+	"errors"
+	"flag"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func writeConfigFile(t *testing.T, data string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	return path
+}
+
+func envFrom(env map[string]string) LookupEnv {
+	return func(key string) (string, bool) {
+		value, ok := env[key]
+		return value, ok
+	}
+}
+
+func TestLoad(t *testing.T) {
+	configFile := writeConfigFile(t, `
+server:
+  grpc_address: ":9080"
+  gateway_address: ":9090"
+refresh:
+  interval: 30s
+suppliers:
+  urls:
+    acme: http://localhost:9000/acme
+log:
+  level: debug
+  access:
+    method_levels:
+      /proto.HotelDataMerge/GetHotels: warn
+`)
+
+	tests := []struct {
+		name    string
+		args    []string
+		env     map[string]string
+		want    func(config *Config)
+		wantErr bool
+	}{
+		{
+			name: "Success - Defaults",
+			want: func(config *Config) {},
+		},
+		{
+			name: "Success - File from flag",
+			args: []string{"-config", configFile},
+			want: func(config *Config) {
+				config.Server.GRPCAddress = ":9080"
+				config.Server.GatewayAddress = ":9090"
+				config.Refresh.Interval = 30 * time.Second
+				config.Suppliers.URLs = map[string]string{"acme": "http://localhost:9000/acme"}
+				config.Log.Level = "debug"
+				config.Log.Access.MethodLevels["/proto.HotelDataMerge/GetHotels"] = "warn"
+			},
+		},
+		{
+			name: "Success - Environment overrides file",
+			env: map[string]string{
+				"HOTELS_CONFIG":                   configFile,
+				"HOTELS_SERVER_GRPC_ADDRESS":      ":7080",
+				"HOTELS_SUPPLIERS_URLS_PATAGONIA": "http://localhost:9000/patagonia",
+				"HOTELS_REFRESH_INTERVAL":         "0s",
+			},
+			want: func(config *Config) {
+				config.Server.GRPCAddress = ":7080"
+				config.Server.GatewayAddress = ":9090"
+				config.Refresh.Interval = 0
+				config.Suppliers.URLs = map[string]string{
+					"acme":      "http://localhost:9000/acme",
+					"patagonia": "http://localhost:9000/patagonia",
+				}
+				config.Log.Level = "debug"
+				config.Log.Access.MethodLevels["/proto.HotelDataMerge/GetHotels"] = "warn"
+			},
+		},
+		{
+			name: "Success - Flags override environment",
+			args: []string{"-server.grpc_address", ":6080", "-log.format=json"},
+			env:  map[string]string{"HOTELS_SERVER_GRPC_ADDRESS": ":7080", "HOTELS_LOG_FORMAT": "text"},
+			want: func(config *Config) {
+				config.Server.GRPCAddress = ":6080"
+				config.Log.Format = "json"
+			},
+		},
+		{
+			name: "Success - Comma-separated list",
+			env:  map[string]string{"HOTELS_HEALTH_CRITICAL_SUPPLIERS": "acme, paperflies"},
+			want: func(config *Config) {
+				config.Health.CriticalSuppliers = []string{"acme", "paperflies"}
+			},
+		},
+		{
+			name: "Success - Access log settings",
+			args: []string{"-log.access.max_payload_bytes", "1024"},
+			env:  map[string]string{"HOTELS_LOG_ACCESS_REDACT_FIELDS": "token,email"},
+			want: func(config *Config) {
+				config.Log.Access.MaxPayloadBytes = 1024
+				config.Log.Access.RedactFields = []string{"token", "email"}
+			},
+		},
+		{
+			name: "Success - Boolean",
+			args: []string{"-server.reflection", "true"},
+			want: func(config *Config) {
+				config.Server.Reflection = true
+			},
+		},
+		{
+			name: "Success - Integer",
+			args: []string{"-archive.dir", "/var/lib/hotels/archive", "-archive.max_recordings", "20"},
+			want: func(config *Config) {
+				config.Archive.Dir = "/var/lib/hotels/archive"
+				config.Archive.MaxRecordings = 20
+			},
+		},
+		{
+			name: "Success - Text normalization step disabled",
+			args: []string{"-text_normalization.strip_html", "false"},
+			env:  map[string]string{"HOTELS_TEXT_NORMALIZATION_FIX_PUNCTUATION": "false"},
+			want: func(config *Config) {
+				config.TextNormalization.StripHTML = false
+				config.TextNormalization.FixPunctuation = false
+			},
+		},
+		{
+			name: "Success - Description strategy from environment",
+			env:  map[string]string{"HOTELS_MERGE_DESCRIPTION_STRATEGY": "combine"},
+			want: func(config *Config) {
+				config.Merge.DescriptionStrategy = "combine"
+			},
+		},
+		{
+			name: "Success - Environment aliases",
+			env: map[string]string{
+				"AUTH_CONFIG":          "/etc/hotels/auth.json",
+				"OTEL_TRACES_EXPORTER": "stdout",
+			},
+			want: func(config *Config) {
+				config.Auth.ConfigFile = "/etc/hotels/auth.json"
+				config.Tracing.Exporter = "stdout"
+			},
+		},
+		{
+			name: "Success - HOTELS_ variable takes precedence over its alias",
+			env:  map[string]string{"OTEL_TRACES_EXPORTER": "stdout", "HOTELS_TRACING_EXPORTER": "otlp"},
+			want: func(config *Config) {
+				config.Tracing.Exporter = "otlp"
+			},
+		},
+		{
+			name:    "Error - Unknown key in file",
+			args:    []string{"-config", writeConfigFile(t, "server:\n  grpc_adress: \":9080\"\n")},
+			wantErr: true,
+		},
+		{
+			name:    "Error - Missing file",
+			args:    []string{"-config", filepath.Join(t.TempDir(), "missing.yaml")},
+			wantErr: true,
+		},
+		{
+			name:    "Error - Invalid duration in environment",
+			env:     map[string]string{"HOTELS_SUPPLIERS_TIMEOUT": "soon"},
+			wantErr: true,
+		},
+		{
+			name:    "Error - Invalid boolean in environment",
+			env:     map[string]string{"HOTELS_SERVER_REFLECTION": "sometimes"},
+			wantErr: true,
+		},
+		{
+			name:    "Error - Invalid integer in environment",
+			env:     map[string]string{"HOTELS_ARCHIVE_MAX_RECORDINGS": "many"},
+			wantErr: true,
+		},
+		{
+			name:    "Error - Invalid value from flag",
+			args:    []string{"-log.level", "verbose"},
+			wantErr: true,
+		},
+		{
+			name:    "Error - Unknown flag",
+			args:    []string{"-port", "8080"},
+			wantErr: true,
+		},
+		{
+			name:    "Error - Unexpected argument",
+			args:    []string{"serve"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Load(tt.args, envFrom(tt.env))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Load() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			want := Default()
+			tt.want(&want)
+			if !reflect.DeepEqual(got.Config, want) {
+				t.Errorf("Load() = %+v, want %+v", got.Config, want)
+			}
+		})
+	}
+}
+
+func TestLoad_PrintConfig(t *testing.T) {
+	got, err := Load([]string{"-print-config"}, envFrom(nil))
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if !got.PrintOnly {
+		t.Errorf("Load() PrintOnly = false, want true")
+	}
+}
+
+func TestLoad_Help(t *testing.T) {
+	if _, err := Load([]string{"-h"}, envFrom(nil)); !errors.Is(err, flag.ErrHelp) {
+		t.Errorf("Load() error = %v, want %v", err, flag.ErrHelp)
+	}
+}
@@ -0,0 +1,46 @@
+package config
+
+import (
+	"io"
+	"maps"
+	"net/url"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Redacted returns a copy of the config that is safe to print: credentials embedded in
+// supplier URLs are masked. The secrets of the auth config are in their own file and are never loaded here.
+func (c Config) Redacted() Config {
+	redacted := c
+	redacted.Suppliers.URLs = maps.Clone(c.Suppliers.URLs)
+	for supplierName, supplierURL := range redacted.Suppliers.URLs {
+		redacted.Suppliers.URLs[supplierName] = redactURL(supplierURL)
+	}
+	return redacted
+}
+
+// Print writes the redacted config as YAML
+func (c Config) Print(w io.Writer) error {
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(c.Redacted()); err != nil {
+		return err
+	}
+	return encoder.Close()
+}
+
+// redactURL masks the password of the URL and the values of its query parameters, which may hold API keys
+func redactURL(rawURL string) string {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return "xxxxx"
+	}
+	if len(parsed.RawQuery) > 0 {
+		query := parsed.Query()
+		for key := range query {
+			query.Set(key, "xxxxx")
+		}
+		parsed.RawQuery = query.Encode()
+	}
+	return parsed.Redacted()
+}
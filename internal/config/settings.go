@@ -0,0 +1,202 @@
+package config
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"hotelsDataMerge/internal/suppliers/utils"
+)
+
+// setting binds one config field to its command-line flag, named after its YAML path,
+// and to its HOTELS_* environment variable
+type setting struct {
+	key   string
+	usage string
+	// envAliases are other environment variables read when the HOTELS_* one is not set
+	envAliases []string
+	apply      func(config *Config, value string) error
+}
+
+// envName is the key in upper case with dots replaced by underscores, e.g. HOTELS_SERVER_GRPC_ADDRESS
+func (s setting) envName() string {
+	return "HOTELS_" + strings.ToUpper(strings.ReplaceAll(s.key, ".", "_"))
+}
+
+func stringSetting(key string, usage string, field func(config *Config) *string, envAliases ...string) setting {
+	return setting{
+		key:        key,
+		usage:      usage,
+		envAliases: envAliases,
+		apply: func(config *Config, value string) error {
+			*field(config) = value
+			return nil
+		},
+	}
+}
+
+func durationSetting(key string, usage string, field func(config *Config) *time.Duration) setting {
+	return setting{
+		key:   key,
+		usage: usage,
+		apply: func(config *Config, value string) error {
+			duration, err := time.ParseDuration(value)
+			if err != nil {
+				return err
+			}
+			*field(config) = duration
+			return nil
+		},
+	}
+}
+
+func intSetting(key string, usage string, field func(config *Config) *int) setting {
+	return setting{
+		key:   key,
+		usage: usage,
+		apply: func(config *Config, value string) error {
+			number, err := strconv.Atoi(value)
+			if err != nil {
+				return err
+			}
+			*field(config) = number
+			return nil
+		},
+	}
+}
+
+func boolSetting(key string, usage string, field func(config *Config) *bool) setting {
+	return setting{
+		key:   key,
+		usage: usage,
+		apply: func(config *Config, value string) error {
+			enabled, err := strconv.ParseBool(value)
+			if err != nil {
+				return err
+			}
+			*field(config) = enabled
+			return nil
+		},
+	}
+}
+
+// stringListSetting reads a comma-separated list; an empty value clears it
+func stringListSetting(key string, usage string, field func(config *Config) *[]string) setting {
+	return setting{
+		key:   key,
+		usage: usage,
+		apply: func(config *Config, value string) error {
+			var values []string
+			for _, item := range strings.Split(value, ",") {
+				if item = strings.TrimSpace(item); len(item) > 0 {
+					values = append(values, item)
+				}
+			}
+			*field(config) = values
+			return nil
+		},
+	}
+}
+
+func supplierURLSetting(supplierName utils.Suppliers) setting {
+	return setting{
+		key:   "suppliers.urls." + string(supplierName),
+		usage: "URL of the " + string(supplierName) + " supplier",
+		apply: func(config *Config, value string) error {
+			if config.Suppliers.URLs == nil {
+				config.Suppliers.URLs = make(map[string]string)
+			}
+			config.Suppliers.URLs[string(supplierName)] = value
+			return nil
+		},
+	}
+}
+
+var settings = append([]setting{
+	stringSetting("server.grpc_address", "listen address of the public gRPC server",
+		func(c *Config) *string { return &c.Server.GRPCAddress }),
+	stringSetting("server.admin_address", "listen address of the admin gRPC server",
+		func(c *Config) *string { return &c.Server.AdminAddress }),
+	stringSetting("server.gateway_address", "listen address of the REST gateway",
+		func(c *Config) *string { return &c.Server.GatewayAddress }),
+	stringSetting("server.gateway_target", "address the gateway dials to reach the gRPC server",
+		func(c *Config) *string { return &c.Server.GatewayTarget }),
+	durationSetting("server.shutdown_timeout", "time allowed for a graceful shutdown",
+		func(c *Config) *time.Duration { return &c.Server.ShutdownTimeout }),
+	boolSetting("server.reflection", "register gRPC server reflection on the public and admin servers",
+		func(c *Config) *bool { return &c.Server.Reflection }),
+	durationSetting("refresh.interval", "interval between scheduled refreshes, 0 to only refresh at startup",
+		func(c *Config) *time.Duration { return &c.Refresh.Interval }),
+	durationSetting("suppliers.timeout", "timeout of each supplier request",
+		func(c *Config) *time.Duration { return &c.Suppliers.Timeout }),
+	stringSetting("log.level", "log level: debug, info, warn or error",
+		func(c *Config) *string { return &c.Log.Level }),
+	stringSetting("log.format", "log format: text or json",
+		func(c *Config) *string { return &c.Log.Format }),
+	intSetting("log.access.max_payload_bytes", "maximum size of the request summary in the access log",
+		func(c *Config) *int { return &c.Log.Access.MaxPayloadBytes }),
+	stringListSetting("log.access.redact_fields", "comma-separated request fields whose values are not logged",
+		func(c *Config) *[]string { return &c.Log.Access.RedactFields }),
+	stringSetting("tls.cert_file", "TLS certificate file",
+		func(c *Config) *string { return &c.TLS.CertFile }),
+	stringSetting("tls.key_file", "TLS private key file",
+		func(c *Config) *string { return &c.TLS.KeyFile }),
+	stringSetting("tls.client_ca_file", "CA bundle verifying client certificates, enables mutual TLS",
+		func(c *Config) *string { return &c.TLS.ClientCAFile }),
+	stringSetting("tls.client_auth", "client certificate check under mutual TLS: require or verify_if_given",
+		func(c *Config) *string { return &c.TLS.ClientAuth }),
+	stringSetting("tls.ca_file", "CA bundle the gateway verifies the gRPC server against",
+		func(c *Config) *string { return &c.TLS.CAFile }),
+	stringSetting("tls.gateway_cert_file", "client certificate the gateway presents under mutual TLS",
+		func(c *Config) *string { return &c.TLS.GatewayCertFile }),
+	stringSetting("tls.gateway_key_file", "private key of the gateway client certificate",
+		func(c *Config) *string { return &c.TLS.GatewayKeyFile }),
+	durationSetting("tls.reload_interval", "interval between checks for changed TLS files, 0 to disable reloading",
+		func(c *Config) *time.Duration { return &c.TLS.ReloadInterval }),
+	stringSetting("auth.config_file", "API key and JWT config file",
+		func(c *Config) *string { return &c.Auth.ConfigFile }, "AUTH_CONFIG"),
+	stringSetting("rate_limit.config_file", "rate limit config file",
+		func(c *Config) *string { return &c.RateLimit.ConfigFile }, "RATE_LIMIT_CONFIG"),
+	stringSetting("tracing.exporter", "trace exporter: otlp, stdout or none",
+		func(c *Config) *string { return &c.Tracing.Exporter }, "OTEL_TRACES_EXPORTER"),
+	durationSetting("health.max_snapshot_age", "maximum age of the served data for readiness, 0 for no maximum",
+		func(c *Config) *time.Duration { return &c.Health.MaxSnapshotAge }),
+	stringListSetting("health.critical_suppliers", "comma-separated suppliers that must be healthy for readiness",
+		func(c *Config) *[]string { return &c.Health.CriticalSuppliers }),
+	stringSetting("archive.dir", "directory the raw supplier payloads are recorded in, empty to disable recording",
+		func(c *Config) *string { return &c.Archive.Dir }),
+	durationSetting("archive.max_age", "how long recordings are kept, 0 for no maximum",
+		func(c *Config) *time.Duration { return &c.Archive.MaxAge }),
+	intSetting("archive.max_recordings", "how many recordings are kept, 0 for no maximum",
+		func(c *Config) *int { return &c.Archive.MaxRecordings }),
+	stringSetting("archive.replay", "ID of the recording to replay instead of calling the suppliers, or latest",
+		func(c *Config) *string { return &c.Archive.Replay }),
+	stringSetting("merge.description_strategy", "how supplier descriptions are merged: longest or combine",
+		func(c *Config) *string { return &c.Merge.DescriptionStrategy }),
+	boolSetting("text_normalization.strip_html", "convert HTML in supplier values to plain text",
+		func(c *Config) *bool { return &c.TextNormalization.StripHTML }),
+	boolSetting("text_normalization.unicode_nfc", "normalize supplier values to Unicode NFC",
+		func(c *Config) *bool { return &c.TextNormalization.UnicodeNFC }),
+	boolSetting("text_normalization.remove_control_chars", "remove control and zero-width characters from supplier values",
+		func(c *Config) *bool { return &c.TextNormalization.RemoveControlChars }),
+	boolSetting("text_normalization.fix_punctuation", "replace typographic quotes and remove spaces before punctuation",
+		func(c *Config) *bool { return &c.TextNormalization.FixPunctuation }),
+	boolSetting("text_normalization.collapse_whitespace", "collapse runs of whitespace in supplier values",
+		func(c *Config) *bool { return &c.TextNormalization.CollapseWhitespace }),
+}, supplierURLSettings()...)
+
+func supplierURLSettings() []setting {
+	supplierSettings := make([]setting, 0, len(utils.SupplierPriority))
+	for _, supplierName := range utils.SupplierPriority {
+		supplierSettings = append(supplierSettings, supplierURLSetting(supplierName))
+	}
+	return supplierSettings
+}
+
+var settingsByKey = func() map[string]setting {
+	byKey := make(map[string]setting, len(settings))
+	for _, s := range settings {
+		byKey[s.key] = s
+	}
+	return byKey
+}()
@@ -0,0 +1,72 @@
+package config
+
+import (
+	"fmt"
+	"log/slog"
+
+	"hotelsDataMerge/external"
+	"hotelsDataMerge/internal/suppliers"
+	"hotelsDataMerge/internal/suppliers/archive"
+	"hotelsDataMerge/internal/suppliers/fetcher"
+	mergerHotel "hotelsDataMerge/internal/suppliers/merger/hotel"
+	"hotelsDataMerge/internal/suppliers/textnorm"
+	"hotelsDataMerge/internal/suppliers/utils"
+)
+
+// SupplierURLs returns the URL of each supplier by supplier
+func (s SuppliersConfig) SupplierURLs() map[utils.Suppliers]string {
+	urls := make(map[utils.Suppliers]string, len(s.URLs))
+	for supplierName, supplierURL := range s.URLs {
+		urls[utils.Suppliers(supplierName)] = supplierURL
+	}
+	return urls
+}
+
+// SuppliersOptions returns the parsing and merging options of the config, so that the server and the
+// commands parse and merge alike; the config must be valid
+func (c Config) SuppliersOptions() suppliers.Options {
+	options := suppliers.DefaultOptions()
+	options.TextNormalization = textnorm.Options{
+		StripHTML:          c.TextNormalization.StripHTML,
+		UnicodeNFC:         c.TextNormalization.UnicodeNFC,
+		RemoveControlChars: c.TextNormalization.RemoveControlChars,
+		FixPunctuation:     c.TextNormalization.FixPunctuation,
+		CollapseWhitespace: c.TextNormalization.CollapseWhitespace,
+	}
+	options.Merger.DescriptionStrategy = mergerHotel.DescriptionStrategies[c.Merge.DescriptionStrategy]
+	return options
+}
+
+// OpenArchive opens Dir with the retention limits of the config
+func (a ArchiveConfig) OpenArchive(logger *slog.Logger) (archive.IntArchive, error) {
+	intArchive, err := archive.Initialize(logger, a.Dir, archive.Options{
+		MaxAge:        a.MaxAge,
+		MaxRecordings: a.MaxRecordings,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open the archive: %w", err)
+	}
+	return intArchive, nil
+}
+
+// SetupSuppliers returns the recorder of the supplier payloads in Dir, or, with Replay, replaces the
+// suppliers by a recording. Without Dir, the suppliers are returned unchanged.
+func (a ArchiveConfig) SetupSuppliers(extSuppliers external.ExtSuppliers, logger *slog.Logger) (external.ExtSuppliers, fetcher.Recorder, error) {
+	if len(a.Dir) == 0 {
+		return extSuppliers, nil, nil
+	}
+	intArchive, err := a.OpenArchive(logger)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(a.Replay) == 0 {
+		logger.Info("[Archive] Recording supplier payloads", "dir", a.Dir)
+		return extSuppliers, intArchive, nil
+	}
+	replaySuppliers, recording, err := intArchive.Replay(a.Replay)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to replay %s: %w", a.Replay, err)
+	}
+	logger.Warn("[Archive] Suppliers are replayed from a recording and not called", "recording", recording.ID)
+	return replaySuppliers, nil, nil
+}
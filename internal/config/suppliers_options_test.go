@@ -0,0 +1,79 @@
+package config
+
+import (
+	"io"
+	"log/slog"
+	"testing"
+
+	"hotelsDataMerge/external"
+	mergerHotel "hotelsDataMerge/internal/suppliers/merger/hotel"
+)
+
+func TestConfig_SuppliersOptions(t *testing.T) {
+	config := Default()
+	config.Merge.DescriptionStrategy = "combine"
+	config.TextNormalization.StripHTML = false
+
+	options := config.SuppliersOptions()
+	if options.Merger.DescriptionStrategy != mergerHotel.DescriptionCombine {
+		t.Errorf("SuppliersOptions() description strategy = %v, want %v", options.Merger.DescriptionStrategy, mergerHotel.DescriptionCombine)
+	}
+	if options.TextNormalization.StripHTML {
+		t.Errorf("SuppliersOptions() strip HTML = true, want false")
+	}
+}
+
+func TestArchiveConfig_SetupSuppliers(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	extSuppliers := external.Initialize(logger, nil, external.Options{})
+
+	tests := []struct {
+		name          string
+		config        ArchiveConfig
+		wantUnchanged bool
+		wantRecorder  bool
+		wantErr       bool
+	}{
+		{
+			name:          "Success - Archive disabled",
+			config:        ArchiveConfig{},
+			wantUnchanged: true,
+			wantRecorder:  false,
+			wantErr:       false,
+		},
+		{
+			name:          "Success - Payloads recorded",
+			config:        ArchiveConfig{Dir: t.TempDir()},
+			wantUnchanged: true,
+			wantRecorder:  true,
+			wantErr:       false,
+		},
+		{
+			name:    "Error - Replay of a missing recording",
+			config:  ArchiveConfig{Dir: t.TempDir(), Replay: "missing"},
+			wantErr: true,
+		},
+		{
+			name:    "Error - Archive directory is a file",
+			config:  ArchiveConfig{Dir: "suppliers_options_test.go"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotSuppliers, gotRecorder, err := tt.config.SetupSuppliers(extSuppliers, logger)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("SetupSuppliers() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if (gotSuppliers == extSuppliers) != tt.wantUnchanged {
+				t.Errorf("SetupSuppliers() suppliers unchanged = %v, want %v", gotSuppliers == extSuppliers, tt.wantUnchanged)
+			}
+			if (gotRecorder != nil) != tt.wantRecorder {
+				t.Errorf("SetupSuppliers() recorder = %v, want recorder %v", gotRecorder, tt.wantRecorder)
+			}
+		})
+	}
+}
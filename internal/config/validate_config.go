@@ -0,0 +1,135 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/url"
+	"strings"
+
+	"hotelsDataMerge/internal/suppliers/utils"
+)
+
+// Validate returns every invalid setting of the config at once
+func (c Config) Validate() error {
+	var errs []error
+	addresses := map[string]string{
+		"server.grpc_address":    c.Server.GRPCAddress,
+		"server.admin_address":   c.Server.AdminAddress,
+		"server.gateway_address": c.Server.GatewayAddress,
+		"server.gateway_target":  c.Server.GatewayTarget,
+	}
+	for key, address := range addresses {
+		if _, _, err := net.SplitHostPort(address); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", key, err))
+		}
+	}
+
+	if c.Server.ShutdownTimeout <= 0 {
+		errs = append(errs, fmt.Errorf("server.shutdown_timeout must be positive"))
+	}
+	if c.Refresh.Interval < 0 {
+		errs = append(errs, fmt.Errorf("refresh.interval must not be negative"))
+	}
+	if c.Suppliers.Timeout <= 0 {
+		errs = append(errs, fmt.Errorf("suppliers.timeout must be positive"))
+	}
+	for supplierName, supplierURL := range c.Suppliers.URLs {
+		if !isKnownSupplier(supplierName) {
+			errs = append(errs, fmt.Errorf("suppliers.urls.%s: unknown supplier", supplierName))
+			continue
+		}
+		parsed, err := url.Parse(supplierURL)
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || len(parsed.Host) == 0 {
+			errs = append(errs, fmt.Errorf("suppliers.urls.%s: must be an absolute http or https URL", supplierName))
+		}
+	}
+
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(c.Log.Level)); err != nil {
+		errs = append(errs, fmt.Errorf("log.level: %q is not debug, info, warn or error", c.Log.Level))
+	}
+	if c.Log.Format != "text" && c.Log.Format != "json" {
+		errs = append(errs, fmt.Errorf("log.format: %q is not text or json", c.Log.Format))
+	}
+	for method, methodLevel := range c.Log.Access.MethodLevels {
+		if !strings.HasPrefix(method, "/") {
+			errs = append(errs, fmt.Errorf("log.access.method_levels: %q is not a full method name", method))
+		}
+		if err := level.UnmarshalText([]byte(methodLevel)); err != nil {
+			errs = append(errs, fmt.Errorf("log.access.method_levels.%s: %q is not debug, info, warn or error", method, methodLevel))
+		}
+	}
+	if c.Log.Access.MaxPayloadBytes <= 0 {
+		errs = append(errs, fmt.Errorf("log.access.max_payload_bytes must be positive"))
+	}
+	if (len(c.TLS.CertFile) == 0) != (len(c.TLS.KeyFile) == 0) {
+		errs = append(errs, fmt.Errorf("tls.cert_file and tls.key_file must be set together"))
+	}
+	if (len(c.TLS.GatewayCertFile) == 0) != (len(c.TLS.GatewayKeyFile) == 0) {
+		errs = append(errs, fmt.Errorf("tls.gateway_cert_file and tls.gateway_key_file must be set together"))
+	}
+	if len(c.TLS.CertFile) == 0 && (len(c.TLS.ClientCAFile) > 0 || len(c.TLS.CAFile) > 0 || len(c.TLS.GatewayCertFile) > 0) {
+		errs = append(errs, fmt.Errorf("tls.client_ca_file, tls.ca_file and tls.gateway_cert_file require tls.cert_file"))
+	}
+	if c.TLS.ClientAuth != "require" && c.TLS.ClientAuth != "verify_if_given" {
+		errs = append(errs, fmt.Errorf("tls.client_auth: %q is not require or verify_if_given", c.TLS.ClientAuth))
+	}
+	if c.TLS.ReloadInterval < 0 {
+		errs = append(errs, fmt.Errorf("tls.reload_interval must not be negative"))
+	}
+	if c.Health.MaxSnapshotAge < 0 {
+		errs = append(errs, fmt.Errorf("health.max_snapshot_age must not be negative"))
+	}
+	for _, supplierName := range c.Health.CriticalSuppliers {
+		if !isKnownSupplier(supplierName) {
+			errs = append(errs, fmt.Errorf("health.critical_suppliers: unknown supplier %q", supplierName))
+		}
+	}
+	if c.Archive.MaxAge < 0 {
+		errs = append(errs, fmt.Errorf("archive.max_age must not be negative"))
+	}
+	if c.Archive.MaxRecordings < 0 {
+		errs = append(errs, fmt.Errorf("archive.max_recordings must not be negative"))
+	}
+	if len(c.Archive.Replay) > 0 && len(c.Archive.Dir) == 0 {
+		errs = append(errs, fmt.Errorf("archive.replay requires archive.dir"))
+	}
+	if c.Merge.DescriptionStrategy != "longest" && c.Merge.DescriptionStrategy != "combine" {
+		errs = append(errs, fmt.Errorf("merge.description_strategy: %q is not longest or combine", c.Merge.DescriptionStrategy))
+	}
+	switch c.Tracing.Exporter {
+	case "otlp", "stdout", "none", "":
+	default:
+		errs = append(errs, fmt.Errorf("tracing.exporter: %q is not otlp, stdout or none", c.Tracing.Exporter))
+	}
+	return errors.Join(errs...)
+}
+
+// SlogLevel returns the parsed level; the config must be valid
+func (l LogConfig) SlogLevel() slog.Level {
+	var level slog.Level
+	_ = level.UnmarshalText([]byte(l.Level))
+	return level
+}
+
+// SlogMethodLevels returns the parsed level of each method; the config must be valid
+func (a AccessLogConfig) SlogMethodLevels() map[string]slog.Level {
+	levels := make(map[string]slog.Level, len(a.MethodLevels))
+	for method, methodLevel := range a.MethodLevels {
+		var level slog.Level
+		_ = level.UnmarshalText([]byte(methodLevel))
+		levels[method] = level
+	}
+	return levels
+}
+
+func isKnownSupplier(supplierName string) bool {
+	for _, known := range utils.SupplierPriority {
+		if string(known) == supplierName {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,195 @@
+package config
+
+import (
+	"log/slog"
+	"reflect"
+	"testing"
+)
+
+func TestConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(config *Config)
+		wantErr bool
+	}{
+		{
+			name:    "Success - Defaults",
+			modify:  func(config *Config) {},
+			wantErr: false,
+		},
+		{
+			name: "Success - Supplier URL and TLS",
+			modify: func(config *Config) {
+				config.Suppliers.URLs = map[string]string{"paperflies": "https://suppliers.example.com/paperflies"}
+				config.TLS.CertFile, config.TLS.KeyFile = "server.crt", "server.key"
+			},
+			wantErr: false,
+		},
+		{
+			name: "Success - Replay from the archive",
+			modify: func(config *Config) {
+				config.Archive.Dir, config.Archive.Replay = "archive", "latest"
+			},
+			wantErr: false,
+		},
+		{
+			name:    "Error - Unknown description strategy",
+			modify:  func(config *Config) { config.Merge.DescriptionStrategy = "shortest" },
+			wantErr: true,
+		},
+		{
+			name:    "Error - Replay without archive",
+			modify:  func(config *Config) { config.Archive.Replay = "latest" },
+			wantErr: true,
+		},
+		{
+			name:    "Error - Negative archive retention",
+			modify:  func(config *Config) { config.Archive.MaxRecordings = -1 },
+			wantErr: true,
+		},
+		{
+			name:    "Error - Address without port",
+			modify:  func(config *Config) { config.Server.GRPCAddress = "8080" },
+			wantErr: true,
+		},
+		{
+			name:    "Error - Unknown critical supplier",
+			modify:  func(config *Config) { config.Health.CriticalSuppliers = []string{"globex"} },
+			wantErr: true,
+		},
+		{
+			name:    "Error - Negative snapshot age",
+			modify:  func(config *Config) { config.Health.MaxSnapshotAge = -1 },
+			wantErr: true,
+		},
+		{
+			name:    "Error - Zero shutdown timeout",
+			modify:  func(config *Config) { config.Server.ShutdownTimeout = 0 },
+			wantErr: true,
+		},
+		{
+			name:    "Error - Negative refresh interval",
+			modify:  func(config *Config) { config.Refresh.Interval = -1 },
+			wantErr: true,
+		},
+		{
+			name:    "Error - Zero supplier timeout",
+			modify:  func(config *Config) { config.Suppliers.Timeout = 0 },
+			wantErr: true,
+		},
+		{
+			name: "Error - Unknown supplier",
+			modify: func(config *Config) {
+				config.Suppliers.URLs = map[string]string{"globex": "https://globex.example.com"}
+			},
+			wantErr: true,
+		},
+		{
+			name:    "Error - Relative supplier URL",
+			modify:  func(config *Config) { config.Suppliers.URLs = map[string]string{"acme": "/suppliers/acme"} },
+			wantErr: true,
+		},
+		{
+			name:    "Error - Unknown log format",
+			modify:  func(config *Config) { config.Log.Format = "logfmt" },
+			wantErr: true,
+		},
+		{
+			name: "Success - Mutual TLS",
+			modify: func(config *Config) {
+				config.TLS.CertFile, config.TLS.KeyFile = "server.crt", "server.key"
+				config.TLS.ClientCAFile, config.TLS.ClientAuth = "clients-ca.crt", "verify_if_given"
+				config.TLS.GatewayCertFile, config.TLS.GatewayKeyFile = "gateway.crt", "gateway.key"
+			},
+			wantErr: false,
+		},
+		{
+			name:    "Error - Client CA without server certificate",
+			modify:  func(config *Config) { config.TLS.ClientCAFile = "clients-ca.crt" },
+			wantErr: true,
+		},
+		{
+			name:    "Error - Unknown client auth",
+			modify:  func(config *Config) { config.TLS.ClientAuth = "optional" },
+			wantErr: true,
+		},
+		{
+			name:    "Error - TLS certificate without key",
+			modify:  func(config *Config) { config.TLS.CertFile = "server.crt" },
+			wantErr: true,
+		},
+		{
+			name: "Success - Access log method level",
+			modify: func(config *Config) {
+				config.Log.Access.MethodLevels["/proto.HotelDataMerge/GetHotels"] = "warn"
+			},
+			wantErr: false,
+		},
+		{
+			name: "Error - Unknown access log level",
+			modify: func(config *Config) {
+				config.Log.Access.MethodLevels["/proto.HotelDataMerge/GetHotels"] = "verbose"
+			},
+			wantErr: true,
+		},
+		{
+			name: "Error - Access log level of a short method name",
+			modify: func(config *Config) {
+				config.Log.Access.MethodLevels["GetHotels"] = "debug"
+			},
+			wantErr: true,
+		},
+		{
+			name:    "Error - Access log without payload",
+			modify:  func(config *Config) { config.Log.Access.MaxPayloadBytes = 0 },
+			wantErr: true,
+		},
+		{
+			name:    "Error - Unknown trace exporter",
+			modify:  func(config *Config) { config.Tracing.Exporter = "jaeger" },
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := Default()
+			tt.modify(&config)
+			if err := config.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLogConfig_SlogLevel(t *testing.T) {
+	tests := []struct {
+		name  string
+		level string
+		want  slog.Level
+	}{
+		{name: "Success - Debug", level: "debug", want: slog.LevelDebug},
+		{name: "Success - Upper case", level: "WARN", want: slog.LevelWarn},
+		{name: "Success - Error", level: "error", want: slog.LevelError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := (LogConfig{Level: tt.level}).SlogLevel(); got != tt.want {
+				t.Errorf("SlogLevel() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAccessLogConfig_SlogMethodLevels(t *testing.T) {
+	accessConfig := AccessLogConfig{MethodLevels: map[string]string{
+		"/proto.HotelDataMerge/GetHotels": "warn",
+		"/proto.HotelDataMerge/GetHotel":  "DEBUG",
+	}}
+	want := map[string]slog.Level{
+		"/proto.HotelDataMerge/GetHotels": slog.LevelWarn,
+		"/proto.HotelDataMerge/GetHotel":  slog.LevelDebug,
+	}
+	if got := accessConfig.SlogMethodLevels(); !reflect.DeepEqual(got, want) {
+		t.Errorf("SlogMethodLevels() = %v, want %v", got, want)
+	}
+}
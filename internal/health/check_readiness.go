@@ -0,0 +1,59 @@
+package health
+
+import (
+	"fmt"
+	"slices"
+
+	"hotelsDataMerge/internal/pipeline"
+)
+
+func (h *intHealth) Readiness() Report {
+	report := Report{Ready: true}
+	notReady := func(format string, args ...any) {
+		report.Ready = false
+		report.Reasons = append(report.Reasons, fmt.Sprintf(format, args...))
+	}
+
+	snapshot, loaded := h.pipeline.GetSnapshot()
+	if loaded {
+		age := h.now().Sub(snapshot.LoadedAt)
+		report.Snapshot = &SnapshotReport{
+			Version:    snapshot.Version,
+			LoadedAt:   snapshot.LoadedAt,
+			Age:        age.String(),
+			HotelCount: snapshot.HotelCount,
+		}
+		if h.config.MaxSnapshotAge > 0 && age > h.config.MaxSnapshotAge {
+			notReady("snapshot is %s old, more than the maximum of %s", age, h.config.MaxSnapshotAge)
+		}
+	} else {
+		notReady("no snapshot loaded yet")
+	}
+
+	for _, supplierStatus := range h.pipeline.ListSuppliers() {
+		supplierReport := constructSupplierReport(supplierStatus)
+		supplierReport.Critical = slices.Contains(h.config.CriticalSuppliers, supplierStatus.Supplier)
+		if supplierReport.Critical && !supplierReport.Healthy {
+			notReady("critical supplier %s is unhealthy", supplierStatus.Supplier)
+		}
+		report.Suppliers = append(report.Suppliers, supplierReport)
+	}
+	return report
+}
+
+// constructSupplierReport considers a supplier healthy when it has data and its last fetch did not fail;
+// a supplier skipped by a partial refresh stays healthy
+func constructSupplierReport(supplierStatus pipeline.SupplierStatus) SupplierReport {
+	supplierReport := SupplierReport{
+		Supplier:    supplierStatus.Supplier,
+		State:       supplierStatus.State,
+		Healthy:     !supplierStatus.LastSuccessAt.IsZero() && supplierStatus.State != pipeline.SupplierFailed,
+		RecordCount: supplierStatus.RecordCount,
+		Error:       supplierStatus.Failure,
+	}
+	if !supplierStatus.LastSuccessAt.IsZero() {
+		lastSuccessAt := supplierStatus.LastSuccessAt
+		supplierReport.LastSuccessAt = &lastSuccessAt
+	}
+	return supplierReport
+}
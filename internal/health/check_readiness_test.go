@@ -0,0 +1,142 @@
+package health
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"hotelsDataMerge/internal/pipeline"
+	"hotelsDataMerge/internal/suppliers/utils"
+)
+
+var testNow = time.Date(2026, 10, 19, 8, 0, 0, 0, time.UTC)
+
+type mockPipeline struct {
+	pipeline.IntPipeline
+	snapshot  *pipeline.Snapshot
+	suppliers []pipeline.SupplierStatus
+}
+
+func (m *mockPipeline) GetSnapshot() (pipeline.Snapshot, bool) {
+	if m.snapshot == nil {
+		return pipeline.Snapshot{}, false
+	}
+	return *m.snapshot, true
+}
+
+func (m *mockPipeline) ListSuppliers() []pipeline.SupplierStatus {
+	return m.suppliers
+}
+
+func newTestHealth(intPipeline pipeline.IntPipeline, config Config) *intHealth {
+	h := Initialize(intPipeline, config).(*intHealth)
+	h.now = func() time.Time { return testNow }
+	return h
+}
+
+func Test_intHealth_Readiness(t *testing.T) {
+	lastSuccessAt := testNow.Add(-time.Minute)
+	healthySuppliers := []pipeline.SupplierStatus{
+		{Supplier: utils.Paperflies, State: pipeline.SupplierOK, RecordCount: 2, LastSuccessAt: lastSuccessAt},
+		{Supplier: utils.Patagonia, State: pipeline.SupplierSkipped, RecordCount: 1, LastSuccessAt: lastSuccessAt},
+		{Supplier: utils.Acme, State: pipeline.SupplierFailed, Error: `Get "https://acme.example/hotels?key=secret": context deadline exceeded`, Failure: "fetch failed: timeout"},
+	}
+	tests := []struct {
+		name          string
+		pipeline      *mockPipeline
+		config        Config
+		wantReady     bool
+		wantReasons   []string
+		wantSuppliers []SupplierReport
+	}{
+		{
+			name: "Success - Fresh snapshot without critical suppliers",
+			pipeline: &mockPipeline{
+				snapshot:  &pipeline.Snapshot{Version: 3, LoadedAt: testNow.Add(-time.Minute), HotelCount: 3},
+				suppliers: healthySuppliers,
+			},
+			config:    Config{MaxSnapshotAge: 30 * time.Minute},
+			wantReady: true,
+			wantSuppliers: []SupplierReport{
+				{Supplier: utils.Paperflies, State: pipeline.SupplierOK, Healthy: true, RecordCount: 2, LastSuccessAt: &lastSuccessAt},
+				{Supplier: utils.Patagonia, State: pipeline.SupplierSkipped, Healthy: true, RecordCount: 1, LastSuccessAt: &lastSuccessAt},
+				{Supplier: utils.Acme, State: pipeline.SupplierFailed, Error: "fetch failed: timeout"},
+			},
+		},
+		{
+			name: "Success - Healthy critical suppliers and no maximum age",
+			pipeline: &mockPipeline{
+				snapshot:  &pipeline.Snapshot{Version: 3, LoadedAt: testNow.Add(-24 * time.Hour), HotelCount: 3},
+				suppliers: healthySuppliers,
+			},
+			config:    Config{CriticalSuppliers: []utils.Suppliers{utils.Paperflies, utils.Patagonia}},
+			wantReady: true,
+			wantSuppliers: []SupplierReport{
+				{Supplier: utils.Paperflies, State: pipeline.SupplierOK, Critical: true, Healthy: true, RecordCount: 2, LastSuccessAt: &lastSuccessAt},
+				{Supplier: utils.Patagonia, State: pipeline.SupplierSkipped, Critical: true, Healthy: true, RecordCount: 1, LastSuccessAt: &lastSuccessAt},
+				{Supplier: utils.Acme, State: pipeline.SupplierFailed, Error: "fetch failed: timeout"},
+			},
+		},
+		{
+			name:        "Error - No snapshot loaded",
+			pipeline:    &mockPipeline{suppliers: []pipeline.SupplierStatus{{Supplier: utils.Acme}}},
+			config:      Config{MaxSnapshotAge: 30 * time.Minute},
+			wantReady:   false,
+			wantReasons: []string{"no snapshot loaded yet"},
+			wantSuppliers: []SupplierReport{
+				{Supplier: utils.Acme},
+			},
+		},
+		{
+			name: "Error - Snapshot too old",
+			pipeline: &mockPipeline{
+				snapshot: &pipeline.Snapshot{Version: 3, LoadedAt: testNow.Add(-time.Hour), HotelCount: 3},
+			},
+			config:      Config{MaxSnapshotAge: 30 * time.Minute},
+			wantReady:   false,
+			wantReasons: []string{"snapshot is 1h0m0s old, more than the maximum of 30m0s"},
+		},
+		{
+			name: "Error - Critical supplier failed",
+			pipeline: &mockPipeline{
+				snapshot:  &pipeline.Snapshot{Version: 3, LoadedAt: testNow.Add(-time.Minute), HotelCount: 3},
+				suppliers: healthySuppliers,
+			},
+			config:      Config{CriticalSuppliers: []utils.Suppliers{utils.Acme}},
+			wantReady:   false,
+			wantReasons: []string{"critical supplier acme is unhealthy"},
+			wantSuppliers: []SupplierReport{
+				{Supplier: utils.Paperflies, State: pipeline.SupplierOK, Healthy: true, RecordCount: 2, LastSuccessAt: &lastSuccessAt},
+				{Supplier: utils.Patagonia, State: pipeline.SupplierSkipped, Healthy: true, RecordCount: 1, LastSuccessAt: &lastSuccessAt},
+				{Supplier: utils.Acme, State: pipeline.SupplierFailed, Critical: true, Error: "fetch failed: timeout"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := newTestHealth(tt.pipeline, tt.config).Readiness()
+			if got.Ready != tt.wantReady {
+				t.Errorf("Readiness() ready = %v, want %v", got.Ready, tt.wantReady)
+			}
+			if !reflect.DeepEqual(got.Reasons, tt.wantReasons) {
+				t.Errorf("Readiness() reasons = %v, want %v", got.Reasons, tt.wantReasons)
+			}
+			if !reflect.DeepEqual(got.Suppliers, tt.wantSuppliers) {
+				t.Errorf("Readiness() suppliers = %+v, want %+v", got.Suppliers, tt.wantSuppliers)
+			}
+			if (got.Snapshot != nil) != (tt.pipeline.snapshot != nil) {
+				t.Errorf("Readiness() snapshot = %v, want one %v", got.Snapshot, tt.pipeline.snapshot != nil)
+			}
+		})
+	}
+}
+
+func Test_intHealth_Readiness_Snapshot(t *testing.T) {
+	h := newTestHealth(&mockPipeline{
+		snapshot: &pipeline.Snapshot{Version: 3, LoadedAt: testNow.Add(-90 * time.Second), HotelCount: 5},
+	}, Config{})
+	want := &SnapshotReport{Version: 3, LoadedAt: testNow.Add(-90 * time.Second), Age: "1m30s", HotelCount: 5}
+	if got := h.Readiness().Snapshot; !reflect.DeepEqual(got, want) {
+		t.Errorf("Readiness() snapshot = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,66 @@
+package health
+
+import (
+	"context"
+	"time"
+
+	"hotelsDataMerge/internal/pipeline"
+	"hotelsDataMerge/internal/suppliers/utils"
+
+	grpchealth "google.golang.org/grpc/health"
+)
+
+type Config struct {
+	// MaxSnapshotAge is how old the served snapshot may be before the service is no longer ready; 0 means no maximum
+	MaxSnapshotAge time.Duration
+	// CriticalSuppliers must have succeeded in their last refresh for the service to be ready
+	CriticalSuppliers []utils.Suppliers
+}
+
+// Report is the readiness of the service, with the reasons it is not ready and the detail of each supplier
+type Report struct {
+	Ready     bool             `json:"ready"`
+	Reasons   []string         `json:"reasons,omitempty"`
+	Snapshot  *SnapshotReport  `json:"snapshot,omitempty"`
+	Suppliers []SupplierReport `json:"suppliers"`
+}
+
+type SnapshotReport struct {
+	Version    int64     `json:"version"`
+	LoadedAt   time.Time `json:"loaded_at"`
+	Age        string    `json:"age"`
+	HotelCount int       `json:"hotel_count"`
+}
+
+type SupplierReport struct {
+	Supplier      utils.Suppliers        `json:"supplier"`
+	State         pipeline.SupplierState `json:"state,omitempty"`
+	Critical      bool                   `json:"critical"`
+	Healthy       bool                   `json:"healthy"`
+	RecordCount   int                    `json:"record_count"`
+	LastSuccessAt *time.Time             `json:"last_success_at,omitempty"`
+	// Error is the stage and kind of the last failure; its details are only reported on the admin API
+	Error string `json:"error,omitempty"`
+}
+
+type IntHealth interface {
+	// Readiness reports whether the service has data fresh enough to serve
+	Readiness() Report
+	// UpdateGRPCStatus sets the serving status of the services on svr from Readiness every interval
+	// until ctx is done
+	UpdateGRPCStatus(ctx context.Context, svr *grpchealth.Server, services []string, interval time.Duration)
+}
+
+type intHealth struct {
+	pipeline pipeline.IntPipeline
+	config   Config
+	now      func() time.Time
+}
+
+func Initialize(intPipeline pipeline.IntPipeline, config Config) IntHealth {
+	return &intHealth{
+		pipeline: intPipeline,
+		config:   config,
+		now:      time.Now,
+	}
+}
@@ -0,0 +1,29 @@
+package health
+
+import (
+	"context"
+	"time"
+
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func (h *intHealth) UpdateGRPCStatus(ctx context.Context, svr *grpchealth.Server, services []string, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		servingStatus := healthpb.HealthCheckResponse_NOT_SERVING
+		if h.Readiness().Ready {
+			servingStatus = healthpb.HealthCheckResponse_SERVING
+		}
+		for _, service := range services {
+			svr.SetServingStatus(service, servingStatus)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
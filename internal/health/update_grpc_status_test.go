@@ -0,0 +1,50 @@
+package health
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"hotelsDataMerge/internal/pipeline"
+
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func Test_intHealth_UpdateGRPCStatus(t *testing.T) {
+	tests := []struct {
+		name     string
+		pipeline *mockPipeline
+		want     healthpb.HealthCheckResponse_ServingStatus
+	}{
+		{
+			name:     "Success - Ready",
+			pipeline: &mockPipeline{snapshot: &pipeline.Snapshot{Version: 1, LoadedAt: testNow}},
+			want:     healthpb.HealthCheckResponse_SERVING,
+		},
+		{
+			name:     "Success - Not ready",
+			pipeline: &mockPipeline{},
+			want:     healthpb.HealthCheckResponse_NOT_SERVING,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svr := grpchealth.NewServer()
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+			// With ctx already done, the status is set once before returning
+			newTestHealth(tt.pipeline, Config{}).UpdateGRPCStatus(ctx, svr, []string{"", "proto.HotelDataMerge"}, time.Hour)
+
+			for _, service := range []string{"", "proto.HotelDataMerge"} {
+				resp, err := svr.Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+				if err != nil {
+					t.Fatalf("Check(%q) error = %v", service, err)
+				}
+				if resp.GetStatus() != tt.want {
+					t.Errorf("Check(%q) = %v, want %v", service, resp.GetStatus(), tt.want)
+				}
+			}
+		})
+	}
+}
@@ -9,6 +9,7 @@ type Hotel struct {
 	Amenities         *HotelAmenities `json:"amenities"`
 	Images            *HotelImages    `json:"images"`
 	BookingConditions []string        `json:"booking_conditions"`
+	BookingPolicy     *BookingPolicy  `json:"booking_policy"`
 }
 
 type HotelLocation struct {
@@ -34,3 +35,61 @@ type HotelImageDetails struct {
 	Link        string `json:"link"`
 	Description string `json:"description"`
 }
+
+type PetPolicy string
+
+const (
+	PetsUnknown    PetPolicy = ""
+	PetsAllowed    PetPolicy = "allowed"
+	PetsNotAllowed PetPolicy = "not_allowed"
+	PetsOnRequest  PetPolicy = "on_request"
+)
+
+// BookingPolicy is the structured form of a hotel's free-text booking conditions
+type BookingPolicy struct {
+	CheckInFrom           string              `json:"check_in_from"`
+	CheckOutUntil         string              `json:"check_out_until"`
+	Pets                  PetPolicy           `json:"pets"`
+	Cancellation          *CancellationPolicy `json:"cancellation"`
+	DepositRequired       bool                `json:"deposit_required"`
+	PrepaymentRequired    bool                `json:"prepayment_required"`
+	PhotoIDRequired       bool                `json:"photo_id_required"`
+	CreditCardRequired    bool                `json:"credit_card_required"`
+	Children              *ChildPolicy        `json:"children"`
+	UnrecognizedSentences []string            `json:"unrecognized_sentences"`
+}
+
+type CancellationPolicy struct {
+	FreeCancellation      bool `json:"free_cancellation"`
+	FreeCancellationHours int  `json:"free_cancellation_hours"`
+	NonRefundable         bool `json:"non_refundable"`
+}
+
+type ChildPolicy struct {
+	// ChildrenAllowed is nil when no booking condition says whether children are allowed
+	ChildrenAllowed    *bool  `json:"children_allowed"`
+	FreeStayUnderAge   int    `json:"free_stay_under_age"`
+	MaxCribs           int    `json:"max_cribs"`
+	ExtraBedsAvailable bool   `json:"extra_beds_available"`
+	ExtraBedCharge     string `json:"extra_bed_charge"`
+}
+
+// Destination is the metadata derived from the merged hotels of one destination
+type Destination struct {
+	Id          uint64       `json:"id"`
+	HotelCount  int          `json:"hotel_count"`
+	City        string       `json:"city"`
+	Country     string       `json:"country"`
+	BoundingBox *BoundingBox `json:"bounding_box"`
+	Centroid    *Coordinates `json:"centroid"`
+}
+
+type BoundingBox struct {
+	SouthWest Coordinates `json:"south_west"`
+	NorthEast Coordinates `json:"north_east"`
+}
+
+type Coordinates struct {
+	Lat float64 `json:"lat"`
+	Lng float64 `json:"lng"`
+}
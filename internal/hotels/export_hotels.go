@@ -0,0 +1,44 @@
+package hotels
+
+import (
+	"iter"
+	"slices"
+)
+
+// Export is a consistent view of the hotels saved by one SaveMaps call. SaveMaps replaces the
+// maps instead of modifying them, so an export can be read after the caller releases its lock.
+type Export struct {
+	Version  int64
+	hotelIDs []string
+	hotels   map[string]Hotel
+}
+
+// ExportHotels returns the hotels currently saved, ordered by ID
+func (i *intHotels) ExportHotels() Export {
+	hotelIDs := make([]string, 0, len(hotelByHotelIDMap))
+	for hotelID := range hotelByHotelIDMap {
+		hotelIDs = append(hotelIDs, hotelID)
+	}
+	slices.Sort(hotelIDs)
+	return Export{
+		Version:  snapshotVersion,
+		hotelIDs: hotelIDs,
+		hotels:   hotelByHotelIDMap,
+	}
+}
+
+// Len returns the number of exported hotels
+func (e Export) Len() int {
+	return len(e.hotelIDs)
+}
+
+// Hotels yields the exported hotels one at a time, ordered by ID
+func (e Export) Hotels() iter.Seq[Hotel] {
+	return func(yield func(Hotel) bool) {
+		for _, hotelID := range e.hotelIDs {
+			if !yield(e.hotels[hotelID]) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package hotels
+
+import (
+	"context"
+	"log/slog"
+	"reflect"
+	"testing"
+)
+
+func Test_intHotels_ExportHotels(t *testing.T) {
+	defer ClearMaps()
+
+	tests := []struct {
+		name        string
+		hotels      map[string]Hotel
+		version     int64
+		wantIDs     []string
+		wantVersion int64
+	}{
+		{
+			name: "Success - Ordered by ID",
+			hotels: map[string]Hotel{
+				"b2": {Id: "b2"},
+				"a1": {Id: "a1"},
+				"c3": {Id: "c3"},
+			},
+			version:     4,
+			wantIDs:     []string{"a1", "b2", "c3"},
+			wantVersion: 4,
+		},
+		{
+			name:        "Success - No hotels",
+			hotels:      map[string]Hotel{},
+			version:     0,
+			wantIDs:     nil,
+			wantVersion: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SaveMaps(context.Background(), tt.hotels)
+			SetSnapshotVersion(tt.version)
+			i := &intHotels{
+				logger: slog.Default(),
+			}
+			got := i.ExportHotels()
+
+			var gotIDs []string
+			for hotel := range got.Hotels() {
+				gotIDs = append(gotIDs, hotel.Id)
+			}
+			if !reflect.DeepEqual(gotIDs, tt.wantIDs) || got.Len() != len(tt.wantIDs) {
+				t.Errorf("ExportHotels() hotels = %v (len %d), want %v", gotIDs, got.Len(), tt.wantIDs)
+			}
+			if got.Version != tt.wantVersion {
+				t.Errorf("ExportHotels() version = %d, want %d", got.Version, tt.wantVersion)
+			}
+		})
+	}
+}
+
+func Test_intHotels_ExportHotels_Consistent(t *testing.T) {
+	defer ClearMaps()
+	SaveMaps(context.Background(), map[string]Hotel{"a1": {Id: "a1", Name: "Before"}})
+	SetSnapshotVersion(1)
+	i := &intHotels{
+		logger: slog.Default(),
+	}
+	export := i.ExportHotels()
+
+	SaveMaps(context.Background(), map[string]Hotel{"a1": {Id: "a1", Name: "After"}, "b2": {Id: "b2"}})
+	SetSnapshotVersion(2)
+
+	var got []Hotel
+	for hotel := range export.Hotels() {
+		got = append(got, hotel)
+	}
+	want := []Hotel{{Id: "a1", Name: "Before"}}
+	if !reflect.DeepEqual(got, want) || export.Version != 1 {
+		t.Errorf("ExportHotels() after SaveMaps = %v (version %d), want %v (version 1)", got, export.Version, want)
+	}
+}
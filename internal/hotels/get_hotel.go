@@ -0,0 +1,7 @@
+package hotels
+
+// GetHotel returns the merged hotel with the given ID
+func (i *intHotels) GetHotel(hotelID string) (Hotel, bool) {
+	hotel, ok := hotelByHotelIDMap[hotelID]
+	return hotel, ok
+}
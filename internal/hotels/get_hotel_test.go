@@ -0,0 +1,53 @@
+package hotels
+
+import (
+	"context"
+	"log/slog"
+	"reflect"
+	"testing"
+)
+
+func Test_intHotels_GetHotel(t *testing.T) {
+	SaveMaps(context.Background(), map[string]Hotel{
+		"hotel1": {
+			Id:            "hotel1",
+			DestinationId: 123,
+			Name:          "Hotel 1",
+		},
+	})
+
+	tests := []struct {
+		name    string
+		hotelID string
+		want    Hotel
+		wantOk  bool
+	}{
+		{
+			name:    "Success - Get existing hotel",
+			hotelID: "hotel1",
+			want: Hotel{
+				Id:            "hotel1",
+				DestinationId: 123,
+				Name:          "Hotel 1",
+			},
+			wantOk: true,
+		},
+		{
+			name:    "Error - Hotel does not exist",
+			hotelID: "hotel2",
+			want:    Hotel{},
+			wantOk:  false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			i := &intHotels{
+				logger: slog.Default(),
+			}
+			got, ok := i.GetHotel(tt.hotelID)
+			if !reflect.DeepEqual(got, tt.want) || ok != tt.wantOk {
+				t.Errorf("GetHotel() = %v, %v, want %v, %v", got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+}
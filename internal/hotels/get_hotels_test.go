@@ -1,6 +1,7 @@
 package hotels
 
 import (
+	"context"
 	"log/slog"
 	"reflect"
 	"testing"
@@ -124,7 +125,7 @@ func Test_intHotels_GetHotels(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			SaveMaps(map[string]Hotel{
+			SaveMaps(context.Background(), map[string]Hotel{
 				"hotel1": {
 					Id:            "hotel1",
 					DestinationId: 123,
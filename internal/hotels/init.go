@@ -1,7 +1,12 @@
 package hotels
 
 import (
+	"context"
 	"log/slog"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 var (
@@ -10,10 +15,19 @@ var (
 
 	destinationIDsMap        = make(map[uint64]bool)
 	hotelsByDestinationIdMap = make(map[uint64][]Hotel)
+
+	// snapshotVersion identifies the hotels saved by the last SaveMaps call
+	snapshotVersion int64
 )
 
+var tracer = otel.Tracer("hotelsDataMerge/internal/hotels")
+
 type IntHotels interface {
 	GetHotels(hotelIDs []string, destinationID uint64) (hotels []Hotel, err error)
+	GetHotel(hotelID string) (hotel Hotel, ok bool)
+	ListDestinations() (destinations []Destination)
+	// ExportHotels returns the hotels currently saved; a later SaveMaps does not change the export
+	ExportHotels() (export Export)
 }
 
 type intHotels struct {
@@ -34,7 +48,12 @@ func GetDestinationIDsMap() map[uint64]bool {
 	return destinationIDsMap
 }
 
-func SaveMaps(hotels map[string]Hotel) {
+func SaveMaps(ctx context.Context, hotels map[string]Hotel) {
+	_, span := tracer.Start(ctx, "hotels.SaveMaps", trace.WithAttributes(
+		attribute.Int("hotels", len(hotels)),
+	))
+	defer span.End()
+
 	// Clear existing maps first
 	ClearMaps()
 	
@@ -51,8 +70,14 @@ func SaveMaps(hotels map[string]Hotel) {
 	}
 }
 
+// SetSnapshotVersion sets the version of the saved hotels, reported by ExportHotels
+func SetSnapshotVersion(version int64) {
+	snapshotVersion = version
+}
+
 // ClearMaps clears all the hotel maps
 func ClearMaps() {
+	snapshotVersion = 0
 	hotelIDsMap = make(map[string]bool)
 	hotelByHotelIDMap = make(map[string]Hotel)
 	destinationIDsMap = make(map[uint64]bool)
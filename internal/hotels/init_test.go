@@ -1,6 +1,7 @@
 package hotels
 
 import (
+	"context"
 	"log/slog"
 	"reflect"
 	"testing"
@@ -18,7 +19,7 @@ func TestGetDestinationIDsMap(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			SaveMaps(map[string]Hotel{})
+			SaveMaps(context.Background(), map[string]Hotel{})
 			
 			if got := GetDestinationIDsMap(); !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("GetDestinationIDsMap() = %v, want %v", got, tt.want)
@@ -39,7 +40,7 @@ func TestGetHotelIDsMap(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			SaveMaps(map[string]Hotel{})
+			SaveMaps(context.Background(), map[string]Hotel{})
 			
 			if got := GetHotelIDsMap(); !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("GetHotelIDsMap() = %v, want %v", got, tt.want)
@@ -141,9 +142,9 @@ func TestSaveMaps(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			SaveMaps(map[string]Hotel{})
+			SaveMaps(context.Background(), map[string]Hotel{})
 			
-			SaveMaps(tt.args.hotels)
+			SaveMaps(context.Background(), tt.args.hotels)
 			
 			hotelIDsMap := GetHotelIDsMap()
 			for hotelID := range tt.args.hotels {
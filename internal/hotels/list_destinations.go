@@ -0,0 +1,69 @@
+package hotels
+
+import (
+	"sort"
+
+	"hotelsDataMerge/internal/suppliers/geo"
+)
+
+// ListDestinations returns every destination, ordered by ID, with the number of hotels, the most
+// common city and country, and the bounding box and centroid of the hotels that have coordinates
+func (i *intHotels) ListDestinations() []Destination {
+	destinations := make([]Destination, 0, len(hotelsByDestinationIdMap))
+	for destinationID, destinationHotels := range hotelsByDestinationIdMap {
+		destinations = append(destinations, buildDestination(destinationID, destinationHotels))
+	}
+	sort.Slice(destinations, func(a, b int) bool {
+		return destinations[a].Id < destinations[b].Id
+	})
+	return destinations
+}
+
+func buildDestination(destinationID uint64, destinationHotels []Hotel) Destination {
+	destination := Destination{
+		Id:         destinationID,
+		HotelCount: len(destinationHotels),
+	}
+
+	cityCounts := make(map[string]int)
+	countryCounts := make(map[string]int)
+	var points []geo.Point
+	for _, hotel := range destinationHotels {
+		if hotel.Location == nil {
+			continue
+		}
+		if len(hotel.Location.City) > 0 {
+			cityCounts[hotel.Location.City]++
+		}
+		if len(hotel.Location.Country) > 0 {
+			countryCounts[hotel.Location.Country]++
+		}
+		if point, ok := geo.ParsePoint(hotel.Location.Lat, hotel.Location.Lng); ok {
+			points = append(points, point)
+		}
+	}
+	destination.City = mostCommon(cityCounts)
+	destination.Country = mostCommon(countryCounts)
+
+	if len(points) > 0 {
+		southWest, northEast := geo.Bounds(points)
+		destination.BoundingBox = &BoundingBox{
+			SouthWest: Coordinates{Lat: southWest.Lat, Lng: southWest.Lng},
+			NorthEast: Coordinates{Lat: northEast.Lat, Lng: northEast.Lng},
+		}
+		centroid := geo.Centroid(points)
+		destination.Centroid = &Coordinates{Lat: centroid.Lat, Lng: centroid.Lng}
+	}
+	return destination
+}
+
+// mostCommon returns the value with the highest count, or the alphabetically first one on a tie
+func mostCommon(counts map[string]int) string {
+	var best string
+	for value, count := range counts {
+		if count > counts[best] || (count == counts[best] && value < best) {
+			best = value
+		}
+	}
+	return best
+}
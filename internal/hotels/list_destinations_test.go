@@ -0,0 +1,122 @@
+package hotels
+
+import (
+	"context"
+	"log/slog"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func Test_intHotels_ListDestinations(t *testing.T) {
+	tests := []struct {
+		name   string
+		hotels map[string]Hotel
+		want   []Destination
+	}{
+		{
+			name: "Success - Derive destination metadata",
+			hotels: map[string]Hotel{
+				"iJhz": {
+					Id:            "iJhz",
+					DestinationId: 5432,
+					Location:      &HotelLocation{Lat: 1.25, Lng: 103.75, City: "Singapore", Country: "SG"},
+				},
+				"SjyX": {
+					Id:            "SjyX",
+					DestinationId: 5432,
+					Location:      &HotelLocation{Lat: 1.5, Lng: 104.0, City: "Singapore", Country: "SG"},
+				},
+				"f8c9": {
+					Id:            "f8c9",
+					DestinationId: 1122,
+					Location:      &HotelLocation{Lat: 35.6926, Lng: 139.690965, City: "Tokyo", Country: "JP"},
+				},
+				"a1b2": {
+					Id:            "a1b2",
+					DestinationId: 1122,
+					Location:      &HotelLocation{City: "Shinjuku", Country: "JP"},
+				},
+				"c3d4": {
+					Id:            "c3d4",
+					DestinationId: 1122,
+				},
+			},
+			want: []Destination{
+				{
+					Id:          1122,
+					HotelCount:  3,
+					City:        "Shinjuku",
+					Country:     "JP",
+					BoundingBox: &BoundingBox{SouthWest: Coordinates{Lat: 35.6926, Lng: 139.690965}, NorthEast: Coordinates{Lat: 35.6926, Lng: 139.690965}},
+					Centroid:    &Coordinates{Lat: 35.6926, Lng: 139.690965},
+				},
+				{
+					Id:          5432,
+					HotelCount:  2,
+					City:        "Singapore",
+					Country:     "SG",
+					BoundingBox: &BoundingBox{SouthWest: Coordinates{Lat: 1.25, Lng: 103.75}, NorthEast: Coordinates{Lat: 1.5, Lng: 104.0}},
+					Centroid:    &Coordinates{Lat: 1.375003, Lng: 103.874993},
+				},
+			},
+		},
+		{
+			name: "Success - Destination across the antimeridian",
+			hotels: map[string]Hotel{
+				"fj01": {
+					Id:            "fj01",
+					DestinationId: 679,
+					Location:      &HotelLocation{Lat: -17.5, Lng: 179.5, Country: "FJ"},
+				},
+				"fj02": {
+					Id:            "fj02",
+					DestinationId: 679,
+					Location:      &HotelLocation{Lat: -17.5, Lng: -179.5, Country: "FJ"},
+				},
+			},
+			want: []Destination{
+				{
+					Id:          679,
+					HotelCount:  2,
+					Country:     "FJ",
+					BoundingBox: &BoundingBox{SouthWest: Coordinates{Lat: -17.5, Lng: 179.5}, NorthEast: Coordinates{Lat: -17.5, Lng: -179.5}},
+					Centroid:    &Coordinates{Lat: -17.500626, Lng: 180},
+				},
+			},
+		},
+		{
+			name: "Success - Destination without locations",
+			hotels: map[string]Hotel{
+				"hotel1": {Id: "hotel1", DestinationId: 123},
+			},
+			want: []Destination{
+				{Id: 123, HotelCount: 1},
+			},
+		},
+		{
+			name:   "Success - No destinations",
+			hotels: map[string]Hotel{},
+			want:   []Destination{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SaveMaps(context.Background(), tt.hotels)
+
+			i := &intHotels{
+				logger: slog.Default(),
+			}
+			got := i.ListDestinations()
+			for _, destination := range got {
+				if destination.Centroid != nil {
+					destination.Centroid.Lat = math.Round(destination.Centroid.Lat*1e6) / 1e6
+					destination.Centroid.Lng = math.Round(destination.Centroid.Lng*1e6) / 1e6
+				}
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ListDestinations() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+
+	"google.golang.org/grpc"
+)
+
+// GRPCServer serves lis until stopped. Stop lets in-flight calls finish with GracefulStop
+// and closes the remaining connections when the shutdown deadline is reached.
+func GRPCServer(name string, svr *grpc.Server, lis net.Listener) Component {
+	return Component{
+		Name: name,
+		Run: func(ctx context.Context) error {
+			return svr.Serve(lis)
+		},
+		Stop: func(ctx context.Context) error {
+			stopped := make(chan struct{})
+			go func() {
+				svr.GracefulStop()
+				close(stopped)
+			}()
+			select {
+			case <-stopped:
+				return nil
+			case <-ctx.Done():
+				svr.Stop()
+				return ctx.Err()
+			}
+		},
+	}
+}
+
+// HTTPServer serves lis until stopped, over TLS when svr.TLSConfig is set. Stop lets in-flight
+// requests finish with Shutdown and closes the remaining connections at the shutdown deadline.
+func HTTPServer(name string, svr *http.Server, lis net.Listener) Component {
+	return Component{
+		Name: name,
+		Run: func(ctx context.Context) error {
+			var err error
+			if svr.TLSConfig != nil {
+				err = svr.ServeTLS(lis, "", "")
+			} else {
+				err = svr.Serve(lis)
+			}
+			if errors.Is(err, http.ErrServerClosed) {
+				return nil
+			}
+			return err
+		},
+		Stop: func(ctx context.Context) error {
+			if err := svr.Shutdown(ctx); err != nil {
+				_ = svr.Close()
+				return err
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,53 @@
+package lifecycle
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+func TestGRPCServer(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Listen() error = %v", err)
+	}
+	component := GRPCServer("grpc", grpc.NewServer(), lis)
+	assertStops(t, component)
+}
+
+func TestHTTPServer(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Listen() error = %v", err)
+	}
+	svr := &http.Server{Handler: http.NotFoundHandler()}
+	component := HTTPServer("gateway", svr, lis)
+	assertStops(t, component)
+}
+
+// assertStops runs the component and checks that Stop makes Run return without error
+func assertStops(t *testing.T, component Component) {
+	t.Helper()
+	runErr := make(chan error, 1)
+	go func() { runErr <- component.Run(context.Background()) }()
+	// Lets Serve start accepting before stopping
+	time.Sleep(10 * time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := component.Stop(ctx); err != nil {
+		t.Fatalf("Stop() error = %v", err)
+	}
+	select {
+	case err := <-runErr:
+		if err != nil {
+			t.Errorf("Run() error = %v, want nil", err)
+		}
+	case <-ctx.Done():
+		t.Fatalf("Run() did not return after Stop()")
+	}
+}
@@ -0,0 +1,82 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"syscall"
+	"time"
+)
+
+// Exit codes returned by ExitCode
+const (
+	ExitOK = 0
+	// ExitFailure means the application could not start or a component failed while running
+	ExitFailure = 1
+	// ExitConfig means the configuration is invalid
+	ExitConfig = 2
+	// ExitShutdownFailed means a component or hook did not stop cleanly within the shutdown timeout
+	ExitShutdownFailed = 3
+)
+
+var (
+	ErrComponentFailed = errors.New("component failed")
+	ErrShutdownFailed  = errors.New("shutdown did not complete cleanly")
+)
+
+// Component is a long-running part of the application, such as a server
+type Component struct {
+	Name string
+	// Run blocks until the component stops. It must return once Stop is called or its context is done;
+	// returning nil before shutdown means the component has finished its work and is not a failure.
+	Run func(ctx context.Context) error
+	// Stop gracefully stops the component within the deadline of ctx; it may be nil when cancelling
+	// the context given to Run is enough
+	Stop func(ctx context.Context) error
+}
+
+// Hook is run on shutdown once every component has stopped, e.g. to flush data
+type Hook struct {
+	Name string
+	Run  func(ctx context.Context) error
+}
+
+type IntLifecycle interface {
+	// Add registers a component. Components are started together and stopped in reverse order of registration.
+	Add(component Component)
+	// OnShutdown registers a hook. Hooks run in order of registration after the components have stopped.
+	OnShutdown(hook Hook)
+	// Run starts the components and blocks until SIGINT or SIGTERM is received, ctx is done or a component
+	// fails. It then stops the components, cancels the context given to them and runs the hooks, all within
+	// the shutdown timeout. A second signal during shutdown terminates the process immediately.
+	Run(ctx context.Context) error
+}
+
+type intLifecycle struct {
+	logger          *slog.Logger
+	shutdownTimeout time.Duration
+	signals         []os.Signal
+	components      []Component
+	hooks           []Hook
+}
+
+func Initialize(logger *slog.Logger, shutdownTimeout time.Duration) IntLifecycle {
+	return &intLifecycle{
+		logger:          logger,
+		shutdownTimeout: shutdownTimeout,
+		signals:         []os.Signal{os.Interrupt, syscall.SIGTERM},
+	}
+}
+
+// ExitCode returns the process exit code for the error returned by Run
+func ExitCode(err error) int {
+	switch {
+	case err == nil:
+		return ExitOK
+	case errors.Is(err, ErrShutdownFailed):
+		return ExitShutdownFailed
+	default:
+		return ExitFailure
+	}
+}
@@ -0,0 +1,111 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os/signal"
+)
+
+type componentResult struct {
+	name string
+	err  error
+}
+
+func (l *intLifecycle) Add(component Component) {
+	l.components = append(l.components, component)
+}
+
+func (l *intLifecycle) OnShutdown(hook Hook) {
+	l.hooks = append(l.hooks, hook)
+}
+
+func (l *intLifecycle) Run(ctx context.Context) error {
+	signalCtx, stopSignals := signal.NotifyContext(ctx, l.signals...)
+	defer stopSignals()
+	// The components' context is only cancelled once the servers have stopped accepting traffic
+	runCtx, cancelRun := context.WithCancel(context.WithoutCancel(ctx))
+	defer cancelRun()
+
+	results := make(chan componentResult, len(l.components))
+	for _, component := range l.components {
+		go func() {
+			results <- componentResult{name: component.Name, err: component.Run(runCtx)}
+		}()
+	}
+
+	finished, runErr := l.wait(signalCtx, results)
+	// Restores the default signal behaviour, so that a second signal kills the process
+	stopSignals()
+
+	shutdownCtx, cancelShutdown := context.WithTimeout(context.WithoutCancel(ctx), l.shutdownTimeout)
+	defer cancelShutdown()
+	if shutdownErr := l.shutdown(shutdownCtx, cancelRun, results, len(l.components)-finished); shutdownErr != nil {
+		l.logger.Error("[Lifecycle] Shutdown did not complete cleanly", "error", shutdownErr)
+		return errors.Join(runErr, fmt.Errorf("%w: %w", ErrShutdownFailed, shutdownErr))
+	}
+	l.logger.Info("[Lifecycle] Shutdown complete")
+	return runErr
+}
+
+// wait blocks until a signal is received, ctx is done or a component fails. It returns the number
+// of components that have already returned and the failure, if any.
+func (l *intLifecycle) wait(ctx context.Context, results <-chan componentResult) (int, error) {
+	finished := 0
+	for {
+		select {
+		case <-ctx.Done():
+			l.logger.Info("[Lifecycle] Shutting down", "reason", context.Cause(ctx))
+			return finished, nil
+		case result := <-results:
+			finished++
+			if result.err != nil {
+				l.logger.Error("[Lifecycle] Component failed, shutting down", "component", result.name, "error", result.err)
+				return finished, fmt.Errorf("%w: %s: %w", ErrComponentFailed, result.name, result.err)
+			}
+			l.logger.Info("[Lifecycle] Component finished", "component", result.name)
+		}
+	}
+}
+
+// shutdown stops the components in reverse order, cancels their context, waits for the running ones
+// to return and runs the hooks. Every step runs even when an earlier one failed.
+func (l *intLifecycle) shutdown(ctx context.Context, cancelRun context.CancelFunc, results <-chan componentResult, running int) error {
+	var errs []error
+	for i := len(l.components) - 1; i >= 0; i-- {
+		component := l.components[i]
+		if component.Stop == nil {
+			continue
+		}
+		l.logger.Info("[Lifecycle] Stopping component", "component", component.Name)
+		if err := component.Stop(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("stop %s: %w", component.Name, err))
+		}
+	}
+	cancelRun()
+	if err := waitComponents(ctx, results, running); err != nil {
+		errs = append(errs, err)
+	}
+
+	for _, hook := range l.hooks {
+		if err := hook.Run(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", hook.Name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func waitComponents(ctx context.Context, results <-chan componentResult, running int) error {
+	var errs []error
+	for ; running > 0; running-- {
+		select {
+		case result := <-results:
+			if result.err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", result.name, result.err))
+			}
+		case <-ctx.Done():
+			return errors.Join(append(errs, fmt.Errorf("%d components still running: %w", running, ctx.Err()))...)
+		}
+	}
+	return errors.Join(errs...)
+}
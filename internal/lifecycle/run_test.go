@@ -0,0 +1,161 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"os"
+	"reflect"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+)
+
+// recorder records the order in which components are stopped and hooks are run
+type recorder struct {
+	mu    sync.Mutex
+	calls []string
+}
+
+func (r *recorder) record(call string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = append(r.calls, call)
+}
+
+// blockingComponent runs until it is stopped or its context is done
+func blockingComponent(name string, r *recorder) Component {
+	stopped := make(chan struct{})
+	return Component{
+		Name: name,
+		Run: func(ctx context.Context) error {
+			select {
+			case <-stopped:
+			case <-ctx.Done():
+			}
+			return nil
+		},
+		Stop: func(ctx context.Context) error {
+			r.record("stop " + name)
+			close(stopped)
+			return nil
+		},
+	}
+}
+
+func newTestLifecycle(shutdownTimeout time.Duration) *intLifecycle {
+	l := Initialize(slog.New(slog.NewTextHandler(io.Discard, nil)), shutdownTimeout).(*intLifecycle)
+	l.signals = []os.Signal{syscall.SIGUSR1}
+	return l
+}
+
+func Test_intLifecycle_Run(t *testing.T) {
+	componentErr := errors.New("address already in use")
+
+	tests := []struct {
+		name      string
+		setup     func(l *intLifecycle, r *recorder, cancel context.CancelFunc)
+		wantCalls []string
+		wantErr   error
+		wantCode  int
+	}{
+		{
+			name: "Success - Components stopped in reverse order, then hooks",
+			setup: func(l *intLifecycle, r *recorder, cancel context.CancelFunc) {
+				l.Add(blockingComponent("grpc", r))
+				l.Add(blockingComponent("gateway", r))
+				l.Add(Component{Name: "scheduler", Run: func(ctx context.Context) error {
+					<-ctx.Done()
+					r.record("scheduler cancelled")
+					return nil
+				}})
+				l.OnShutdown(Hook{Name: "pipeline", Run: func(ctx context.Context) error { r.record("hook pipeline"); return nil }})
+				l.OnShutdown(Hook{Name: "tracing", Run: func(ctx context.Context) error { r.record("hook tracing"); return nil }})
+				cancel()
+			},
+			wantCalls: []string{"stop gateway", "stop grpc", "scheduler cancelled", "hook pipeline", "hook tracing"},
+			wantCode:  ExitOK,
+		},
+		{
+			name: "Success - Component finishing its work does not shut down",
+			setup: func(l *intLifecycle, r *recorder, cancel context.CancelFunc) {
+				l.Add(Component{Name: "startup", Run: func(ctx context.Context) error {
+					r.record("startup done")
+					time.AfterFunc(10*time.Millisecond, cancel)
+					return nil
+				}})
+				l.Add(blockingComponent("grpc", r))
+			},
+			wantCalls: []string{"startup done", "stop grpc"},
+			wantCode:  ExitOK,
+		},
+		{
+			name: "Success - Signal",
+			setup: func(l *intLifecycle, r *recorder, cancel context.CancelFunc) {
+				l.Add(Component{Name: "grpc", Run: func(ctx context.Context) error {
+					_ = syscall.Kill(os.Getpid(), syscall.SIGUSR1)
+					<-ctx.Done()
+					return nil
+				}})
+			},
+			wantCalls: nil,
+			wantCode:  ExitOK,
+		},
+		{
+			name: "Error - Component failure stops the others",
+			setup: func(l *intLifecycle, r *recorder, cancel context.CancelFunc) {
+				l.Add(blockingComponent("grpc", r))
+				l.Add(Component{Name: "gateway", Run: func(ctx context.Context) error { return componentErr }})
+			},
+			wantCalls: []string{"stop grpc"},
+			wantErr:   ErrComponentFailed,
+			wantCode:  ExitFailure,
+		},
+		{
+			name: "Error - Component not stopping within the timeout",
+			setup: func(l *intLifecycle, r *recorder, cancel context.CancelFunc) {
+				l.Add(Component{
+					Name: "grpc",
+					Run:  func(ctx context.Context) error { select {} },
+					Stop: func(ctx context.Context) error { <-ctx.Done(); return ctx.Err() },
+				})
+				cancel()
+			},
+			wantErr:  ErrShutdownFailed,
+			wantCode: ExitShutdownFailed,
+		},
+		{
+			name: "Error - Failing hook",
+			setup: func(l *intLifecycle, r *recorder, cancel context.CancelFunc) {
+				l.OnShutdown(Hook{Name: "flush", Run: func(ctx context.Context) error { return io.ErrShortWrite }})
+				l.OnShutdown(Hook{Name: "tracing", Run: func(ctx context.Context) error { r.record("hook tracing"); return nil }})
+				cancel()
+			},
+			wantCalls: []string{"hook tracing"},
+			wantErr:   ErrShutdownFailed,
+			wantCode:  ExitShutdownFailed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := newTestLifecycle(100 * time.Millisecond)
+			r := &recorder{}
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			tt.setup(l, r, cancel)
+
+			err := l.Run(ctx)
+			if !errors.Is(err, tt.wantErr) || (err == nil) != (tt.wantErr == nil) {
+				t.Errorf("Run() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if code := ExitCode(err); code != tt.wantCode {
+				t.Errorf("ExitCode() = %v, want %v", code, tt.wantCode)
+			}
+			if !reflect.DeepEqual(r.calls, tt.wantCalls) {
+				t.Errorf("Run() calls = %v, want %v", r.calls, tt.wantCalls)
+			}
+		})
+	}
+}
@@ -0,0 +1,147 @@
+package metrics
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "hotels"
+
+// Metrics holds the Prometheus collectors of the application. A nil *Metrics is valid
+// and records nothing, so that components can be used without metrics, e.g. in tests.
+type Metrics struct {
+	registry *prometheus.Registry
+	now      func() time.Time
+
+	supplierFetchDuration *prometheus.HistogramVec
+	supplierResponses     *prometheus.CounterVec
+	supplierResponseSize  *prometheus.HistogramVec
+	supplierParsedRecords *prometheus.GaugeVec
+	supplierErrors        *prometheus.CounterVec
+	mergeDuration         prometheus.Histogram
+	snapshotHotels        prometheus.Gauge
+	grpcRequests          *prometheus.CounterVec
+	grpcRequestDuration   *prometheus.HistogramVec
+	grpcRateLimited       *prometheus.CounterVec
+	httpRequests          *prometheus.CounterVec
+	httpRequestDuration   *prometheus.HistogramVec
+
+	mu         sync.RWMutex
+	snapshotAt time.Time
+}
+
+func Initialize() *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		now:      time.Now,
+		supplierFetchDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "supplier_fetch_duration_seconds",
+			Help:      "Time taken to fetch the raw data of a supplier.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"supplier"}),
+		supplierResponses: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "supplier_responses_total",
+			Help:      "Supplier responses by HTTP status code; code is \"error\" when no response was received.",
+		}, []string{"supplier", "code"}),
+		supplierResponseSize: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "supplier_response_size_bytes",
+			Help:      "Size of the supplier response bodies.",
+			Buckets:   prometheus.ExponentialBuckets(1024, 4, 8),
+		}, []string{"supplier"}),
+		supplierParsedRecords: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "supplier_parsed_records",
+			Help:      "Number of hotels parsed from the last successful fetch of a supplier.",
+		}, []string{"supplier"}),
+		supplierErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "supplier_errors_total",
+			Help:      "Supplier refresh failures by stage (fetch or parse).",
+		}, []string{"supplier", "stage"}),
+		mergeDuration: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "merge_duration_seconds",
+			Help:      "Time taken to merge the suppliers data into a snapshot.",
+			Buckets:   prometheus.DefBuckets,
+		}),
+		snapshotHotels: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "snapshot_hotels",
+			Help:      "Number of hotels in the snapshot being served.",
+		}),
+		grpcRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "grpc_server_handled_total",
+			Help:      "gRPC requests completed on the server by method and status code.",
+		}, []string{"method", "code"}),
+		grpcRequestDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "grpc_server_handling_seconds",
+			Help:      "Time taken by the server to handle gRPC requests.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method"}),
+		grpcRateLimited: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "grpc_server_rate_limited_total",
+			Help:      "gRPC requests rejected by the per-client rate limit by method.",
+		}, []string{"method"}),
+		httpRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "http_requests_total",
+			Help:      "HTTP requests served by the gateway by method and status code.",
+		}, []string{"method", "code"}),
+		httpRequestDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "http_request_duration_seconds",
+			Help:      "Time taken by the gateway to serve HTTP requests.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method"}),
+	}
+
+	snapshotAge := prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "snapshot_age_seconds",
+		Help:      "Seconds since the snapshot being served was built; 0 until the first snapshot.",
+	}, m.snapshotAge)
+
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.supplierFetchDuration,
+		m.supplierResponses,
+		m.supplierResponseSize,
+		m.supplierParsedRecords,
+		m.supplierErrors,
+		m.mergeDuration,
+		m.snapshotHotels,
+		snapshotAge,
+		m.grpcRequests,
+		m.grpcRequestDuration,
+		m.grpcRateLimited,
+		m.httpRequests,
+		m.httpRequestDuration,
+	)
+	return m
+}
+
+// Handler serves the metrics in the Prometheus exposition format
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{})
+}
+
+func (m *Metrics) snapshotAge() float64 {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if m.snapshotAt.IsZero() {
+		return 0
+	}
+	return m.now().Sub(m.snapshotAt).Seconds()
+}
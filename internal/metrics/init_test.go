@@ -0,0 +1,128 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func scrape(t *testing.T, m *Metrics) string {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	m.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("scrape status = %v, want %v", rec.Code, http.StatusOK)
+	}
+	body, err := io.ReadAll(rec.Body)
+	if err != nil {
+		t.Fatalf("scrape read error = %v", err)
+	}
+	return string(body)
+}
+
+func TestMetrics_Handler(t *testing.T) {
+	m := Initialize()
+	snapshotAt := time.Date(2026, 10, 19, 8, 0, 0, 0, time.UTC)
+	m.now = func() time.Time { return snapshotAt }
+
+	m.ObserveSupplierResponse("acme", http.StatusOK, 2048, 150*time.Millisecond)
+	m.ObserveSupplierResponse("patagonia", 0, 0, time.Second)
+	m.ObserveSupplierRecords("acme", 3)
+	m.ObserveSupplierError("patagonia", StageFetch)
+	m.ObserveSnapshot(20*time.Millisecond, 3)
+	m.now = func() time.Time { return snapshotAt.Add(90 * time.Second) }
+
+	interceptor := m.UnaryServerInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: "/proto.HotelDataMerge/GetHotel"}
+	_, _ = interceptor(context.Background(), nil, info, func(ctx context.Context, req any) (any, error) {
+		return nil, status.Error(codes.NotFound, "hotel 'x' does not exist")
+	})
+
+	streamInterceptor := m.StreamServerInterceptor()
+	streamInfo := &grpc.StreamServerInfo{FullMethod: "/grpc.reflection.v1.ServerReflection/ServerReflectionInfo", IsServerStream: true}
+	_ = streamInterceptor(nil, nil, streamInfo, func(srv any, stream grpc.ServerStream) error {
+		return nil
+	})
+
+	m.ObserveRateLimited("/proto.HotelDataMerge/GetHotels")
+
+	handler := m.InstrumentHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/v1/hotels/x", nil))
+
+	body := scrape(t, m)
+	tests := []struct {
+		name string
+		want string
+	}{
+		{name: "Success - Supplier fetch latency", want: `hotels_supplier_fetch_duration_seconds_count{supplier="acme"} 1`},
+		{name: "Success - Supplier HTTP status", want: `hotels_supplier_responses_total{code="200",supplier="acme"} 1`},
+		{name: "Success - Supplier request without response", want: `hotels_supplier_responses_total{code="error",supplier="patagonia"} 1`},
+		{name: "Success - Supplier response bytes", want: `hotels_supplier_response_size_bytes_sum{supplier="acme"} 2048`},
+		{name: "Success - Parsed records", want: `hotels_supplier_parsed_records{supplier="acme"} 3`},
+		{name: "Success - Supplier errors", want: `hotels_supplier_errors_total{stage="fetch",supplier="patagonia"} 1`},
+		{name: "Success - Merge duration", want: `hotels_merge_duration_seconds_count 1`},
+		{name: "Success - Hotels per snapshot", want: `hotels_snapshot_hotels 3`},
+		{name: "Success - Snapshot age", want: `hotels_snapshot_age_seconds 90`},
+		{name: "Success - gRPC requests by method and code", want: `hotels_grpc_server_handled_total{code="NotFound",method="/proto.HotelDataMerge/GetHotel"} 1`},
+		{name: "Success - gRPC latency", want: `hotels_grpc_server_handling_seconds_count{method="/proto.HotelDataMerge/GetHotel"} 1`},
+		{name: "Success - gRPC streams by method and code", want: `hotels_grpc_server_handled_total{code="OK",method="/grpc.reflection.v1.ServerReflection/ServerReflectionInfo"} 1`},
+		{name: "Success - gRPC stream latency", want: `hotels_grpc_server_handling_seconds_count{method="/grpc.reflection.v1.ServerReflection/ServerReflectionInfo"} 1`},
+		{name: "Success - Rate limited requests", want: `hotels_grpc_server_rate_limited_total{method="/proto.HotelDataMerge/GetHotels"} 1`},
+		{name: "Success - HTTP requests by method and code", want: `hotels_http_requests_total{code="404",method="get"} 1`},
+		{name: "Success - HTTP latency", want: `hotels_http_request_duration_seconds_count{method="get"} 1`},
+		{name: "Success - Go runtime metrics", want: `go_goroutines`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !strings.Contains(body, tt.want) {
+				t.Errorf("scrape does not contain %q", tt.want)
+			}
+		})
+	}
+}
+
+func TestMetrics_Nil(t *testing.T) {
+	var m *Metrics
+	m.ObserveSupplierResponse("acme", http.StatusOK, 10, time.Second)
+	m.ObserveSupplierRecords("acme", 1)
+	m.ObserveSupplierError("acme", StageParse)
+	m.ObserveSnapshot(time.Second, 1)
+	m.ObserveRateLimited("/proto.HotelDataMerge/GetHotels")
+
+	wantErr := errors.New("failed")
+	_, err := m.UnaryServerInterceptor()(context.Background(), nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, req any) (any, error) {
+		return nil, wantErr
+	})
+	if !errors.Is(err, wantErr) {
+		t.Errorf("UnaryServerInterceptor() error = %v, want %v", err, wantErr)
+	}
+	err = m.StreamServerInterceptor()(nil, nil, &grpc.StreamServerInfo{}, func(srv any, stream grpc.ServerStream) error {
+		return wantErr
+	})
+	if !errors.Is(err, wantErr) {
+		t.Errorf("StreamServerInterceptor() error = %v, want %v", err, wantErr)
+	}
+
+	rec := httptest.NewRecorder()
+	m.InstrumentHandler(http.NotFoundHandler()).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("InstrumentHandler() status = %v, want %v", rec.Code, http.StatusNotFound)
+	}
+}
+
+func TestMetrics_SnapshotAgeBeforeFirstSnapshot(t *testing.T) {
+	if body := scrape(t, Initialize()); !strings.Contains(body, "hotels_snapshot_age_seconds 0") {
+		t.Errorf("scrape does not contain a zero snapshot age")
+	}
+}
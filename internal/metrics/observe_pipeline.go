@@ -0,0 +1,51 @@
+package metrics
+
+import (
+	"strconv"
+	"time"
+)
+
+const (
+	StageFetch = "fetch"
+	StageParse = "parse"
+)
+
+// ObserveSupplierResponse records one request to a supplier. A statusCode of 0 means no response was received.
+func (m *Metrics) ObserveSupplierResponse(supplier string, statusCode int, size int, duration time.Duration) {
+	if m == nil {
+		return
+	}
+	code := "error"
+	if statusCode != 0 {
+		code = strconv.Itoa(statusCode)
+		m.supplierResponseSize.WithLabelValues(supplier).Observe(float64(size))
+	}
+	m.supplierResponses.WithLabelValues(supplier, code).Inc()
+	m.supplierFetchDuration.WithLabelValues(supplier).Observe(duration.Seconds())
+}
+
+func (m *Metrics) ObserveSupplierRecords(supplier string, records int) {
+	if m == nil {
+		return
+	}
+	m.supplierParsedRecords.WithLabelValues(supplier).Set(float64(records))
+}
+
+func (m *Metrics) ObserveSupplierError(supplier string, stage string) {
+	if m == nil {
+		return
+	}
+	m.supplierErrors.WithLabelValues(supplier, stage).Inc()
+}
+
+// ObserveSnapshot records a merge and the snapshot it produced
+func (m *Metrics) ObserveSnapshot(mergeDuration time.Duration, hotels int) {
+	if m == nil {
+		return
+	}
+	m.mergeDuration.Observe(mergeDuration.Seconds())
+	m.snapshotHotels.Set(float64(hotels))
+	m.mu.Lock()
+	m.snapshotAt = m.now()
+	m.mu.Unlock()
+}
@@ -0,0 +1,55 @@
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryServerInterceptor counts and times every gRPC request by its full method name and status code
+func (m *Metrics) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if m == nil {
+			return handler(ctx, req)
+		}
+		startedAt := time.Now()
+		resp, err := handler(ctx, req)
+		m.grpcRequestDuration.WithLabelValues(info.FullMethod).Observe(time.Since(startedAt).Seconds())
+		m.grpcRequests.WithLabelValues(info.FullMethod, status.Code(err).String()).Inc()
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor counts and times every gRPC stream by its full method name and status code
+func (m *Metrics) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if m == nil {
+			return handler(srv, stream)
+		}
+		startedAt := time.Now()
+		err := handler(srv, stream)
+		m.grpcRequestDuration.WithLabelValues(info.FullMethod).Observe(time.Since(startedAt).Seconds())
+		m.grpcRequests.WithLabelValues(info.FullMethod, status.Code(err).String()).Inc()
+		return err
+	}
+}
+
+// ObserveRateLimited counts a gRPC request rejected by the rate limit
+func (m *Metrics) ObserveRateLimited(fullMethod string) {
+	if m == nil {
+		return
+	}
+	m.grpcRateLimited.WithLabelValues(fullMethod).Inc()
+}
+
+// InstrumentHandler counts and times every HTTP request served by next by method and status code
+func (m *Metrics) InstrumentHandler(next http.Handler) http.Handler {
+	if m == nil {
+		return next
+	}
+	return promhttp.InstrumentHandlerDuration(m.httpRequestDuration, promhttp.InstrumentHandlerCounter(m.httpRequests, next))
+}
@@ -0,0 +1,80 @@
+package mocksuppliers
+
+import (
+	"embed"
+	"io/fs"
+	"log/slog"
+	"net/http"
+	"sync"
+
+	"hotelsDataMerge/internal/suppliers/utils"
+)
+
+//go:embed fixtures/*.json
+var fixtures embed.FS
+
+// IntMockSuppliers serves the supplier payloads at GET /suppliers/{supplier}, like the live supplier API,
+// following a scenario. PUT /scenario?name=<scenario> switches to a built-in scenario at runtime.
+type IntMockSuppliers interface {
+	http.Handler
+	// SetScenario replaces the scenario and restarts every supplier's script from its first step
+	SetScenario(scenario Scenario)
+	// Calls returns the number of requests received by supplierName since the scenario was set
+	Calls(supplierName utils.Suppliers) int
+}
+
+type intMockSuppliers struct {
+	logger   *slog.Logger
+	fixtures fs.FS
+	mux      *http.ServeMux
+
+	mu       sync.Mutex
+	scenario Scenario
+	calls    map[utils.Suppliers]int
+}
+
+// Fixtures returns the bundled payloads: <supplier>.json, and <supplier>.updated.json used by the changing scenario
+func Fixtures() fs.FS {
+	sub, _ := fs.Sub(fixtures, "fixtures")
+	return sub
+}
+
+// Initialize returns a mock of the suppliers serving the <supplier>.json payloads of fixtures, e.g. Fixtures()
+func Initialize(logger *slog.Logger, fixtures fs.FS, scenario Scenario) IntMockSuppliers {
+	m := &intMockSuppliers{
+		logger:   logger,
+		fixtures: fixtures,
+		scenario: scenario,
+		calls:    make(map[utils.Suppliers]int),
+	}
+	m.mux = http.NewServeMux()
+	m.mux.HandleFunc("GET /suppliers/{supplier}", m.serveSupplier)
+	m.mux.HandleFunc("PUT /scenario", m.putScenario)
+	return m
+}
+
+func (m *intMockSuppliers) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.mux.ServeHTTP(w, r)
+}
+
+func (m *intMockSuppliers) SetScenario(scenario Scenario) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.scenario = scenario
+	m.calls = make(map[utils.Suppliers]int)
+}
+
+func (m *intMockSuppliers) Calls(supplierName utils.Suppliers) int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.calls[supplierName]
+}
+
+// nextStep counts a request to supplierName and returns the step of the scenario answering it
+func (m *intMockSuppliers) nextStep(supplierName utils.Suppliers) (Scenario, Step) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	call := m.calls[supplierName]
+	m.calls[supplierName]++
+	return m.scenario, m.scenario.step(supplierName, call)
+}
@@ -0,0 +1,274 @@
+package mocksuppliers
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"hotelsDataMerge/internal/suppliers/utils"
+)
+
+type response struct {
+	status int
+	body   string
+}
+
+func get(t *testing.T, url string) response {
+	t.Helper()
+	resp, err := http.Get(url)
+	if err != nil {
+		t.Fatalf("Get(%s) error = %v", url, err)
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+	return response{status: resp.StatusCode, body: string(body)}
+}
+
+// newTestServer serves the bundled fixtures following scenario until the test ends, like
+// mockstest.NewServer, whose import would be a cycle here
+func newTestServer(t *testing.T, scenario Scenario) (IntMockSuppliers, map[utils.Suppliers]string) {
+	t.Helper()
+	mock := Initialize(slog.New(slog.NewTextHandler(io.Discard, nil)), Fixtures(), scenario)
+	svr := httptest.NewServer(mock)
+	t.Cleanup(svr.Close)
+
+	urls := make(map[utils.Suppliers]string, len(utils.SupplierPriority))
+	for _, supplierName := range utils.SupplierPriority {
+		urls[supplierName] = svr.URL + "/suppliers/" + string(supplierName)
+	}
+	return mock, urls
+}
+
+// recordIDs returns the ID of every record of a valid payload, whatever the supplier
+func recordIDs(t *testing.T, body string) []string {
+	t.Helper()
+	var records []map[string]any
+	if err := json.Unmarshal([]byte(body), &records); err != nil {
+		t.Fatalf("payload is not a JSON array: %v", err)
+	}
+	ids := make([]string, 0, len(records))
+	for _, record := range records {
+		for _, key := range []string{"Id", "id", "hotel_id"} {
+			if id, ok := record[key].(string); ok {
+				ids = append(ids, id)
+			}
+		}
+	}
+	return ids
+}
+
+func Test_intMockSuppliers_ServeHTTP(t *testing.T) {
+	tests := []struct {
+		name     string
+		scenario string
+		supplier utils.Suppliers
+		check    func(t *testing.T, responses []response)
+	}{
+		{
+			name:     "Success - Healthy",
+			scenario: "healthy",
+			supplier: utils.Acme,
+			check: func(t *testing.T, responses []response) {
+				if got := recordIDs(t, responses[0].body); !reflect.DeepEqual(got, []string{"iJhz", "SjyX", "f8c9"}) {
+					t.Errorf("acme hotels = %v, want [iJhz SjyX f8c9]", got)
+				}
+			},
+		},
+		{
+			name:     "Success - 5xx burst then recovery",
+			scenario: "flaky",
+			supplier: utils.Patagonia,
+			check: func(t *testing.T, responses []response) {
+				var statuses []int
+				for _, resp := range responses {
+					statuses = append(statuses, resp.status)
+				}
+				want := []int{http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusOK}
+				if !reflect.DeepEqual(statuses, want) {
+					t.Errorf("statuses = %v, want %v", statuses, want)
+				}
+			},
+		},
+		{
+			name:     "Success - Truncated JSON",
+			scenario: "truncated",
+			supplier: utils.Acme,
+			check: func(t *testing.T, responses []response) {
+				if responses[0].status != http.StatusOK || json.Valid([]byte(responses[0].body)) {
+					t.Errorf("response = %v, want invalid JSON with status 200", responses[0].status)
+				}
+			},
+		},
+		{
+			name:     "Success - Schema drift",
+			scenario: "drift",
+			supplier: utils.Patagonia,
+			check: func(t *testing.T, responses []response) {
+				var records []map[string]any
+				_ = json.Unmarshal([]byte(responses[0].body), &records)
+				if _, ok := records[0]["latitude"]; !ok {
+					t.Errorf("record = %v, want lat renamed to latitude", records[0])
+				}
+				if _, ok := records[0]["info"]; ok {
+					t.Errorf("record = %v, want info dropped", records[0])
+				}
+			},
+		},
+		{
+			name:     "Success - Empty array",
+			scenario: "empty",
+			supplier: utils.Paperflies,
+			check: func(t *testing.T, responses []response) {
+				if responses[0].body != "[]" {
+					t.Errorf("body = %q, want []", responses[0].body)
+				}
+			},
+		},
+		{
+			name:     "Success - Data changing between calls",
+			scenario: "changing",
+			supplier: utils.Paperflies,
+			check: func(t *testing.T, responses []response) {
+				if got := recordIDs(t, responses[0].body); !reflect.DeepEqual(got, []string{"iJhz", "SjyX"}) {
+					t.Errorf("first paperflies hotels = %v, want [iJhz SjyX]", got)
+				}
+				for _, resp := range responses[1:] {
+					if got := recordIDs(t, resp.body); !reflect.DeepEqual(got, []string{"iJhz"}) {
+						t.Errorf("next paperflies hotels = %v, want [iJhz]", got)
+					}
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, urls := newTestServer(t, Scenarios[tt.scenario])
+			var responses []response
+			for range 4 {
+				responses = append(responses, get(t, urls[tt.supplier]))
+			}
+			if calls := mock.Calls(tt.supplier); calls != 4 {
+				t.Errorf("Calls() = %v, want 4", calls)
+			}
+			tt.check(t, responses)
+		})
+	}
+}
+
+func Test_intMockSuppliers_Latency(t *testing.T) {
+	_, urls := newTestServer(t, Scenario{Suppliers: map[string][]Step{
+		AnySupplier: {{Calls: 1, Latency: time.Second}},
+	}})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, urls[utils.Acme], nil)
+	if _, err := http.DefaultClient.Do(req); err == nil {
+		t.Errorf("Do() error = nil, want a timeout on the slow call")
+	}
+	if resp := get(t, urls[utils.Acme]); resp.status != http.StatusOK {
+		t.Errorf("next call status = %v, want %v", resp.status, http.StatusOK)
+	}
+}
+
+func Test_intMockSuppliers_SetScenario(t *testing.T) {
+	mock, urls := newTestServer(t, Scenarios["empty"])
+	baseURL := strings.TrimSuffix(urls[utils.Acme], "/suppliers/acme")
+
+	tests := []struct {
+		name       string
+		scenario   string
+		wantStatus int
+		wantEmpty  bool
+	}{
+		{name: "Success - Built-in scenario", scenario: "healthy", wantStatus: http.StatusNoContent, wantEmpty: false},
+		{name: "Error - Unknown scenario", scenario: "unknown", wantStatus: http.StatusBadRequest, wantEmpty: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest(http.MethodPut, baseURL+"/scenario?name="+tt.scenario, nil)
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatalf("Do() error = %v", err)
+			}
+			_ = resp.Body.Close()
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("PUT /scenario status = %v, want %v", resp.StatusCode, tt.wantStatus)
+			}
+			if got := get(t, urls[utils.Acme]).body == "[]"; got != tt.wantEmpty {
+				t.Errorf("empty payload = %v, want %v", got, tt.wantEmpty)
+			}
+		})
+	}
+	if resp := get(t, baseURL+"/suppliers/expedia"); resp.status != http.StatusNotFound {
+		t.Errorf("unknown supplier status = %v, want %v", resp.status, http.StatusNotFound)
+	}
+	if calls := mock.Calls(utils.Acme); calls != 2 {
+		t.Errorf("Calls() = %v, want 2 since the last scenario change", calls)
+	}
+}
+
+func TestLoadScenario(t *testing.T) {
+	dir := t.TempDir()
+	validFile := filepath.Join(dir, "valid.yaml")
+	_ = os.WriteFile(validFile, []byte(`
+suppliers:
+  acme:
+    - calls: 2
+      status: 500
+    - latency: 50ms
+      drop: [Facilities]
+`), 0o600)
+	invalidFile := filepath.Join(dir, "invalid.yaml")
+	_ = os.WriteFile(invalidFile, []byte("suppliers:\n  acme:\n    - delay: 1s\n"), 0o600)
+
+	tests := []struct {
+		name    string
+		path    string
+		want    Scenario
+		wantErr bool
+	}{
+		{
+			name: "Success - Built-in",
+			path: "flaky",
+			want: Scenarios["flaky"],
+		},
+		{
+			name: "Success - File",
+			path: validFile,
+			want: Scenario{Name: validFile, Suppliers: map[string][]Step{
+				"acme": {{Calls: 2, Status: 500}, {Latency: 50 * time.Millisecond, Drop: []string{"Facilities"}}},
+			}},
+		},
+		{
+			name:    "Error - Unknown field",
+			path:    invalidFile,
+			wantErr: true,
+		},
+		{
+			name:    "Error - Neither built-in nor file",
+			path:    filepath.Join(dir, "missing.yaml"),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := LoadScenario(tt.path)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("LoadScenario() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("LoadScenario() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
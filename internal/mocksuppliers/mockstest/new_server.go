@@ -0,0 +1,27 @@
+package mockstest
+
+import (
+	"io"
+	"log/slog"
+	"net/http/httptest"
+	"testing"
+
+	"hotelsDataMerge/internal/mocksuppliers"
+	"hotelsDataMerge/internal/suppliers/utils"
+)
+
+// NewServer serves the bundled fixtures following scenario until the test ends. It returns
+// the mock, to change the scenario or count calls, and the URL of every supplier, to be given
+// to external.Options.SupplierURLs or the HOTELS_SUPPLIERS_URLS_* settings.
+func NewServer(tb testing.TB, scenario mocksuppliers.Scenario) (mocksuppliers.IntMockSuppliers, map[utils.Suppliers]string) {
+	tb.Helper()
+	mock := mocksuppliers.Initialize(slog.New(slog.NewTextHandler(io.Discard, nil)), mocksuppliers.Fixtures(), scenario)
+	svr := httptest.NewServer(mock)
+	tb.Cleanup(svr.Close)
+
+	urls := make(map[utils.Suppliers]string, len(utils.SupplierPriority))
+	for _, supplierName := range utils.SupplierPriority {
+		urls[supplierName] = svr.URL + "/suppliers/" + string(supplierName)
+	}
+	return mock, urls
+}
@@ -0,0 +1,108 @@
+package mocksuppliers
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"time"
+
+	"hotelsDataMerge/internal/suppliers/utils"
+
+	"gopkg.in/yaml.v3"
+)
+
+// AnySupplier scripts the suppliers that have no script of their own
+const AnySupplier = "*"
+
+// Scenario scripts the successive responses of each supplier. Once the steps of a supplier are used up,
+// its fixture is served unchanged.
+type Scenario struct {
+	Name      string            `yaml:"name"`
+	Suppliers map[string][]Step `yaml:"suppliers"`
+}
+
+// Step is how a supplier answers a number of successive requests
+type Step struct {
+	// Calls is the number of requests the step answers; 0 means every remaining request
+	Calls int `yaml:"calls"`
+	// Latency delays the response
+	Latency time.Duration `yaml:"latency"`
+	// Status, when not 2xx, answers with a plain text error instead of the payload
+	Status int `yaml:"status"`
+	// Fixture serves <supplier>.<fixture>.json instead of <supplier>.json, e.g. to change data between calls
+	Fixture string `yaml:"fixture"`
+	// Empty serves an empty array
+	Empty bool `yaml:"empty"`
+	// Truncate cuts the payload in half, leaving invalid JSON
+	Truncate bool `yaml:"truncate"`
+	// Rename renames fields of every record, e.g. lat: latitude, to simulate schema drift
+	Rename map[string]string `yaml:"rename"`
+	// Drop removes fields of every record
+	Drop []string `yaml:"drop"`
+}
+
+// Scenarios are the built-in scenarios, selected by name
+var Scenarios = map[string]Scenario{
+	"healthy": {Name: "healthy"},
+	"slow": {Name: "slow", Suppliers: map[string][]Step{
+		AnySupplier: {{Latency: 3 * time.Second}},
+	}},
+	"flaky": {Name: "flaky", Suppliers: map[string][]Step{
+		AnySupplier: {{Calls: 3, Status: http.StatusServiceUnavailable}},
+	}},
+	"truncated": {Name: "truncated", Suppliers: map[string][]Step{
+		string(utils.Acme): {{Truncate: true}},
+	}},
+	"drift": {Name: "drift", Suppliers: map[string][]Step{
+		string(utils.Acme):      {{Rename: map[string]string{"Facilities": "facilities"}}},
+		string(utils.Patagonia): {{Rename: map[string]string{"lat": "latitude", "lng": "longitude"}, Drop: []string{"info"}}},
+	}},
+	"empty": {Name: "empty", Suppliers: map[string][]Step{
+		AnySupplier: {{Empty: true}},
+	}},
+	"changing": {Name: "changing", Suppliers: map[string][]Step{
+		AnySupplier: {{Calls: 1}, {Fixture: "updated"}},
+	}},
+}
+
+// LoadScenario returns the built-in scenario called name, or else reads the YAML scenario file at name
+func LoadScenario(name string) (Scenario, error) {
+	if scenario, ok := Scenarios[name]; ok {
+		return scenario, nil
+	}
+	data, err := os.ReadFile(name)
+	if errors.Is(err, os.ErrNotExist) {
+		return Scenario{}, fmt.Errorf("%q is neither a built-in scenario nor a file", name)
+	}
+	if err != nil {
+		return Scenario{}, err
+	}
+	var scenario Scenario
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&scenario); err != nil && !errors.Is(err, io.EOF) {
+		return Scenario{}, fmt.Errorf("invalid scenario file %s: %w", name, err)
+	}
+	if len(scenario.Name) == 0 {
+		scenario.Name = name
+	}
+	return scenario, nil
+}
+
+// step returns the step answering the call-th request (from 0) to supplierName
+func (s Scenario) step(supplierName utils.Suppliers, call int) Step {
+	steps, ok := s.Suppliers[string(supplierName)]
+	if !ok {
+		steps = s.Suppliers[AnySupplier]
+	}
+	for _, step := range steps {
+		if step.Calls == 0 || call < step.Calls {
+			return step
+		}
+		call -= step.Calls
+	}
+	return Step{}
+}
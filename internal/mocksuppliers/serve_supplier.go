@@ -0,0 +1,108 @@
+package mocksuppliers
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"time"
+
+	"hotelsDataMerge/internal/suppliers/utils"
+)
+
+// serveSupplier answers a request for the payload of a supplier with the next step of its script
+func (m *intMockSuppliers) serveSupplier(w http.ResponseWriter, r *http.Request) {
+	supplierName := utils.Suppliers(r.PathValue("supplier"))
+	if _, err := fs.Stat(m.fixtures, string(supplierName)+".json"); err != nil {
+		http.NotFound(w, r)
+		return
+	}
+	scenario, step := m.nextStep(supplierName)
+	m.logger.Debug("[MockSuppliers] Request", "supplier", supplierName, "scenario", scenario.Name, "step", step)
+
+	if step.Latency > 0 {
+		timer := time.NewTimer(step.Latency)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+		case <-r.Context().Done():
+			return
+		}
+	}
+	if step.Status != 0 && (step.Status < 200 || step.Status > 299) {
+		// Like real APIs, errors have a JSON body, which clients must not mistake for data
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(step.Status)
+		_, _ = fmt.Fprintf(w, `{"error":%q}`, http.StatusText(step.Status))
+		return
+	}
+
+	payload, err := m.payload(supplierName, step)
+	if err != nil {
+		m.logger.Error("[MockSuppliers] Failed to build the payload", "supplier", supplierName, "error", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if step.Status != 0 {
+		w.WriteHeader(step.Status)
+	}
+	_, _ = w.Write(payload)
+}
+
+// payload returns the fixture of supplierName as changed by step
+func (m *intMockSuppliers) payload(supplierName utils.Suppliers, step Step) ([]byte, error) {
+	if step.Empty {
+		return []byte("[]"), nil
+	}
+	name := string(supplierName) + ".json"
+	if len(step.Fixture) > 0 {
+		name = fmt.Sprintf("%s.%s.json", supplierName, step.Fixture)
+	}
+	payload, err := fs.ReadFile(m.fixtures, name)
+	if err != nil {
+		return nil, err
+	}
+	if len(step.Rename) > 0 || len(step.Drop) > 0 {
+		if payload, err = driftSchema(payload, step.Rename, step.Drop); err != nil {
+			return nil, fmt.Errorf("fixture %s: %w", name, err)
+		}
+	}
+	if step.Truncate {
+		payload = payload[:len(payload)/2]
+	}
+	return payload, nil
+}
+
+// driftSchema renames and removes fields of every record of a payload
+func driftSchema(payload []byte, rename map[string]string, drop []string) ([]byte, error) {
+	var records []map[string]json.RawMessage
+	if err := json.Unmarshal(payload, &records); err != nil {
+		return nil, err
+	}
+	for _, record := range records {
+		for _, field := range drop {
+			delete(record, field)
+		}
+		for from, to := range rename {
+			if value, ok := record[from]; ok {
+				delete(record, from)
+				record[to] = value
+			}
+		}
+	}
+	return json.Marshal(records)
+}
+
+// putScenario switches to the built-in scenario given by the name query parameter
+func (m *intMockSuppliers) putScenario(w http.ResponseWriter, r *http.Request) {
+	name := r.URL.Query().Get("name")
+	scenario, ok := Scenarios[name]
+	if !ok {
+		http.Error(w, fmt.Sprintf("unknown scenario %q", name), http.StatusBadRequest)
+		return
+	}
+	m.SetScenario(scenario)
+	m.logger.Info("[MockSuppliers] Scenario set", "scenario", name)
+	w.WriteHeader(http.StatusNoContent)
+}
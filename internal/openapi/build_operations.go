@@ -0,0 +1,198 @@
+package openapi
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const jsonContentType = "application/json"
+
+// pathVariable matches the {field} and {field=pattern} variables of a path template
+var pathVariable = regexp.MustCompile(`\{([^}=]+)(=[^}]*)?\}`)
+
+var errUnsupportedRule = errors.New("unsupported http rule")
+
+func (d *Document) addOperation(method protoreflect.MethodDescriptor, rule *annotations.HttpRule, binding int) error {
+	verb, template := httpPattern(rule)
+	if len(template) == 0 {
+		return errUnsupportedRule
+	}
+
+	operationID := fmt.Sprintf("%s_%s", method.Parent().Name(), method.Name())
+	if binding > 0 {
+		operationID = fmt.Sprintf("%s_%d", operationID, binding)
+	}
+	operation := &Operation{
+		OperationID: operationID,
+		Summary:     string(method.Name()),
+		Tags:        []string{string(method.Parent().Name())},
+		Responses:   make(map[string]*Response),
+	}
+
+	input := method.Input()
+	bound := make(map[string]bool)
+	for _, match := range pathVariable.FindAllStringSubmatch(template, -1) {
+		fieldPath := match[1]
+		field := findField(input, fieldPath)
+		if field == nil {
+			return fmt.Errorf("%w: path variable %q is not a field of %s", errUnsupportedRule, fieldPath, input.FullName())
+		}
+		bound[fieldPath] = true
+		operation.Parameters = append(operation.Parameters, Parameter{
+			Name:     fieldPath,
+			In:       "path",
+			Required: true,
+			Schema:   d.fieldSchema(field),
+		})
+	}
+	path := pathVariable.ReplaceAllString(template, "{$1}")
+
+	switch body := rule.GetBody(); body {
+	case "":
+		operation.Parameters = append(operation.Parameters, d.queryParameters(input, "", bound, nil)...)
+	case "*":
+		operation.RequestBody = &RequestBody{
+			Required: true,
+			Content:  map[string]MediaType{jsonContentType: {Schema: d.messageSchema(input)}},
+		}
+	default:
+		field := findField(input, body)
+		if field == nil {
+			return fmt.Errorf("%w: body %q is not a field of %s", errUnsupportedRule, body, input.FullName())
+		}
+		bound[body] = true
+		operation.RequestBody = &RequestBody{
+			Required: true,
+			Content:  map[string]MediaType{jsonContentType: {Schema: d.fieldSchema(field)}},
+		}
+		operation.Parameters = append(operation.Parameters, d.queryParameters(input, "", bound, nil)...)
+	}
+
+	responseSchema := d.messageSchema(method.Output())
+	if responseBody := rule.GetResponseBody(); len(responseBody) > 0 {
+		field := findField(method.Output(), responseBody)
+		if field == nil {
+			return fmt.Errorf("%w: response body %q is not a field of %s", errUnsupportedRule, responseBody, method.Output().FullName())
+		}
+		responseSchema = d.fieldSchema(field)
+	}
+	operation.Responses["200"] = &Response{
+		Description: "A successful response.",
+		Content:     map[string]MediaType{jsonContentType: {Schema: responseSchema}},
+	}
+
+	pathItem, exists := d.Paths[path]
+	if !exists {
+		pathItem = &PathItem{}
+		d.Paths[path] = pathItem
+	}
+	slot := pathItem.operation(verb)
+	if slot == nil {
+		return fmt.Errorf("%w: method %q", errUnsupportedRule, verb)
+	}
+	if *slot != nil {
+		return fmt.Errorf("%s %s is bound to both %s and %s", verb, path, (*slot).OperationID, operationID)
+	}
+	*slot = operation
+	return nil
+}
+
+// queryParameters returns a parameter per field of the message that is not bound to the path or body.
+// As the gateway does, fields of nested messages are named after their dotted path.
+func (d *Document) queryParameters(message protoreflect.MessageDescriptor, prefix string, bound map[string]bool, visited []protoreflect.FullName) []Parameter {
+	var parameters []Parameter
+	visited = append(slices.Clip(visited), message.FullName())
+	fields := message.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		name := prefix + field.JSONName()
+		if bound[prefix+string(field.Name())] || bound[name] || field.IsMap() {
+			continue
+		}
+		if field.Kind() == protoreflect.MessageKind {
+			_, wellKnown := wellKnownSchemas[field.Message().FullName()]
+			if !wellKnown {
+				// Repeated and recursive messages cannot be expressed as query parameters
+				if !field.IsList() && !slices.Contains(visited, field.Message().FullName()) {
+					parameters = append(parameters, d.queryParameters(field.Message(), name+".", bound, visited)...)
+				}
+				continue
+			}
+		}
+		parameters = append(parameters, Parameter{
+			Name:   name,
+			In:     "query",
+			Schema: d.fieldSchema(field),
+		})
+	}
+	return parameters
+}
+
+func httpPattern(rule *annotations.HttpRule) (verb string, template string) {
+	switch pattern := rule.GetPattern().(type) {
+	case *annotations.HttpRule_Get:
+		return "get", pattern.Get
+	case *annotations.HttpRule_Put:
+		return "put", pattern.Put
+	case *annotations.HttpRule_Post:
+		return "post", pattern.Post
+	case *annotations.HttpRule_Delete:
+		return "delete", pattern.Delete
+	case *annotations.HttpRule_Patch:
+		return "patch", pattern.Patch
+	case *annotations.HttpRule_Custom:
+		return strings.ToLower(pattern.Custom.GetKind()), pattern.Custom.GetPath()
+	}
+	return "", ""
+}
+
+func (p *PathItem) operation(verb string) **Operation {
+	switch verb {
+	case "get":
+		return &p.Get
+	case "put":
+		return &p.Put
+	case "post":
+		return &p.Post
+	case "delete":
+		return &p.Delete
+	case "patch":
+		return &p.Patch
+	}
+	return nil
+}
+
+// findField resolves a dotted field path, such as "hotel.id", in the message
+func findField(message protoreflect.MessageDescriptor, fieldPath string) protoreflect.FieldDescriptor {
+	var field protoreflect.FieldDescriptor
+	for _, name := range strings.Split(fieldPath, ".") {
+		if message == nil {
+			return nil
+		}
+		field = message.Fields().ByName(protoreflect.Name(name))
+		if field == nil {
+			return nil
+		}
+		message = field.Message()
+	}
+	return field
+}
+
+// Operations returns every operation of the document, so that callers can decorate them
+func (d *Document) Operations() []*Operation {
+	var operations []*Operation
+	for _, pathItem := range d.Paths {
+		for _, operation := range []*Operation{pathItem.Get, pathItem.Put, pathItem.Post, pathItem.Delete, pathItem.Patch} {
+			if operation != nil {
+				operations = append(operations, operation)
+			}
+		}
+	}
+	return operations
+}
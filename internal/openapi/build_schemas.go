@@ -0,0 +1,105 @@
+package openapi
+
+import (
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const schemaRefPrefix = "#/components/schemas/"
+
+// wellKnownSchemas are the well-known types with a special JSON mapping, inlined instead of referenced
+var wellKnownSchemas = map[protoreflect.FullName]Schema{
+	"google.protobuf.FieldMask":   {Type: "string", Description: "Comma-separated field paths"},
+	"google.protobuf.Timestamp":   {Type: "string", Format: "date-time"},
+	"google.protobuf.Duration":    {Type: "string", Description: `Seconds with an "s" suffix, e.g. "3.5s"`},
+	"google.protobuf.Struct":      {Type: "object"},
+	"google.protobuf.Value":       {},
+	"google.protobuf.ListValue":   {Type: "array", Items: &Schema{}},
+	"google.protobuf.Any":         {Type: "object"},
+	"google.protobuf.Empty":       {Type: "object"},
+	"google.protobuf.DoubleValue": {Type: "number", Format: "double"},
+	"google.protobuf.FloatValue":  {Type: "number", Format: "float"},
+	"google.protobuf.Int64Value":  {Type: "string", Format: "int64"},
+	"google.protobuf.UInt64Value": {Type: "string", Format: "uint64"},
+	"google.protobuf.Int32Value":  {Type: "integer", Format: "int32"},
+	"google.protobuf.UInt32Value": {Type: "integer", Format: "int64"},
+	"google.protobuf.BoolValue":   {Type: "boolean"},
+	"google.protobuf.StringValue": {Type: "string"},
+	"google.protobuf.BytesValue":  {Type: "string", Format: "byte"},
+}
+
+// scalarSchemas follow the proto3 JSON mapping, where 64-bit integers are strings
+var scalarSchemas = map[protoreflect.Kind]Schema{
+	protoreflect.BoolKind:     {Type: "boolean"},
+	protoreflect.Int32Kind:    {Type: "integer", Format: "int32"},
+	protoreflect.Sint32Kind:   {Type: "integer", Format: "int32"},
+	protoreflect.Sfixed32Kind: {Type: "integer", Format: "int32"},
+	protoreflect.Uint32Kind:   {Type: "integer", Format: "int64"},
+	protoreflect.Fixed32Kind:  {Type: "integer", Format: "int64"},
+	protoreflect.Int64Kind:    {Type: "string", Format: "int64"},
+	protoreflect.Sint64Kind:   {Type: "string", Format: "int64"},
+	protoreflect.Sfixed64Kind: {Type: "string", Format: "int64"},
+	protoreflect.Uint64Kind:   {Type: "string", Format: "uint64"},
+	protoreflect.Fixed64Kind:  {Type: "string", Format: "uint64"},
+	protoreflect.FloatKind:    {Type: "number", Format: "float"},
+	protoreflect.DoubleKind:   {Type: "number", Format: "double"},
+	protoreflect.StringKind:   {Type: "string"},
+	protoreflect.BytesKind:    {Type: "string", Format: "byte"},
+}
+
+// fieldSchema returns the schema of a field, as an array for repeated fields and an object for maps
+func (d *Document) fieldSchema(field protoreflect.FieldDescriptor) *Schema {
+	switch {
+	case field.IsMap():
+		return &Schema{Type: "object", AdditionalProperties: d.valueSchema(field.MapValue())}
+	case field.IsList():
+		return &Schema{Type: "array", Items: d.valueSchema(field)}
+	default:
+		return d.valueSchema(field)
+	}
+}
+
+// valueSchema returns the schema of a single value of the field
+func (d *Document) valueSchema(field protoreflect.FieldDescriptor) *Schema {
+	switch field.Kind() {
+	case protoreflect.EnumKind:
+		return d.enumSchema(field.Enum())
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return d.messageSchema(field.Message())
+	default:
+		schema := scalarSchemas[field.Kind()]
+		return &schema
+	}
+}
+
+// messageSchema returns a reference to the message's component schema, which it adds on first use
+func (d *Document) messageSchema(message protoreflect.MessageDescriptor) *Schema {
+	if schema, ok := wellKnownSchemas[message.FullName()]; ok {
+		return &schema
+	}
+	name := string(message.FullName())
+	if _, exists := d.Components.Schemas[name]; !exists {
+		schema := &Schema{Type: "object", Properties: make(map[string]*Schema)}
+		// Added before its fields, so that recursive messages end on the reference
+		d.Components.Schemas[name] = schema
+		fields := message.Fields()
+		for i := 0; i < fields.Len(); i++ {
+			field := fields.Get(i)
+			schema.Properties[field.JSONName()] = d.fieldSchema(field)
+		}
+	}
+	return &Schema{Ref: schemaRefPrefix + name}
+}
+
+// enumSchema returns a reference to the enum's component schema; the gateway writes enums as their value names
+func (d *Document) enumSchema(enum protoreflect.EnumDescriptor) *Schema {
+	name := string(enum.FullName())
+	if _, exists := d.Components.Schemas[name]; !exists {
+		schema := &Schema{Type: "string"}
+		values := enum.Values()
+		for i := 0; i < values.Len(); i++ {
+			schema.Enum = append(schema.Enum, string(values.Get(i).Name()))
+		}
+		d.Components.Schemas[name] = schema
+	}
+	return &Schema{Ref: schemaRefPrefix + name}
+}
@@ -0,0 +1,240 @@
+package openapi
+
+import (
+	"errors"
+	"reflect"
+	"slices"
+	"testing"
+
+	"hotelsDataMerge/proto"
+
+	"google.golang.org/genproto/googleapis/api/annotations"
+	protobuf "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// newTestService builds a service with one method per rule, all taking a Request and returning a Response:
+//
+//	message Request { string name = 1; Filter filter = 2; Request parent = 3; map<string, string> labels = 4; }
+//	message Filter { int64 min_id = 1; Request request = 2; }
+//	message Response { repeated Filter filters = 1; }
+func newTestService(t *testing.T, rules ...*annotations.HttpRule) protoreflect.ServiceDescriptor {
+	t.Helper()
+	field := func(name string, number int32, fieldType descriptorpb.FieldDescriptorProto_Type, typeName string, label descriptorpb.FieldDescriptorProto_Label) *descriptorpb.FieldDescriptorProto {
+		f := &descriptorpb.FieldDescriptorProto{
+			Name:   protobuf.String(name),
+			Number: protobuf.Int32(number),
+			Type:   fieldType.Enum(),
+			Label:  label.Enum(),
+		}
+		if len(typeName) > 0 {
+			f.TypeName = protobuf.String(typeName)
+		}
+		return f
+	}
+	optional := descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL
+	repeated := descriptorpb.FieldDescriptorProto_LABEL_REPEATED
+	message := descriptorpb.FieldDescriptorProto_TYPE_MESSAGE
+
+	file := &descriptorpb.FileDescriptorProto{
+		Name:    protobuf.String("openapi_test.proto"),
+		Package: protobuf.String("test"),
+		Syntax:  protobuf.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{
+			{
+				Name: protobuf.String("Request"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					field("name", 1, descriptorpb.FieldDescriptorProto_TYPE_STRING, "", optional),
+					field("filter", 2, message, ".test.Filter", optional),
+					field("parent", 3, message, ".test.Request", optional),
+					field("labels", 4, message, ".test.Request.LabelsEntry", repeated),
+				},
+				NestedType: []*descriptorpb.DescriptorProto{{
+					Name: protobuf.String("LabelsEntry"),
+					Field: []*descriptorpb.FieldDescriptorProto{
+						field("key", 1, descriptorpb.FieldDescriptorProto_TYPE_STRING, "", optional),
+						field("value", 2, descriptorpb.FieldDescriptorProto_TYPE_STRING, "", optional),
+					},
+					Options: &descriptorpb.MessageOptions{MapEntry: protobuf.Bool(true)},
+				}},
+			},
+			{
+				Name: protobuf.String("Filter"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					field("min_id", 1, descriptorpb.FieldDescriptorProto_TYPE_INT64, "", optional),
+					field("request", 2, message, ".test.Request", optional),
+				},
+			},
+			{
+				Name: protobuf.String("Response"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					field("filters", 1, message, ".test.Filter", repeated),
+				},
+			},
+		},
+		Service: []*descriptorpb.ServiceDescriptorProto{{Name: protobuf.String("TestService")}},
+	}
+	for i, rule := range rules {
+		options := &descriptorpb.MethodOptions{}
+		protobuf.SetExtension(options, annotations.E_Http, rule)
+		file.Service[0].Method = append(file.Service[0].Method, &descriptorpb.MethodDescriptorProto{
+			Name:       protobuf.String(string(rune('A' + i))),
+			InputType:  protobuf.String(".test.Request"),
+			OutputType: protobuf.String(".test.Response"),
+			Options:    options,
+		})
+	}
+	fileDescriptor, err := protodesc.NewFile(file, protoregistry.GlobalFiles)
+	if err != nil {
+		t.Fatalf("NewFile() error = %v", err)
+	}
+	return fileDescriptor.Services().Get(0)
+}
+
+func TestGenerate(t *testing.T) {
+	ref := func(name string) *Schema { return &Schema{Ref: schemaRefPrefix + name} }
+	stringSchema := &Schema{Type: "string"}
+	responses := map[string]*Response{
+		"200": {Description: "A successful response.", Content: map[string]MediaType{jsonContentType: {Schema: ref("test.Response")}}},
+	}
+
+	tests := []struct {
+		name    string
+		rules   []*annotations.HttpRule
+		want    map[string]*PathItem
+		wantErr bool
+	}{
+		{
+			name: "Success - Path variable and flattened query parameters",
+			rules: []*annotations.HttpRule{
+				{Pattern: &annotations.HttpRule_Get{Get: "/v1/items/{name=items/*}"}},
+			},
+			want: map[string]*PathItem{
+				"/v1/items/{name}": {Get: &Operation{
+					OperationID: "TestService_A",
+					Summary:     "A",
+					Tags:        []string{"TestService"},
+					Parameters: []Parameter{
+						{Name: "name", In: "path", Required: true, Schema: stringSchema},
+						{Name: "filter.minId", In: "query", Schema: &Schema{Type: "string", Format: "int64"}},
+					},
+					Responses: responses,
+				}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Success - Body and additional binding",
+			rules: []*annotations.HttpRule{
+				{
+					Pattern: &annotations.HttpRule_Post{Post: "/v1/items"},
+					Body:    "*",
+					AdditionalBindings: []*annotations.HttpRule{
+						{Pattern: &annotations.HttpRule_Put{Put: "/v1/items/{name}"}, Body: "filter"},
+					},
+				},
+			},
+			want: map[string]*PathItem{
+				"/v1/items": {Post: &Operation{
+					OperationID: "TestService_A",
+					Summary:     "A",
+					Tags:        []string{"TestService"},
+					RequestBody: &RequestBody{Required: true, Content: map[string]MediaType{jsonContentType: {Schema: ref("test.Request")}}},
+					Responses:   responses,
+				}},
+				"/v1/items/{name}": {Put: &Operation{
+					OperationID: "TestService_A_1",
+					Summary:     "A",
+					Tags:        []string{"TestService"},
+					Parameters: []Parameter{
+						{Name: "name", In: "path", Required: true, Schema: stringSchema},
+					},
+					RequestBody: &RequestBody{Required: true, Content: map[string]MediaType{jsonContentType: {Schema: ref("test.Filter")}}},
+					Responses:   responses,
+				}},
+			},
+			wantErr: false,
+		},
+		{
+			name:    "Error - Path variable is not a field",
+			rules:   []*annotations.HttpRule{{Pattern: &annotations.HttpRule_Get{Get: "/v1/items/{id}"}}},
+			wantErr: true,
+		},
+		{
+			name: "Error - Same path and method twice",
+			rules: []*annotations.HttpRule{
+				{Pattern: &annotations.HttpRule_Get{Get: "/v1/items"}},
+				{Pattern: &annotations.HttpRule_Get{Get: "/v1/items"}},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Generate(Info{Title: "Test", Version: "v1"}, newTestService(t, tt.rules...))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Generate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				if len(tt.rules) == 1 && !errors.Is(err, errUnsupportedRule) {
+					t.Errorf("Generate() error = %v, want %v", err, errUnsupportedRule)
+				}
+				return
+			}
+			for path, want := range tt.want {
+				if !reflect.DeepEqual(got.Paths[path], want) {
+					t.Errorf("Generate() paths[%s] = %+v, want %+v", path, got.Paths[path], want)
+				}
+			}
+			if len(got.Paths) != len(tt.want) {
+				t.Errorf("Generate() has %d paths, want %d", len(got.Paths), len(tt.want))
+			}
+		})
+	}
+}
+
+func TestGenerate_Schemas(t *testing.T) {
+	got, err := Generate(Info{Title: "Hotels", Version: "v1"}, proto.File_proto_hotelsdatamerge_proto.Services().Get(0))
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	if got.OpenAPI != version {
+		t.Errorf("Generate() openapi = %v, want %v", got.OpenAPI, version)
+	}
+
+	tests := []struct {
+		name     string
+		schema   string
+		property string
+		want     *Schema
+	}{
+		{name: "Success - int64 as a string", schema: "proto.Hotel", property: "destinationId", want: &Schema{Type: "string", Format: "int64"}},
+		{name: "Success - lowerCamelCase message reference", schema: "proto.Hotel", property: "bookingPolicy", want: &Schema{Ref: schemaRefPrefix + "proto.BookingPolicy"}},
+		{name: "Success - Repeated message", schema: "proto.GetHotelsResponse", property: "hotels", want: &Schema{Type: "array", Items: &Schema{Ref: schemaRefPrefix + "proto.Hotel"}}},
+		{name: "Success - Enum reference", schema: "proto.BookingPolicy", property: "pets", want: &Schema{Ref: schemaRefPrefix + "proto.PetPolicy"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schema, ok := got.Components.Schemas[tt.schema]
+			if !ok {
+				t.Fatalf("Generate() has no %s schema", tt.schema)
+			}
+			if property := schema.Properties[tt.property]; !reflect.DeepEqual(property, tt.want) {
+				t.Errorf("Generate() %s.%s = %+v, want %+v", tt.schema, tt.property, property, tt.want)
+			}
+		})
+	}
+
+	wantReadMask := Parameter{Name: "readMask", In: "query", Schema: &Schema{Type: "string", Description: "Comma-separated field paths"}}
+	if parameters := got.Paths["/v1/hotels/{id}"].Get.Parameters; !slices.ContainsFunc(parameters, func(p Parameter) bool { return reflect.DeepEqual(p, wantReadMask) }) {
+		t.Errorf("Generate() GetHotel parameters = %+v, want %+v", parameters, wantReadMask)
+	}
+
+	wantEnum := &Schema{Type: "string", Enum: []string{"PETS_UNKNOWN", "PETS_ALLOWED", "PETS_NOT_ALLOWED", "PETS_ON_REQUEST"}}
+	if enum := got.Components.Schemas["proto.PetPolicy"]; !reflect.DeepEqual(enum, wantEnum) {
+		t.Errorf("Generate() proto.PetPolicy = %+v, want %+v", enum, wantEnum)
+	}
+}
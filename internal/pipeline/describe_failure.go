@@ -0,0 +1,45 @@
+package pipeline
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+
+	"hotelsDataMerge/external"
+)
+
+var (
+	errFetch = errors.New("failed to fetch suppliers data")
+	errParse = errors.New("failed to parse and map suppliers data")
+)
+
+// describeFailure returns the stage and kind of a supplier failure, such as "fetch failed: timeout".
+// Unlike the error, it holds neither the supplier URL, whose query may carry credentials, nor
+// response details, so it can be published on unauthenticated endpoints.
+func describeFailure(err error) string {
+	stage := "refresh failed"
+	switch {
+	case errors.Is(err, errFetch):
+		stage = "fetch failed"
+	case errors.Is(err, errParse):
+		stage = "parse failed"
+	}
+
+	var netErr net.Error
+	var syntaxErr *json.SyntaxError
+	var typeErr *json.UnmarshalTypeError
+	switch {
+	case errors.Is(err, external.ErrUnexpectedStatus):
+		return stage + ": error status"
+	case errors.Is(err, context.Canceled):
+		return stage + ": cancelled"
+	case errors.Is(err, context.DeadlineExceeded), errors.As(err, &netErr) && netErr.Timeout():
+		return stage + ": timeout"
+	case errors.As(err, &netErr):
+		return stage + ": connection error"
+	case errors.As(err, &syntaxErr), errors.As(err, &typeErr):
+		return stage + ": invalid response"
+	}
+	return stage
+}
@@ -0,0 +1,70 @@
+package pipeline
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"syscall"
+	"testing"
+
+	"hotelsDataMerge/external"
+)
+
+func Test_describeFailure(t *testing.T) {
+	const supplierURL = "https://acme.example/hotels?key=secret"
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{
+			name: "Success - Fetch timeout",
+			err:  fmt.Errorf("%w: %w", errFetch, &url.Error{Op: "Get", URL: supplierURL, Err: context.DeadlineExceeded}),
+			want: "fetch failed: timeout",
+		},
+		{
+			name: "Success - Fetch connection error",
+			err:  fmt.Errorf("%w: %w", errFetch, &url.Error{Op: "Get", URL: supplierURL, Err: syscall.ECONNREFUSED}),
+			want: "fetch failed: connection error",
+		},
+		{
+			name: "Success - Supplier error status",
+			err:  fmt.Errorf("%w: %w", errFetch, fmt.Errorf("%w %d", external.ErrUnexpectedStatus, 503)),
+			want: "fetch failed: error status",
+		},
+		{
+			name: "Success - Fetch cancelled",
+			err:  fmt.Errorf("%w: %w", errFetch, context.Canceled),
+			want: "fetch failed: cancelled",
+		},
+		{
+			name: "Success - Invalid response",
+			err:  fmt.Errorf("%w: %w", errFetch, &json.SyntaxError{Offset: 3}),
+			want: "fetch failed: invalid response",
+		},
+		{
+			name: "Success - Parse failure",
+			err:  fmt.Errorf("%w: %w", errParse, errors.New("unknown field")),
+			want: "parse failed",
+		},
+		{
+			name: "Success - Unknown stage",
+			err:  errors.New("boom"),
+			want: "refresh failed",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := describeFailure(tt.err)
+			if got != tt.want {
+				t.Errorf("describeFailure() = %q, want %q", got, tt.want)
+			}
+			if strings.Contains(got, "secret") {
+				t.Errorf("describeFailure() = %q, must not contain the supplier URL", got)
+			}
+		})
+	}
+}
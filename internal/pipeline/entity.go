@@ -0,0 +1,83 @@
+package pipeline
+
+import (
+	"time"
+
+	"hotelsDataMerge/internal/suppliers/quality"
+	"hotelsDataMerge/internal/suppliers/utils"
+)
+
+type RunState string
+
+const (
+	RunRunning            RunState = "running"
+	RunSucceeded          RunState = "succeeded"
+	RunPartiallySucceeded RunState = "partially_succeeded"
+	RunFailed             RunState = "failed"
+)
+
+type SupplierState string
+
+const (
+	// SupplierOK means the supplier's data was fetched and parsed in this run
+	SupplierOK SupplierState = "ok"
+	// SupplierFailed means fetching or parsing failed; the data of the last successful run is used instead
+	SupplierFailed SupplierState = "failed"
+	// SupplierSkipped means the supplier was not selected for this run and was not fetched; the data of its last successful
+	// run, if any, is used
+	SupplierSkipped SupplierState = "skipped"
+)
+
+type Trigger string
+
+const (
+	TriggerStartup  Trigger = "startup"
+	TriggerAdmin    Trigger = "admin"
+	TriggerSchedule Trigger = "schedule"
+)
+
+// Run describes one refresh of the suppliers data
+type Run struct {
+	ID         int64            `json:"id"`
+	Trigger    Trigger          `json:"trigger"`
+	State      RunState         `json:"state"`
+	StartedAt  time.Time        `json:"started_at"`
+	FinishedAt time.Time        `json:"finished_at"`
+	Suppliers  []SupplierStatus `json:"suppliers"`
+	HotelCount int              `json:"hotel_count"`
+	Error      string           `json:"error"`
+}
+
+type SupplierStatus struct {
+	Supplier      utils.Suppliers `json:"supplier"`
+	State         SupplierState   `json:"state"`
+	RecordCount   int             `json:"record_count"`
+	FetchDuration time.Duration   `json:"fetch_duration"`
+	LastSuccessAt time.Time       `json:"last_success_at"`
+	Error         string          `json:"error"`
+	// Failure is the stage and kind of the error, without its details, e.g. "fetch failed: timeout"
+	Failure string `json:"failure"`
+}
+
+// Snapshot describes the merged hotels currently served
+type Snapshot struct {
+	// Version is the ID of the run that produced the snapshot
+	Version    int64     `json:"version"`
+	LoadedAt   time.Time `json:"loaded_at"`
+	HotelCount int       `json:"hotel_count"`
+}
+
+// QualityReport is the data quality report of the merge of one run
+type QualityReport struct {
+	// Run is the ID of the run whose merge produced the report
+	Run int64 `json:"run"`
+	quality.Report
+}
+
+// Duration returns how long the run took, or has taken so far
+func (r Run) Duration() time.Duration {
+	if r.FinishedAt.IsZero() {
+		return time.Since(r.StartedAt)
+	}
+	return r.FinishedAt.Sub(r.StartedAt)
+}
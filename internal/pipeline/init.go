@@ -0,0 +1,79 @@
+package pipeline
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"time"
+
+	"hotelsDataMerge/internal/hotels"
+	"hotelsDataMerge/internal/metrics"
+	"hotelsDataMerge/internal/suppliers"
+	"hotelsDataMerge/internal/suppliers/utils"
+)
+
+var (
+	ErrRefreshInProgress = errors.New("a refresh is already in progress")
+	ErrUnknownSupplier   = errors.New("unknown supplier")
+	ErrShuttingDown      = errors.New("the pipeline is shutting down")
+)
+
+type IntPipeline interface {
+	// TriggerRefresh fetches, parses and merges the given suppliers, or all suppliers when none are given.
+	// With wait, it returns the finished run; otherwise it returns the running run immediately.
+	TriggerRefresh(ctx context.Context, trigger Trigger, supplierNames []utils.Suppliers, wait bool) (Run, error)
+	// GetStatus returns the run in progress, if any, and the last finished run, if any
+	GetStatus() (current *Run, last *Run)
+	ListSuppliers() []SupplierStatus
+	// GetSnapshot returns the snapshot currently served, or false before the first successful run
+	GetSnapshot() (Snapshot, bool)
+	// GetQualityReport returns the quality report of the last run that merged the suppliers data,
+	// or false before the first successful run
+	GetQualityReport() (QualityReport, bool)
+	// RunScheduler refreshes all suppliers every interval until ctx is done. A tick is skipped
+	// while another refresh is in progress; a zero interval returns immediately.
+	RunScheduler(ctx context.Context, interval time.Duration)
+	// Shutdown cancels the refresh in progress, including its supplier fetches, and waits until it has
+	// returned or ctx is done. Refreshes triggered afterwards fail with ErrShuttingDown.
+	Shutdown(ctx context.Context) error
+}
+
+type intPipeline struct {
+	logger    *slog.Logger
+	suppliers *suppliers.IntSuppliers
+	metrics   *metrics.Metrics
+	now       func() time.Time
+
+	// ctx is cancelled by Shutdown, which cancels every run
+	ctx    context.Context
+	cancel context.CancelFunc
+	runs   sync.WaitGroup
+
+	mu      sync.Mutex
+	nextID  int64
+	current *Run
+	last    *Run
+	// snapshot is nil until a run has saved the merged hotels
+	snapshot *Snapshot
+	// qualityReport is nil until a run has merged the suppliers data
+	qualityReport *QualityReport
+	// hotelsBySupplier keeps the hotels of each supplier's last successful run, so that
+	// a refresh of some suppliers, or a failing supplier, does not drop the other hotels
+	hotelsBySupplier map[utils.Suppliers][]hotels.Hotel
+	supplierStatuses map[utils.Suppliers]SupplierStatus
+}
+
+func Initialize(logger *slog.Logger, intSuppliers *suppliers.IntSuppliers, appMetrics *metrics.Metrics) IntPipeline {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &intPipeline{
+		logger:           logger,
+		ctx:              ctx,
+		cancel:           cancel,
+		suppliers:        intSuppliers,
+		metrics:          appMetrics,
+		now:              time.Now,
+		hotelsBySupplier: make(map[utils.Suppliers][]hotels.Hotel),
+		supplierStatuses: make(map[utils.Suppliers]SupplierStatus),
+	}
+}
@@ -0,0 +1,232 @@
+package pipeline
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"hotelsDataMerge/external"
+	"hotelsDataMerge/internal/hotels"
+	"hotelsDataMerge/internal/metrics"
+	"hotelsDataMerge/internal/suppliers/fetcher"
+	"hotelsDataMerge/internal/suppliers/quality"
+	"hotelsDataMerge/internal/suppliers/utils"
+	"hotelsDataMerge/internal/tracing"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var tracer = otel.Tracer("hotelsDataMerge/internal/pipeline")
+
+func (p *intPipeline) TriggerRefresh(ctx context.Context, trigger Trigger, supplierNames []utils.Suppliers, wait bool) (Run, error) {
+	selected, err := selectSuppliers(supplierNames)
+	if err != nil {
+		return Run{}, err
+	}
+
+	p.mu.Lock()
+	if p.ctx.Err() != nil {
+		p.mu.Unlock()
+		return Run{}, ErrShuttingDown
+	}
+	if p.current != nil {
+		p.mu.Unlock()
+		return Run{}, ErrRefreshInProgress
+	}
+	p.runs.Add(1)
+	p.nextID++
+	run := Run{
+		ID:        p.nextID,
+		Trigger:   trigger,
+		State:     RunRunning,
+		StartedAt: p.now(),
+	}
+	p.current = &run
+	p.mu.Unlock()
+
+	if !wait {
+		// The run outlives the request that triggered it, but stays in its trace
+		go p.refresh(context.WithoutCancel(ctx), run, selected)
+		return run, nil
+	}
+	return p.refresh(ctx, run, selected), nil
+}
+
+// selectSuppliers returns the set of suppliers to refresh, all of them when none are given
+func selectSuppliers(supplierNames []utils.Suppliers) (map[utils.Suppliers]bool, error) {
+	selected := make(map[utils.Suppliers]bool)
+	for _, supplierName := range supplierNames {
+		if !slices.Contains(utils.SupplierPriority, supplierName) {
+			return nil, fmt.Errorf("%w %q", ErrUnknownSupplier, supplierName)
+		}
+		selected[supplierName] = true
+	}
+	if len(selected) == 0 {
+		for _, supplierName := range utils.SupplierPriority {
+			selected[supplierName] = true
+		}
+	}
+	return selected, nil
+}
+
+func (p *intPipeline) refresh(ctx context.Context, run Run, selected map[utils.Suppliers]bool) Run {
+	defer p.runs.Done()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stopCancelOnShutdown := context.AfterFunc(p.ctx, cancel)
+	defer stopCancelOnShutdown()
+
+	ctx, span := tracer.Start(ctx, "pipeline.Refresh", trace.WithAttributes(
+		attribute.Int64("run", run.ID),
+		attribute.String("trigger", string(run.Trigger)),
+	))
+	defer span.End()
+	// Payloads recorded by the fetcher are grouped by run
+	ctx = fetcher.WithRun(ctx, fetcher.Run{ID: run.ID, StartedAt: run.StartedAt})
+
+	p.logger.Info("[Pipeline] Starting suppliers data fetch and processing", "run", run.ID, "trigger", run.Trigger)
+
+	var mappedData []hotels.Hotel
+	failed := 0
+	for _, supplierName := range allSuppliers() {
+		supplierStatus, supplierHotels := p.refreshSupplier(ctx, supplierName, selected[supplierName])
+		if supplierStatus.State == SupplierFailed {
+			failed++
+		}
+		run.Suppliers = append(run.Suppliers, supplierStatus)
+		mappedData = append(mappedData, supplierHotels...)
+	}
+
+	switch {
+	case ctx.Err() != nil:
+		// The fetches were cut short, so the served hotels are left unchanged
+		run.State = RunFailed
+		run.Error = "refresh cancelled"
+	case len(mappedData) == 0 && failed > 0:
+		run.State = RunFailed
+		run.Error = "no supplier data available"
+	case failed > 0:
+		run.State = RunPartiallySucceeded
+	default:
+		run.State = RunSucceeded
+	}
+
+	var qualityReport quality.Report
+	if run.State != RunFailed {
+		mergeStartedAt := p.now()
+		mergedHotels := p.suppliers.Merger.MergeHotelsData(ctx, mappedData)
+		mergeDuration := p.now().Sub(mergeStartedAt)
+		// Only block readers while the maps are swapped
+		external.FetchSuppliersMutex.Lock()
+		hotels.SaveMaps(ctx, mergedHotels)
+		hotels.SetSnapshotVersion(run.ID)
+		external.FetchSuppliersMutex.Unlock()
+		run.HotelCount = len(mergedHotels)
+		p.metrics.ObserveSnapshot(mergeDuration, run.HotelCount)
+		qualityReport = p.suppliers.Merger.GetQualityReport()
+	}
+	run.FinishedAt = p.now()
+	span.SetAttributes(attribute.String("state", string(run.State)), attribute.Int("hotels", run.HotelCount))
+	if run.State == RunFailed {
+		span.SetStatus(codes.Error, run.Error)
+	}
+
+	p.mu.Lock()
+	p.current = nil
+	p.last = &run
+	if run.State != RunFailed {
+		p.snapshot = &Snapshot{Version: run.ID, LoadedAt: run.FinishedAt, HotelCount: run.HotelCount}
+		p.qualityReport = &QualityReport{Run: run.ID, Report: qualityReport}
+	}
+	p.mu.Unlock()
+
+	p.logger.Info("[Pipeline] Suppliers data fetch and processing finished",
+		"run", run.ID,
+		"state", run.State,
+		"duration", run.Duration(),
+		"hotels", run.HotelCount,
+		"qualityIssues", len(qualityReport.Issues),
+		"unmappedAmenities", len(qualityReport.UnmappedAmenities),
+	)
+	return run
+}
+
+// refreshSupplier fetches and parses one supplier when it is selected, and otherwise, or when that
+// fails, returns the hotels of the supplier's last successful run, if any. An unselected supplier
+// is never fetched, even when it has no hotels yet
+func (p *intPipeline) refreshSupplier(ctx context.Context, supplierName utils.Suppliers, selected bool) (SupplierStatus, []hotels.Hotel) {
+	p.mu.Lock()
+	supplierStatus := p.supplierStatuses[supplierName]
+	cachedHotels := p.hotelsBySupplier[supplierName]
+	p.mu.Unlock()
+
+	supplierStatus.Supplier = supplierName
+	if !selected {
+		supplierStatus.State = SupplierSkipped
+		supplierStatus.RecordCount = len(cachedHotels)
+		supplierStatus.Error = ""
+		supplierStatus.Failure = ""
+		supplierStatus.FetchDuration = 0
+		return supplierStatus, cachedHotels
+	}
+
+	startedAt := p.now()
+	supplierHotels, err := p.fetchAndParse(ctx, supplierName)
+	supplierStatus.FetchDuration = p.now().Sub(startedAt)
+	if err != nil {
+		p.logger.Error("[Pipeline] Failed to refresh supplier data", "supplier", supplierName, "error", err)
+		supplierStatus.State = SupplierFailed
+		supplierStatus.RecordCount = len(cachedHotels)
+		supplierStatus.Error = err.Error()
+		supplierStatus.Failure = describeFailure(err)
+		p.saveSupplierStatus(supplierStatus, nil)
+		return supplierStatus, cachedHotels
+	}
+
+	supplierStatus.State = SupplierOK
+	supplierStatus.RecordCount = len(supplierHotels)
+	supplierStatus.LastSuccessAt = p.now()
+	supplierStatus.Error = ""
+	supplierStatus.Failure = ""
+	p.saveSupplierStatus(supplierStatus, supplierHotels)
+	return supplierStatus, supplierHotels
+}
+
+func (p *intPipeline) fetchAndParse(ctx context.Context, supplierName utils.Suppliers) (supplierHotels []hotels.Hotel, err error) {
+	ctx, span := tracer.Start(ctx, "pipeline.RefreshSupplier", trace.WithAttributes(
+		attribute.String("supplier", string(supplierName)),
+	))
+	defer func() { tracing.EndSpan(span, err) }()
+
+	rawResp, err := p.suppliers.Fetcher.GetSupplierData(ctx, supplierName)
+	if err != nil {
+		p.metrics.ObserveSupplierError(string(supplierName), metrics.StageFetch)
+		return nil, fmt.Errorf("%w: %w", errFetch, err)
+	}
+	supplierHotels, err = p.suppliers.Parser.ParseSuppliersData(ctx, map[utils.Suppliers]json.RawMessage{supplierName: rawResp})
+	if err != nil {
+		p.metrics.ObserveSupplierError(string(supplierName), metrics.StageParse)
+		return nil, fmt.Errorf("%w: %w", errParse, err)
+	}
+	p.metrics.ObserveSupplierRecords(string(supplierName), len(supplierHotels))
+	return supplierHotels, nil
+}
+
+// saveSupplierStatus records the supplier's status and, when given, the hotels of its successful run
+func (p *intPipeline) saveSupplierStatus(supplierStatus SupplierStatus, supplierHotels []hotels.Hotel) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.supplierStatuses[supplierStatus.Supplier] = supplierStatus
+	if supplierHotels != nil {
+		p.hotelsBySupplier[supplierStatus.Supplier] = supplierHotels
+	}
+}
+
+// allSuppliers returns every supplier in priority order
+func allSuppliers() []utils.Suppliers {
+	return slices.Clone(utils.SupplierPriority)
+}
@@ -0,0 +1,300 @@
+package pipeline
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"reflect"
+	"testing"
+	"time"
+
+	"hotelsDataMerge/internal/hotels"
+	"hotelsDataMerge/internal/suppliers"
+	"hotelsDataMerge/internal/suppliers/fetcher"
+	"hotelsDataMerge/internal/suppliers/quality"
+	"hotelsDataMerge/internal/suppliers/utils"
+)
+
+type mockFetcher struct {
+	responses map[utils.Suppliers]json.RawMessage
+	errors    map[utils.Suppliers]error
+	// runs are the runs the fetches belonged to
+	runs []fetcher.Run
+}
+
+func (m *mockFetcher) GetSupplierData(ctx context.Context, supplierName utils.Suppliers) (json.RawMessage, error) {
+	if run, ok := fetcher.RunFromContext(ctx); ok {
+		m.runs = append(m.runs, run)
+	}
+	if err, exists := m.errors[supplierName]; exists {
+		return nil, err
+	}
+	return m.responses[supplierName], nil
+}
+
+// mockParser reads each supplier response as a list of hotel IDs
+type mockParser struct{}
+
+func (m *mockParser) ParseSuppliersData(ctx context.Context, resp map[utils.Suppliers]json.RawMessage) ([]hotels.Hotel, error) {
+	var mappedData []hotels.Hotel
+	for _, rawResp := range resp {
+		var hotelIDs []string
+		if err := json.Unmarshal(rawResp, &hotelIDs); err != nil {
+			return nil, err
+		}
+		for _, hotelID := range hotelIDs {
+			mappedData = append(mappedData, hotels.Hotel{Id: hotelID})
+		}
+	}
+	return mappedData, nil
+}
+
+type mockMerger struct{}
+
+func (m *mockMerger) MergeHotelsData(ctx context.Context, mappedData []hotels.Hotel) map[string]hotels.Hotel {
+	mergedHotels := make(map[string]hotels.Hotel)
+	for _, hotel := range mappedData {
+		mergedHotels[hotel.Id] = hotel
+	}
+	return mergedHotels
+}
+
+func (m *mockMerger) GetQualityReport() quality.Report {
+	return quality.Report{}
+}
+
+func newTestPipeline(fetcher *mockFetcher) *intPipeline {
+	now := time.Date(2026, 10, 19, 8, 0, 0, 0, time.UTC)
+	p := Initialize(slog.Default(), &suppliers.IntSuppliers{
+		Fetcher: fetcher,
+		Parser:  &mockParser{},
+		Merger:  &mockMerger{},
+	}, nil).(*intPipeline)
+	p.now = func() time.Time { return now }
+	return p
+}
+
+func supplierStates(run Run) map[utils.Suppliers]SupplierState {
+	states := make(map[utils.Suppliers]SupplierState)
+	for _, supplierStatus := range run.Suppliers {
+		states[supplierStatus.Supplier] = supplierStatus.State
+	}
+	return states
+}
+
+func Test_intPipeline_TriggerRefresh(t *testing.T) {
+	tests := []struct {
+		name           string
+		responses      map[utils.Suppliers]json.RawMessage
+		errors         map[utils.Suppliers]error
+		previous       []utils.Suppliers
+		supplierNames  []utils.Suppliers
+		wantState      RunState
+		wantSuppliers  map[utils.Suppliers]SupplierState
+		wantHotelCount int
+		wantFetches    int
+		wantErr        error
+	}{
+		{
+			name: "Success - Refresh all suppliers",
+			responses: map[utils.Suppliers]json.RawMessage{
+				utils.Acme:       json.RawMessage(`["a1","a2"]`),
+				utils.Patagonia:  json.RawMessage(`["p1"]`),
+				utils.Paperflies: json.RawMessage(`["a1"]`),
+			},
+			wantState: RunSucceeded,
+			wantSuppliers: map[utils.Suppliers]SupplierState{
+				utils.Acme:       SupplierOK,
+				utils.Patagonia:  SupplierOK,
+				utils.Paperflies: SupplierOK,
+			},
+			wantHotelCount: 3,
+			wantFetches:    3,
+		},
+		{
+			name: "Success - Failing supplier falls back to its last data",
+			responses: map[utils.Suppliers]json.RawMessage{
+				utils.Acme:       json.RawMessage(`["a1"]`),
+				utils.Patagonia:  json.RawMessage(`["p1"]`),
+				utils.Paperflies: json.RawMessage(`["f1"]`),
+			},
+			errors:    map[utils.Suppliers]error{utils.Patagonia: errors.New("connection refused")},
+			previous:  []utils.Suppliers{utils.Patagonia},
+			wantState: RunPartiallySucceeded,
+			wantSuppliers: map[utils.Suppliers]SupplierState{
+				utils.Acme:       SupplierOK,
+				utils.Patagonia:  SupplierFailed,
+				utils.Paperflies: SupplierOK,
+			},
+			wantHotelCount: 3,
+			wantFetches:    3,
+		},
+		{
+			name: "Success - Refresh a subset of suppliers",
+			responses: map[utils.Suppliers]json.RawMessage{
+				utils.Acme:       json.RawMessage(`["a1"]`),
+				utils.Patagonia:  json.RawMessage(`["p1"]`),
+				utils.Paperflies: json.RawMessage(`["f1"]`),
+			},
+			previous:      []utils.Suppliers{utils.Acme, utils.Patagonia, utils.Paperflies},
+			supplierNames: []utils.Suppliers{utils.Acme},
+			wantState:     RunSucceeded,
+			wantSuppliers: map[utils.Suppliers]SupplierState{
+				utils.Acme:       SupplierOK,
+				utils.Patagonia:  SupplierSkipped,
+				utils.Paperflies: SupplierSkipped,
+			},
+			wantHotelCount: 3,
+			wantFetches:    1,
+		},
+		{
+			name: "Success - Refresh a subset of suppliers before a full load",
+			responses: map[utils.Suppliers]json.RawMessage{
+				utils.Acme:       json.RawMessage(`["a1"]`),
+				utils.Patagonia:  json.RawMessage(`["p1"]`),
+				utils.Paperflies: json.RawMessage(`["f1"]`),
+			},
+			supplierNames: []utils.Suppliers{utils.Acme},
+			wantState:     RunSucceeded,
+			wantSuppliers: map[utils.Suppliers]SupplierState{
+				utils.Acme:       SupplierOK,
+				utils.Patagonia:  SupplierSkipped,
+				utils.Paperflies: SupplierSkipped,
+			},
+			wantHotelCount: 1,
+			wantFetches:    1,
+		},
+		{
+			name: "Error - All suppliers fail",
+			errors: map[utils.Suppliers]error{
+				utils.Acme:       errors.New("timeout"),
+				utils.Patagonia:  errors.New("timeout"),
+				utils.Paperflies: errors.New("timeout"),
+			},
+			wantState: RunFailed,
+			wantSuppliers: map[utils.Suppliers]SupplierState{
+				utils.Acme:       SupplierFailed,
+				utils.Patagonia:  SupplierFailed,
+				utils.Paperflies: SupplierFailed,
+			},
+			wantFetches: 3,
+		},
+		{
+			name:          "Error - Unknown supplier",
+			supplierNames: []utils.Suppliers{"unknown"},
+			wantErr:       ErrUnknownSupplier,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer hotels.ClearMaps()
+			fetcher := &mockFetcher{responses: tt.responses, errors: map[utils.Suppliers]error{}}
+			p := newTestPipeline(fetcher)
+			if len(tt.previous) > 0 {
+				if _, err := p.TriggerRefresh(context.Background(), TriggerStartup, tt.previous, true); err != nil {
+					t.Fatalf("TriggerRefresh() setup error = %v", err)
+				}
+			}
+			fetcher.errors = tt.errors
+			fetcher.runs = nil
+
+			got, err := p.TriggerRefresh(context.Background(), TriggerAdmin, tt.supplierNames, true)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("TriggerRefresh() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if got.State != tt.wantState {
+				t.Errorf("TriggerRefresh() state = %v, want %v", got.State, tt.wantState)
+			}
+			if states := supplierStates(got); !reflect.DeepEqual(states, tt.wantSuppliers) {
+				t.Errorf("TriggerRefresh() suppliers = %v, want %v", states, tt.wantSuppliers)
+			}
+			if len(fetcher.runs) != tt.wantFetches {
+				t.Errorf("TriggerRefresh() fetches = %v, want %v", len(fetcher.runs), tt.wantFetches)
+			}
+			if got.HotelCount != tt.wantHotelCount {
+				t.Errorf("TriggerRefresh() hotelCount = %v, want %v", got.HotelCount, tt.wantHotelCount)
+			}
+			if tt.wantState != RunFailed && len(hotels.GetHotelIDsMap()) != tt.wantHotelCount {
+				t.Errorf("saved hotels = %v, want %v", len(hotels.GetHotelIDsMap()), tt.wantHotelCount)
+			}
+			wantSnapshot := tt.wantState != RunFailed || len(tt.previous) > 0
+			if _, ok := p.GetSnapshot(); ok != wantSnapshot {
+				t.Errorf("GetSnapshot() ok = %v, want %v", ok, wantSnapshot)
+			}
+			// A failed run keeps the report of the previous run
+			wantReportRun := got.ID
+			if tt.wantState == RunFailed {
+				wantReportRun = got.ID - 1
+			}
+			if qualityReport, ok := p.GetQualityReport(); ok != wantSnapshot || (ok && qualityReport.Run != wantReportRun) {
+				t.Errorf("GetQualityReport() = run %v, %v, want run %v, %v", qualityReport.Run, ok, wantReportRun, wantSnapshot)
+			}
+		})
+	}
+}
+
+func Test_intPipeline_TriggerRefresh_InProgress(t *testing.T) {
+	p := newTestPipeline(&mockFetcher{})
+	p.current = &Run{ID: 1, State: RunRunning}
+
+	if _, err := p.TriggerRefresh(context.Background(), TriggerAdmin, nil, true); !errors.Is(err, ErrRefreshInProgress) {
+		t.Errorf("TriggerRefresh() error = %v, want %v", err, ErrRefreshInProgress)
+	}
+}
+
+func Test_intPipeline_GetStatus(t *testing.T) {
+	defer hotels.ClearMaps()
+	supplierFetcher := &mockFetcher{
+		responses: map[utils.Suppliers]json.RawMessage{
+			utils.Acme:       json.RawMessage(`["a1"]`),
+			utils.Paperflies: json.RawMessage(`[]`),
+		},
+		errors: map[utils.Suppliers]error{utils.Patagonia: errors.New("timeout")},
+	}
+	p := newTestPipeline(supplierFetcher)
+
+	current, last := p.GetStatus()
+	if current != nil || last != nil {
+		t.Fatalf("GetStatus() before any run = %v, %v, want nil, nil", current, last)
+	}
+	if snapshot, ok := p.GetSnapshot(); ok {
+		t.Fatalf("GetSnapshot() before any run = %v, want none", snapshot)
+	}
+
+	run, err := p.TriggerRefresh(context.Background(), TriggerStartup, nil, true)
+	if err != nil {
+		t.Fatalf("TriggerRefresh() error = %v", err)
+	}
+	current, last = p.GetStatus()
+	if current != nil {
+		t.Errorf("GetStatus() current = %v, want nil", current)
+	}
+	if last == nil || !reflect.DeepEqual(*last, run) {
+		t.Errorf("GetStatus() last = %v, want %v", last, run)
+	}
+	wantSnapshot := Snapshot{Version: run.ID, LoadedAt: p.now(), HotelCount: 1}
+	if snapshot, ok := p.GetSnapshot(); !ok || snapshot != wantSnapshot {
+		t.Errorf("GetSnapshot() = %v, %v, want %v", snapshot, ok, wantSnapshot)
+	}
+	if export := hotels.Initialize(slog.Default()).ExportHotels(); export.Version != run.ID {
+		t.Errorf("ExportHotels() version = %d, want %d", export.Version, run.ID)
+	}
+	// Every fetch carries the run, so that the recorded payloads are grouped by run
+	wantRun := fetcher.Run{ID: run.ID, StartedAt: run.StartedAt}
+	if len(supplierFetcher.runs) != 3 || supplierFetcher.runs[0] != wantRun || supplierFetcher.runs[2] != wantRun {
+		t.Errorf("fetches runs = %v, want 3 fetches of %v", supplierFetcher.runs, wantRun)
+	}
+
+	wantSuppliers := []SupplierStatus{
+		{Supplier: utils.Paperflies, State: SupplierOK, LastSuccessAt: p.now()},
+		{Supplier: utils.Patagonia, State: SupplierFailed, Error: "failed to fetch suppliers data: timeout", Failure: "fetch failed"},
+		{Supplier: utils.Acme, State: SupplierOK, RecordCount: 1, LastSuccessAt: p.now()},
+	}
+	if got := p.ListSuppliers(); !reflect.DeepEqual(got, wantSuppliers) {
+		t.Errorf("ListSuppliers() = %v, want %v", got, wantSuppliers)
+	}
+}
@@ -0,0 +1,32 @@
+package pipeline
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+func (p *intPipeline) RunScheduler(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+	p.logger.Info("[Pipeline] Scheduled refreshes enabled", "interval", interval)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			_, err := p.TriggerRefresh(ctx, TriggerSchedule, nil, true)
+			switch {
+			case errors.Is(err, ErrShuttingDown):
+				return
+			case errors.Is(err, ErrRefreshInProgress):
+				p.logger.Info("[Pipeline] Skipping scheduled refresh - refresh in progress")
+			case err != nil:
+				p.logger.Error("[Pipeline] Scheduled refresh failed", "error", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package pipeline
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"hotelsDataMerge/internal/hotels"
+	"hotelsDataMerge/internal/suppliers/utils"
+)
+
+func Test_intPipeline_RunScheduler(t *testing.T) {
+	defer hotels.ClearMaps()
+	p := newTestPipeline(&mockFetcher{
+		responses: map[utils.Suppliers]json.RawMessage{
+			utils.Acme:       json.RawMessage(`["a1"]`),
+			utils.Patagonia:  json.RawMessage(`[]`),
+			utils.Paperflies: json.RawMessage(`[]`),
+		},
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		p.RunScheduler(ctx, 5*time.Millisecond)
+		close(done)
+	}()
+
+	deadline := time.After(5 * time.Second)
+	for {
+		if _, last := p.GetStatus(); last != nil && last.ID >= 2 {
+			if last.Trigger != TriggerSchedule || last.State != RunSucceeded {
+				t.Errorf("RunScheduler() last run = %v %v, want %v %v", last.Trigger, last.State, TriggerSchedule, RunSucceeded)
+			}
+			break
+		}
+		select {
+		case <-deadline:
+			t.Fatalf("RunScheduler() did not refresh twice")
+		case <-time.After(time.Millisecond):
+		}
+	}
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("RunScheduler() did not return after the context was cancelled")
+	}
+}
+
+func Test_intPipeline_RunScheduler_Disabled(t *testing.T) {
+	p := newTestPipeline(&mockFetcher{})
+	p.RunScheduler(context.Background(), 0)
+	if _, last := p.GetStatus(); last != nil {
+		t.Errorf("RunScheduler() with no interval ran %v", last)
+	}
+}
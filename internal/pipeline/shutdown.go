@@ -0,0 +1,22 @@
+package pipeline
+
+import "context"
+
+func (p *intPipeline) Shutdown(ctx context.Context) error {
+	// Cancelling under the lock guarantees no run is added once the wait starts
+	p.mu.Lock()
+	p.cancel()
+	p.mu.Unlock()
+
+	stopped := make(chan struct{})
+	go func() {
+		p.runs.Wait()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
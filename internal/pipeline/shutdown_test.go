@@ -0,0 +1,73 @@
+package pipeline
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"hotelsDataMerge/internal/hotels"
+	"hotelsDataMerge/internal/suppliers/utils"
+)
+
+// blockingFetcher blocks every fetch until its context is cancelled
+type blockingFetcher struct {
+	mockFetcher
+	started chan struct{}
+}
+
+func (b *blockingFetcher) GetSupplierData(ctx context.Context, supplierName utils.Suppliers) (json.RawMessage, error) {
+	select {
+	case b.started <- struct{}{}:
+	default:
+	}
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func Test_intPipeline_Shutdown(t *testing.T) {
+	defer hotels.ClearMaps()
+	fetcher := &blockingFetcher{started: make(chan struct{}, 1)}
+	p := newTestPipeline(&mockFetcher{})
+	p.suppliers.Fetcher = fetcher
+
+	hotels.SaveMaps(context.Background(), map[string]hotels.Hotel{"a1": {Id: "a1"}})
+	if _, err := p.TriggerRefresh(context.Background(), TriggerAdmin, nil, false); err != nil {
+		t.Fatalf("TriggerRefresh() error = %v", err)
+	}
+	<-fetcher.started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := p.Shutdown(ctx); err != nil {
+		t.Fatalf("Shutdown() error = %v", err)
+	}
+
+	current, last := p.GetStatus()
+	if current != nil {
+		t.Errorf("GetStatus() current = %v, want nil", current)
+	}
+	if last == nil || last.State != RunFailed || last.Error != "refresh cancelled" {
+		t.Errorf("GetStatus() last = %+v, want a cancelled run", last)
+	}
+	if !hotels.GetHotelIDsMap()["a1"] {
+		t.Errorf("Shutdown() replaced the served hotels")
+	}
+	if _, err := p.TriggerRefresh(context.Background(), TriggerAdmin, nil, true); !errors.Is(err, ErrShuttingDown) {
+		t.Errorf("TriggerRefresh() after Shutdown() error = %v, want %v", err, ErrShuttingDown)
+	}
+}
+
+func Test_intPipeline_Shutdown_Timeout(t *testing.T) {
+	p := newTestPipeline(&mockFetcher{})
+	// A run that never returns
+	p.runs.Add(1)
+	defer p.runs.Done()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := p.Shutdown(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Shutdown() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+}
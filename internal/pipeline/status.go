@@ -0,0 +1,46 @@
+package pipeline
+
+func (p *intPipeline) GetStatus() (current *Run, last *Run) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.current != nil {
+		run := *p.current
+		current = &run
+	}
+	if p.last != nil {
+		run := *p.last
+		last = &run
+	}
+	return current, last
+}
+
+// ListSuppliers returns the status of every supplier after its last refresh, in priority order
+func (p *intPipeline) ListSuppliers() []SupplierStatus {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	statuses := make([]SupplierStatus, 0, len(p.supplierStatuses))
+	for _, supplierName := range allSuppliers() {
+		supplierStatus := p.supplierStatuses[supplierName]
+		supplierStatus.Supplier = supplierName
+		statuses = append(statuses, supplierStatus)
+	}
+	return statuses
+}
+
+func (p *intPipeline) GetSnapshot() (Snapshot, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.snapshot == nil {
+		return Snapshot{}, false
+	}
+	return *p.snapshot, true
+}
+
+func (p *intPipeline) GetQualityReport() (QualityReport, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.qualityReport == nil {
+		return QualityReport{}, false
+	}
+	return *p.qualityReport, true
+}
@@ -0,0 +1,67 @@
+package pipeline
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"hotelsDataMerge/internal/hotels"
+	"hotelsDataMerge/internal/suppliers/utils"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func Test_intPipeline_TriggerRefresh_Spans(t *testing.T) {
+	defer hotels.ClearMaps()
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+
+	p := newTestPipeline(&mockFetcher{
+		responses: map[utils.Suppliers]json.RawMessage{
+			utils.Acme:       json.RawMessage(`["a1"]`),
+			utils.Paperflies: json.RawMessage(`["f1"]`),
+		},
+		errors: map[utils.Suppliers]error{utils.Patagonia: errors.New("timeout")},
+	})
+	ctx, parent := otel.Tracer("test").Start(context.Background(), "admin.TriggerRefresh")
+	if _, err := p.TriggerRefresh(ctx, TriggerAdmin, nil, true); err != nil {
+		t.Fatalf("TriggerRefresh() error = %v", err)
+	}
+	parent.End()
+
+	spans := make(map[string][]sdktrace.ReadOnlySpan)
+	for _, span := range recorder.Ended() {
+		spans[span.Name()] = append(spans[span.Name()], span)
+	}
+	if len(spans["pipeline.Refresh"]) != 1 {
+		t.Fatalf("pipeline.Refresh spans = %d, want 1", len(spans["pipeline.Refresh"]))
+	}
+	refresh := spans["pipeline.Refresh"][0]
+	if refresh.Parent().SpanID() != parent.SpanContext().SpanID() {
+		t.Errorf("pipeline.Refresh parent = %v, want %v", refresh.Parent().SpanID(), parent.SpanContext().SpanID())
+	}
+
+	supplierSpans := spans["pipeline.RefreshSupplier"]
+	if len(supplierSpans) != 3 {
+		t.Fatalf("pipeline.RefreshSupplier spans = %d, want 3", len(supplierSpans))
+	}
+	failed := 0
+	for _, span := range supplierSpans {
+		if span.Parent().SpanID() != refresh.SpanContext().SpanID() {
+			t.Errorf("pipeline.RefreshSupplier parent = %v, want %v", span.Parent().SpanID(), refresh.SpanContext().SpanID())
+		}
+		if span.Status().Code == codes.Error {
+			failed++
+		}
+	}
+	if failed != 1 {
+		t.Errorf("failed pipeline.RefreshSupplier spans = %d, want 1", failed)
+	}
+	if len(spans["hotels.SaveMaps"]) != 1 {
+		t.Errorf("hotels.SaveMaps spans = %d, want 1", len(spans["hotels.SaveMaps"]))
+	}
+}
@@ -0,0 +1,76 @@
+package ratelimit
+
+import (
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// sweepInterval is how often buckets that have refilled are dropped, so that clients
+// seen once do not hold memory forever
+const sweepInterval = time.Minute
+
+func (l *intLimiter) Allow(fullMethod string, client string) (bool, time.Duration) {
+	limit := l.limit(fullMethod)
+	if limit.RequestsPerSecond == 0 {
+		return true, 0
+	}
+
+	now := l.now()
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.sweep(now)
+
+	key := bucketKey{fullMethod: fullMethod, client: client}
+	bucket, ok := l.buckets[key]
+	if !ok {
+		bucket = rate.NewLimiter(rate.Limit(limit.RequestsPerSecond), limit.Burst)
+		l.buckets[key] = bucket
+	}
+	reservation := bucket.ReserveN(now, 1)
+	delay := reservation.DelayFrom(now)
+	if delay == 0 {
+		return true, 0
+	}
+	// Rejected calls do not consume tokens, so a client that backs off is not penalised further
+	reservation.CancelAt(now)
+	return false, delay
+}
+
+func (l *intLimiter) Exhausted(fullMethod string, client string) (bool, time.Duration) {
+	if l.limit(fullMethod).RequestsPerSecond == 0 {
+		return false, 0
+	}
+
+	now := l.now()
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	bucket, ok := l.buckets[bucketKey{fullMethod: fullMethod, client: client}]
+	if !ok {
+		return false, 0
+	}
+	reservation := bucket.ReserveN(now, 1)
+	delay := reservation.DelayFrom(now)
+	reservation.CancelAt(now)
+	return delay > 0, delay
+}
+
+func (l *intLimiter) limit(fullMethod string) Limit {
+	if limit, ok := l.config.Methods[fullMethod]; ok {
+		return limit
+	}
+	return l.config.Default
+}
+
+// sweep drops the buckets that are full again, which behave exactly like new ones
+func (l *intLimiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < sweepInterval {
+		return
+	}
+	l.lastSweep = now
+	for key, bucket := range l.buckets {
+		if bucket.TokensAt(now) >= float64(bucket.Burst()) {
+			delete(l.buckets, key)
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+)
+
+const (
+	getHotels      = "/proto.HotelDataMerge/GetHotels"
+	triggerRefresh = "/proto.HotelDataMergeAdmin/TriggerRefresh"
+)
+
+type call struct {
+	after          time.Duration
+	fullMethod     string
+	client         string
+	wantAllowed    bool
+	wantRetryAfter time.Duration
+}
+
+func Test_intLimiter_Allow(t *testing.T) {
+	config := Config{
+		Default: Limit{RequestsPerSecond: 1, Burst: 2},
+		Methods: map[string]Limit{
+			triggerRefresh:                   {RequestsPerSecond: 0.1, Burst: 1},
+			"/proto.HotelDataMerge/GetHotel": {},
+		},
+	}
+
+	tests := []struct {
+		name  string
+		calls []call
+	}{
+		{
+			name: "Success - Burst is allowed then refilled",
+			calls: []call{
+				{fullMethod: getHotels, client: "a", wantAllowed: true},
+				{fullMethod: getHotels, client: "a", wantAllowed: true},
+				{fullMethod: getHotels, client: "a", wantAllowed: false, wantRetryAfter: time.Second},
+				{after: 250 * time.Millisecond, fullMethod: getHotels, client: "a", wantAllowed: false, wantRetryAfter: 750 * time.Millisecond},
+				{after: 750 * time.Millisecond, fullMethod: getHotels, client: "a", wantAllowed: true},
+			},
+		},
+		{
+			name: "Success - Clients have their own buckets",
+			calls: []call{
+				{fullMethod: getHotels, client: "a", wantAllowed: true},
+				{fullMethod: getHotels, client: "a", wantAllowed: true},
+				{fullMethod: getHotels, client: "b", wantAllowed: true},
+			},
+		},
+		{
+			name: "Success - Methods have their own buckets and limits",
+			calls: []call{
+				{fullMethod: triggerRefresh, client: "a", wantAllowed: true},
+				{fullMethod: triggerRefresh, client: "a", wantAllowed: false, wantRetryAfter: 10 * time.Second},
+				{fullMethod: getHotels, client: "a", wantAllowed: true},
+			},
+		},
+		{
+			name: "Success - Zero rate disables the limit",
+			calls: []call{
+				{fullMethod: "/proto.HotelDataMerge/GetHotel", client: "a", wantAllowed: true},
+				{fullMethod: "/proto.HotelDataMerge/GetHotel", client: "a", wantAllowed: true},
+				{fullMethod: "/proto.HotelDataMerge/GetHotel", client: "a", wantAllowed: true},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			limiter, err := Initialize(config)
+			if err != nil {
+				t.Fatalf("Initialize() error = %v", err)
+			}
+			l := limiter.(*intLimiter)
+			now := time.Date(2026, 10, 19, 8, 0, 0, 0, time.UTC)
+			l.now = func() time.Time { return now }
+
+			for i, c := range tt.calls {
+				now = now.Add(c.after)
+				allowed, retryAfter := l.Allow(c.fullMethod, c.client)
+				if allowed != c.wantAllowed || retryAfter != c.wantRetryAfter {
+					t.Errorf("call %d: Allow() = %v, %v, want %v, %v", i, allowed, retryAfter, c.wantAllowed, c.wantRetryAfter)
+				}
+			}
+		})
+	}
+}
+
+func Test_intLimiter_Exhausted(t *testing.T) {
+	limiter, err := Initialize(Config{Default: Limit{RequestsPerSecond: 1, Burst: 1}})
+	if err != nil {
+		t.Fatalf("Initialize() error = %v", err)
+	}
+	l := limiter.(*intLimiter)
+	now := time.Date(2026, 10, 19, 8, 0, 0, 0, time.UTC)
+	l.now = func() time.Time { return now }
+
+	tests := []struct {
+		name           string
+		allow          bool
+		after          time.Duration
+		wantExhausted  bool
+		wantRetryAfter time.Duration
+	}{
+		{name: "Success - Unknown client", wantExhausted: false},
+		{name: "Success - Exhausted after its burst", allow: true, wantExhausted: true, wantRetryAfter: time.Second},
+		{name: "Success - Checking takes no token", wantExhausted: true, wantRetryAfter: time.Second},
+		{name: "Success - Refilled", after: time.Second, wantExhausted: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			now = now.Add(tt.after)
+			if tt.allow {
+				l.Allow(getHotels, "a")
+			}
+			exhausted, retryAfter := l.Exhausted(getHotels, "a")
+			if exhausted != tt.wantExhausted || retryAfter != tt.wantRetryAfter {
+				t.Errorf("Exhausted() = %v, %v, want %v, %v", exhausted, retryAfter, tt.wantExhausted, tt.wantRetryAfter)
+			}
+		})
+	}
+}
+
+func Test_intLimiter_sweep(t *testing.T) {
+	limiter, err := Initialize(Config{Default: Limit{RequestsPerSecond: 1, Burst: 1}})
+	if err != nil {
+		t.Fatalf("Initialize() error = %v", err)
+	}
+	l := limiter.(*intLimiter)
+	now := time.Date(2026, 10, 19, 8, 0, 0, 0, time.UTC)
+	l.now = func() time.Time { return now }
+
+	l.Allow(getHotels, "a")
+	now = now.Add(sweepInterval - 500*time.Millisecond)
+	l.Allow(getHotels, "b")
+	now = now.Add(500 * time.Millisecond)
+	l.Allow(getHotels, "c")
+
+	if _, ok := l.buckets[bucketKey{fullMethod: getHotels, client: "a"}]; ok {
+		t.Errorf("sweep() kept the refilled bucket of client a")
+	}
+	if len(l.buckets) != 2 {
+		t.Errorf("sweep() kept %d buckets, want 2", len(l.buckets))
+	}
+}
@@ -0,0 +1,102 @@
+package ratelimit
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// Config is the rate limit config file
+type Config struct {
+	// Default applies to every method that has no entry in Methods
+	Default Limit `json:"default"`
+	// Methods overrides the limit per full method name, e.g. "/proto.HotelDataMerge/GetHotels"
+	Methods map[string]Limit `json:"methods"`
+	// MaxHotelIDs is the maximum number of hotelIDs in a single GetHotels request; 0 means no maximum
+	MaxHotelIDs int `json:"max_hotel_ids"`
+}
+
+// Limit is a token bucket refilled at RequestsPerSecond and holding up to Burst requests.
+// A RequestsPerSecond of 0 disables the limit.
+type Limit struct {
+	RequestsPerSecond float64 `json:"requests_per_second"`
+	Burst             int     `json:"burst"`
+}
+
+// DefaultConfig allows each client 20 requests per second on every method, with bursts of 40,
+// and up to 100 hotelIDs per GetHotels request
+func DefaultConfig() Config {
+	return Config{
+		Default:     Limit{RequestsPerSecond: 20, Burst: 40},
+		MaxHotelIDs: 100,
+	}
+}
+
+type IntLimiter interface {
+	// Allow takes a token from the client's bucket for the method. When the bucket is empty,
+	// it returns false and how long the client should wait before retrying.
+	Allow(fullMethod string, client string) (bool, time.Duration)
+	// Exhausted reports whether the client's bucket for the method is empty, without taking a token,
+	// and how long the client should wait before retrying
+	Exhausted(fullMethod string, client string) (bool, time.Duration)
+}
+
+type intLimiter struct {
+	config Config
+	now    func() time.Time
+
+	mu        sync.Mutex
+	buckets   map[bucketKey]*rate.Limiter
+	lastSweep time.Time
+}
+
+type bucketKey struct {
+	fullMethod string
+	client     string
+}
+
+// LoadConfig reads the rate limit config file; fields it does not set keep their DefaultConfig value
+func LoadConfig(path string) (Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Config{}, err
+	}
+	config := DefaultConfig()
+	if err := json.Unmarshal(data, &config); err != nil {
+		return Config{}, fmt.Errorf("invalid rate limit config %s: %w", path, err)
+	}
+	return config, nil
+}
+
+func Initialize(config Config) (IntLimiter, error) {
+	if err := validateLimit(config.Default); err != nil {
+		return nil, fmt.Errorf("default: %w", err)
+	}
+	for fullMethod, limit := range config.Methods {
+		if err := validateLimit(limit); err != nil {
+			return nil, fmt.Errorf("method %s: %w", fullMethod, err)
+		}
+	}
+	if config.MaxHotelIDs < 0 {
+		return nil, fmt.Errorf("max_hotel_ids must not be negative")
+	}
+	return &intLimiter{
+		config:  config,
+		now:     time.Now,
+		buckets: make(map[bucketKey]*rate.Limiter),
+	}, nil
+}
+
+func validateLimit(limit Limit) error {
+	if limit.RequestsPerSecond < 0 {
+		return fmt.Errorf("requests_per_second must not be negative")
+	}
+	if limit.RequestsPerSecond > 0 && limit.Burst < 1 {
+		return fmt.Errorf("burst must be at least 1")
+	}
+	return nil
+}
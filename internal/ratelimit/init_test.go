@@ -0,0 +1,90 @@
+package ratelimit
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestLoadConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    Config
+		wantErr bool
+	}{
+		{
+			name: "Success - Unset fields keep their default",
+			data: `{"methods": {"/proto.HotelDataMerge/GetHotels": {"requests_per_second": 5, "burst": 10}}}`,
+			want: Config{
+				Default:     Limit{RequestsPerSecond: 20, Burst: 40},
+				Methods:     map[string]Limit{"/proto.HotelDataMerge/GetHotels": {RequestsPerSecond: 5, Burst: 10}},
+				MaxHotelIDs: 100,
+			},
+			wantErr: false,
+		},
+		{
+			name:    "Error - Invalid JSON",
+			data:    `{"default": `,
+			want:    Config{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "rate_limit.json")
+			if err := os.WriteFile(path, []byte(tt.data), 0o600); err != nil {
+				t.Fatalf("WriteFile() error = %v", err)
+			}
+			got, err := LoadConfig(path)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("LoadConfig() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("LoadConfig() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInitialize(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  Config
+		wantErr bool
+	}{
+		{
+			name:    "Success - Default config",
+			config:  DefaultConfig(),
+			wantErr: false,
+		},
+		{
+			name:    "Success - Disabled limit without burst",
+			config:  Config{Methods: map[string]Limit{getHotels: {}}},
+			wantErr: false,
+		},
+		{
+			name:    "Error - Negative rate",
+			config:  Config{Default: Limit{RequestsPerSecond: -1, Burst: 1}},
+			wantErr: true,
+		},
+		{
+			name:    "Error - Rate without burst",
+			config:  Config{Methods: map[string]Limit{getHotels: {RequestsPerSecond: 1}}},
+			wantErr: true,
+		},
+		{
+			name:    "Error - Negative maximum of hotel IDs",
+			config:  Config{MaxHotelIDs: -1},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Initialize(tt.config); (err != nil) != tt.wantErr {
+				t.Errorf("Initialize() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package archive
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"os"
+	"sync"
+	"time"
+
+	"hotelsDataMerge/external"
+	"hotelsDataMerge/internal/suppliers/utils"
+)
+
+// Latest replays the most recent recording
+const Latest = "latest"
+
+var ErrRecordingNotFound = errors.New("recording not found")
+
+// Recording is the raw input of one refresh run: the payload of each supplier it fetched.
+// It is stored as <dir>/<ID>/manifest.json next to one gzip file per supplier.
+type Recording struct {
+	ID        string    `json:"id"`
+	Run       int64     `json:"run"`
+	StartedAt time.Time `json:"started_at"`
+	Payloads  []Payload `json:"payloads"`
+}
+
+type Payload struct {
+	Supplier   utils.Suppliers `json:"supplier"`
+	RecordedAt time.Time       `json:"recorded_at"`
+	// SHA256 is the hex-encoded hash of the uncompressed payload, checked when it is loaded
+	SHA256 string `json:"sha256"`
+	// Size is the uncompressed size of the payload in bytes
+	Size int    `json:"size"`
+	File string `json:"file"`
+}
+
+type IntArchive interface {
+	// Record stores the payload of a supplier in the recording of the run of ctx (see fetcher.WithRun),
+	// creating it on the run's first payload and then removing the recordings past the retention limits
+	Record(ctx context.Context, supplierName utils.Suppliers, rawResp json.RawMessage) error
+	// List returns the recordings, oldest first
+	List() (recordings []Recording, err error)
+	// Load returns a recording, or the latest one, with its payloads after checking their hashes
+	Load(id string) (recording Recording, payloads map[utils.Suppliers]json.RawMessage, err error)
+	// Replay returns suppliers serving the payloads of a recording, or of the latest one, in place of the live suppliers
+	Replay(id string) (extSuppliers external.ExtSuppliers, recording Recording, err error)
+}
+
+// Options are the retention limits, enforced whenever a recording is created
+type Options struct {
+	// MaxAge is how long recordings are kept; 0 means no maximum
+	MaxAge time.Duration
+	// MaxRecordings is how many recordings are kept; 0 means no maximum
+	MaxRecordings int
+}
+
+type intArchive struct {
+	logger  *slog.Logger
+	dir     string
+	options Options
+	now     func() time.Time
+
+	// mu serializes the updates of the manifests
+	mu sync.Mutex
+}
+
+// Initialize returns the archive stored in dir, creating the directory if needed
+func Initialize(logger *slog.Logger, dir string, options Options) (IntArchive, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &intArchive{
+		logger:  logger,
+		dir:     dir,
+		options: options,
+		now:     time.Now,
+	}, nil
+}
@@ -0,0 +1,197 @@
+package archive
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"testing"
+	"time"
+
+	"hotelsDataMerge/internal/suppliers/fetcher"
+	"hotelsDataMerge/internal/suppliers/utils"
+)
+
+var testNow = time.Date(2026, 10, 19, 8, 0, 0, 0, time.UTC)
+
+func newTestArchive(t *testing.T, options Options) *intArchive {
+	t.Helper()
+	archive, err := Initialize(slog.New(slog.NewTextHandler(io.Discard, nil)), t.TempDir(), options)
+	if err != nil {
+		t.Fatalf("Initialize() error = %v", err)
+	}
+	a := archive.(*intArchive)
+	a.now = func() time.Time { return testNow }
+	return a
+}
+
+// record records the payloads of a run started at startedAt
+func record(t *testing.T, a *intArchive, runID int64, startedAt time.Time, payloads map[utils.Suppliers]string) {
+	t.Helper()
+	ctx := fetcher.WithRun(context.Background(), fetcher.Run{ID: runID, StartedAt: startedAt})
+	for _, supplierName := range utils.SupplierPriority {
+		if payload, ok := payloads[supplierName]; ok {
+			if err := a.Record(ctx, supplierName, json.RawMessage(payload)); err != nil {
+				t.Fatalf("Record() error = %v", err)
+			}
+		}
+	}
+}
+
+func recordingIDs(t *testing.T, a *intArchive) []string {
+	t.Helper()
+	recordings, err := a.List()
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	ids := make([]string, 0, len(recordings))
+	for _, recording := range recordings {
+		ids = append(ids, recording.ID)
+	}
+	return ids
+}
+
+func Test_intArchive_Record(t *testing.T) {
+	a := newTestArchive(t, Options{})
+	startedAt := testNow.Add(-time.Minute)
+	record(t, a, 3, startedAt, map[utils.Suppliers]string{utils.Acme: `[{"Id":"old"}]`, utils.Patagonia: `[{"id":"f8c9"}]`})
+	// A supplier fetched again in the same run keeps its last payload
+	record(t, a, 3, startedAt, map[utils.Suppliers]string{utils.Acme: `[{"Id":"iJhz"}]`})
+
+	recording, payloads, err := a.Load("20261019T075900.000Z-run3")
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if recording.Run != 3 || !recording.StartedAt.Equal(startedAt) || len(recording.Payloads) != 2 {
+		t.Errorf("Load() recording = %+v, want run 3 with 2 payloads", recording)
+	}
+	wantPayloads := map[utils.Suppliers]json.RawMessage{
+		utils.Acme:      json.RawMessage(`[{"Id":"iJhz"}]`),
+		utils.Patagonia: json.RawMessage(`[{"id":"f8c9"}]`),
+	}
+	if !reflect.DeepEqual(payloads, wantPayloads) {
+		t.Errorf("Load() payloads = %s, want %s", payloads, wantPayloads)
+	}
+	sum := sha256.Sum256([]byte(`[{"id":"f8c9"}]`))
+	wantPayload := Payload{Supplier: utils.Patagonia, RecordedAt: testNow, SHA256: hex.EncodeToString(sum[:]), Size: 15, File: "patagonia.json.gz"}
+	if !slices.Contains(recording.Payloads, wantPayload) {
+		t.Errorf("Load() payloads = %+v, want %+v", recording.Payloads, wantPayload)
+	}
+}
+
+func Test_intArchive_Load(t *testing.T) {
+	a := newTestArchive(t, Options{})
+	record(t, a, 1, testNow.Add(-2*time.Hour), map[utils.Suppliers]string{utils.Acme: `["first"]`})
+	record(t, a, 2, testNow.Add(-time.Hour), map[utils.Suppliers]string{utils.Acme: `["second"]`})
+	record(t, a, 1, testNow, map[utils.Suppliers]string{utils.Acme: `["after restart"]`, utils.Paperflies: `[]`})
+	corrupted := "20261019T060000.000Z-run1"
+	if err := os.WriteFile(filepath.Join(a.dir, corrupted, "acme.json.gz"), []byte("not gzip"), 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		id      string
+		wantID  string
+		wantErr error
+	}{
+		{name: "Success - By ID", id: "20261019T070000.000Z-run2", wantID: "20261019T070000.000Z-run2"},
+		{name: "Success - Latest, after a restart reset run IDs", id: Latest, wantID: "20261019T080000.000Z-run1"},
+		{name: "Error - Unknown ID", id: "20261019T090000.000Z-run9", wantErr: ErrRecordingNotFound},
+		{name: "Error - Path outside the archive", id: "../recording", wantErr: ErrRecordingNotFound},
+		{name: "Error - Corrupted payload", id: corrupted},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recording, _, err := a.Load(tt.id)
+			if len(tt.wantID) == 0 {
+				if err == nil || (tt.wantErr != nil && !errors.Is(err, tt.wantErr)) {
+					t.Errorf("Load() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Load() error = %v", err)
+			}
+			if recording.ID != tt.wantID {
+				t.Errorf("Load() ID = %s, want %s", recording.ID, tt.wantID)
+			}
+		})
+	}
+}
+
+func Test_intArchive_prune(t *testing.T) {
+	tests := []struct {
+		name    string
+		options Options
+		want    []string
+	}{
+		{
+			name:    "Success - No limits",
+			options: Options{},
+			want:    []string{"20261010T080000.000Z-run1", "20261019T060000.000Z-run2", "20261019T070000.000Z-run3", "20261019T080000.000Z-run4"},
+		},
+		{
+			name:    "Success - Expired recordings removed",
+			options: Options{MaxAge: 24 * time.Hour},
+			want:    []string{"20261019T060000.000Z-run2", "20261019T070000.000Z-run3", "20261019T080000.000Z-run4"},
+		},
+		{
+			name:    "Success - Oldest recordings beyond the maximum removed",
+			options: Options{MaxRecordings: 2},
+			want:    []string{"20261019T070000.000Z-run3", "20261019T080000.000Z-run4"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := newTestArchive(t, tt.options)
+			for i, startedAt := range []time.Time{testNow.Add(-9 * 24 * time.Hour), testNow.Add(-2 * time.Hour), testNow.Add(-time.Hour), testNow} {
+				record(t, a, int64(i+1), startedAt, map[utils.Suppliers]string{utils.Acme: `[]`})
+			}
+			if got := recordingIDs(t, a); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("List() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_intArchive_Replay(t *testing.T) {
+	a := newTestArchive(t, Options{})
+	record(t, a, 1, testNow, map[utils.Suppliers]string{utils.Acme: `[{"Id":"iJhz"}]`})
+	extSuppliers, recording, err := a.Replay(Latest)
+	if err != nil {
+		t.Fatalf("Replay() error = %v", err)
+	}
+	if recording.ID != "20261019T080000.000Z-run1" {
+		t.Errorf("Replay() recording = %s, want 20261019T080000.000Z-run1", recording.ID)
+	}
+
+	tests := []struct {
+		name         string
+		supplierName utils.Suppliers
+		want         json.RawMessage
+		wantErr      bool
+	}{
+		{name: "Success - Recorded supplier", supplierName: utils.Acme, want: json.RawMessage(`[{"Id":"iJhz"}]`)},
+		{name: "Error - Supplier missing from the recording", supplierName: utils.Patagonia, wantErr: true},
+		{name: "Error - Unknown supplier", supplierName: utils.Suppliers("unknown"), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := extSuppliers.GetSuppliersRawInfo(context.Background(), tt.supplierName)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GetSuppliersRawInfo() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetSuppliersRawInfo() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
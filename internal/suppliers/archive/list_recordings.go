@@ -0,0 +1,110 @@
+package archive
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"hotelsDataMerge/internal/suppliers/utils"
+)
+
+func (a *intArchive) List() ([]Recording, error) {
+	entries, err := os.ReadDir(a.dir)
+	if err != nil {
+		return nil, err
+	}
+	recordings := make([]Recording, 0, len(entries))
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		recording, err := readManifest(filepath.Join(a.dir, entry.Name()))
+		if errors.Is(err, os.ErrNotExist) {
+			// Not a recording, or one whose first payload is still being written
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		recordings = append(recordings, recording)
+	}
+	sort.Slice(recordings, func(i, j int) bool {
+		return recordings[i].ID < recordings[j].ID
+	})
+	return recordings, nil
+}
+
+func (a *intArchive) Load(id string) (Recording, map[utils.Suppliers]json.RawMessage, error) {
+	if id == Latest {
+		recordings, err := a.List()
+		if err != nil {
+			return Recording{}, nil, err
+		}
+		if len(recordings) == 0 {
+			return Recording{}, nil, fmt.Errorf("%w: the archive is empty", ErrRecordingNotFound)
+		}
+		id = recordings[len(recordings)-1].ID
+	}
+	if filepath.Base(id) != id {
+		return Recording{}, nil, fmt.Errorf("%w: invalid ID %q", ErrRecordingNotFound, id)
+	}
+
+	recordingDir := filepath.Join(a.dir, id)
+	recording, err := readManifest(recordingDir)
+	if errors.Is(err, os.ErrNotExist) {
+		return Recording{}, nil, fmt.Errorf("%w: %s", ErrRecordingNotFound, id)
+	}
+	if err != nil {
+		return Recording{}, nil, err
+	}
+	payloads := make(map[utils.Suppliers]json.RawMessage, len(recording.Payloads))
+	for _, payload := range recording.Payloads {
+		rawResp, err := readPayload(recordingDir, payload)
+		if err != nil {
+			return Recording{}, nil, fmt.Errorf("recording %s: %w", id, err)
+		}
+		payloads[payload.Supplier] = rawResp
+	}
+	return recording, payloads, nil
+}
+
+func readManifest(recordingDir string) (Recording, error) {
+	data, err := os.ReadFile(filepath.Join(recordingDir, manifestFile))
+	if err != nil {
+		return Recording{}, err
+	}
+	var recording Recording
+	if err := json.Unmarshal(data, &recording); err != nil {
+		return Recording{}, fmt.Errorf("invalid manifest in %s: %w", recordingDir, err)
+	}
+	return recording, nil
+}
+
+// readPayload decompresses a payload and checks it against its hash
+func readPayload(recordingDir string, payload Payload) (json.RawMessage, error) {
+	compressed, err := os.ReadFile(filepath.Join(recordingDir, payload.File))
+	if err != nil {
+		return nil, err
+	}
+	reader, err := gzip.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", payload.File, err)
+	}
+	rawResp, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", payload.File, err)
+	}
+	sum := sha256.Sum256(rawResp)
+	if hex.EncodeToString(sum[:]) != payload.SHA256 {
+		return nil, fmt.Errorf("%s does not match its sha256 %s", payload.File, payload.SHA256)
+	}
+	return rawResp, nil
+}
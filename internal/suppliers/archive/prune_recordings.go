@@ -0,0 +1,34 @@
+package archive
+
+import (
+	"os"
+	"path/filepath"
+)
+
+// prune removes the recordings older than MaxAge, then the oldest ones beyond MaxRecordings.
+// The recording being written, current, is always kept. Failures are only logged.
+func (a *intArchive) prune(current string) {
+	recordings, err := a.List()
+	if err != nil {
+		a.logger.Warn("[Archive] Failed to list recordings to prune", "error", err)
+		return
+	}
+	kept := len(recordings)
+	cutoff := a.now().Add(-a.options.MaxAge)
+	for _, recording := range recordings {
+		if recording.ID == current {
+			continue
+		}
+		expired := a.options.MaxAge > 0 && recording.StartedAt.Before(cutoff)
+		tooMany := a.options.MaxRecordings > 0 && kept > a.options.MaxRecordings
+		if !expired && !tooMany {
+			continue
+		}
+		if err := os.RemoveAll(filepath.Join(a.dir, recording.ID)); err != nil {
+			a.logger.Warn("[Archive] Failed to remove recording", "recording", recording.ID, "error", err)
+			continue
+		}
+		kept--
+		a.logger.Info("[Archive] Recording removed", "recording", recording.ID, "expired", expired)
+	}
+}
@@ -0,0 +1,110 @@
+package archive
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"hotelsDataMerge/internal/suppliers/fetcher"
+	"hotelsDataMerge/internal/suppliers/utils"
+)
+
+const manifestFile = "manifest.json"
+
+func (a *intArchive) Record(ctx context.Context, supplierName utils.Suppliers, rawResp json.RawMessage) error {
+	now := a.now()
+	run, ok := fetcher.RunFromContext(ctx)
+	if !ok {
+		// A fetch outside of a run is recorded on its own
+		run = fetcher.Run{StartedAt: now}
+	}
+	id := recordingID(run)
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	recordingDir := filepath.Join(a.dir, id)
+	recording, err := readManifest(recordingDir)
+	created := errors.Is(err, os.ErrNotExist)
+	switch {
+	case created:
+		if err := os.MkdirAll(recordingDir, 0o755); err != nil {
+			return err
+		}
+		recording = Recording{ID: id, Run: run.ID, StartedAt: run.StartedAt.UTC()}
+	case err != nil:
+		return err
+	}
+
+	sum := sha256.Sum256(rawResp)
+	payload := Payload{
+		Supplier:   supplierName,
+		RecordedAt: now.UTC(),
+		SHA256:     hex.EncodeToString(sum[:]),
+		Size:       len(rawResp),
+		File:       string(supplierName) + ".json.gz",
+	}
+	var compressed bytes.Buffer
+	writer := gzip.NewWriter(&compressed)
+	if _, err := writer.Write(rawResp); err != nil {
+		return err
+	}
+	if err := writer.Close(); err != nil {
+		return err
+	}
+	if err := writeFileAtomic(filepath.Join(recordingDir, payload.File), compressed.Bytes()); err != nil {
+		return err
+	}
+
+	// A supplier fetched twice in a run keeps its last payload
+	payloads := recording.Payloads[:0]
+	for _, recorded := range recording.Payloads {
+		if recorded.Supplier != supplierName {
+			payloads = append(payloads, recorded)
+		}
+	}
+	recording.Payloads = append(payloads, payload)
+	manifest, err := json.MarshalIndent(recording, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := writeFileAtomic(filepath.Join(recordingDir, manifestFile), manifest); err != nil {
+		return err
+	}
+	a.logger.DebugContext(ctx, "[Archive] Payload recorded", "recording", id, "supplier", supplierName, "sha256", payload.SHA256, "size", payload.Size)
+
+	if created {
+		a.prune(id)
+	}
+	return nil
+}
+
+// recordingID names the recording of a run after its start time, so that IDs sort chronologically
+// and stay unique across restarts, which reset run IDs
+func recordingID(run fetcher.Run) string {
+	return fmt.Sprintf("%s-run%d", run.StartedAt.UTC().Format("20060102T150405.000Z"), run.ID)
+}
+
+// writeFileAtomic writes data to a temporary file renamed to path, so that readers never see a partial file
+func writeFileAtomic(path string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,35 @@
+package archive
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"hotelsDataMerge/external"
+	"hotelsDataMerge/internal/suppliers/utils"
+)
+
+// replaySuppliers serves the payloads of a recording, so that a refresh gets the exact input of the recorded run
+type replaySuppliers struct {
+	recordingID string
+	payloads    map[utils.Suppliers]json.RawMessage
+}
+
+func (a *intArchive) Replay(id string) (external.ExtSuppliers, Recording, error) {
+	recording, payloads, err := a.Load(id)
+	if err != nil {
+		return nil, Recording{}, err
+	}
+	a.logger.Info("[Archive] Replaying recording", "recording", recording.ID, "run", recording.Run, "suppliers", len(payloads))
+	return &replaySuppliers{recordingID: recording.ID, payloads: payloads}, recording, nil
+}
+
+// GetSuppliersRawInfo returns the recorded payload of the supplier.
+// A supplier missing from the recording fails like an unreachable supplier would.
+func (r *replaySuppliers) GetSuppliersRawInfo(ctx context.Context, supplierName utils.Suppliers) (json.RawMessage, error) {
+	rawResp, ok := r.payloads[supplierName]
+	if !ok {
+		return nil, fmt.Errorf("supplier %s is not in recording %s", supplierName, r.recordingID)
+	}
+	return rawResp, nil
+}
@@ -0,0 +1,129 @@
+package countries
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+//go:embed countries.json
+var countriesFile []byte
+
+//go:embed cities.json
+var citiesFile []byte
+
+var defaultCountries = mustLoad(countriesFile, citiesFile)
+
+type Country struct {
+	Code    string   `json:"code"`
+	Alpha3  string   `json:"alpha3"`
+	Name    string   `json:"name"`
+	Aliases []string `json:"aliases"`
+}
+
+type countriesDocument struct {
+	Version   string    `json:"version"`
+	Countries []Country `json:"countries"`
+}
+
+type citiesDocument struct {
+	Version string                         `json:"version"`
+	Cities  map[string]map[string][]string `json:"cities"`
+}
+
+type IntCountries interface {
+	NormalizeCountry(raw string) (code string, ok bool)
+	CountryName(code string) string
+	NormalizeCity(countryCode, raw string) string
+}
+
+type intCountries struct {
+	countryByCode map[string]Country
+	codeByKey     map[string]string
+	cityByKey     map[string]map[string]string
+}
+
+// Default returns the ISO 3166-1 dataset and city alias table embedded in the binary.
+func Default() IntCountries {
+	return defaultCountries
+}
+
+// Load parses a countries document and a city alias document.
+func Load(countriesData, citiesData []byte) (IntCountries, error) {
+	var countriesDoc countriesDocument
+	if err := json.Unmarshal(countriesData, &countriesDoc); err != nil {
+		return nil, err
+	}
+	var citiesDoc citiesDocument
+	if err := json.Unmarshal(citiesData, &citiesDoc); err != nil {
+		return nil, err
+	}
+
+	c := &intCountries{
+		countryByCode: make(map[string]Country),
+		codeByKey:     make(map[string]string),
+		cityByKey:     make(map[string]map[string]string),
+	}
+
+	for _, country := range countriesDoc.Countries {
+		if len(country.Code) != 2 || len(country.Name) == 0 {
+			return nil, fmt.Errorf("country %q must have a 2-letter code and a name", country.Code)
+		}
+		c.countryByCode[country.Code] = country
+		terms := append([]string{country.Code, country.Alpha3, country.Name}, country.Aliases...)
+		for _, term := range terms {
+			key := normalizeKey(term)
+			if len(key) == 0 {
+				continue
+			}
+			if existing, ok := c.codeByKey[key]; ok && existing != country.Code {
+				return nil, fmt.Errorf("term %q maps to both %q and %q", term, existing, country.Code)
+			}
+			c.codeByKey[key] = country.Code
+		}
+	}
+
+	for code, cities := range citiesDoc.Cities {
+		if _, ok := c.countryByCode[code]; !ok {
+			return nil, fmt.Errorf("city aliases reference unknown country %q", code)
+		}
+		c.cityByKey[code] = make(map[string]string)
+		for city, aliases := range cities {
+			for _, term := range append([]string{city}, aliases...) {
+				c.cityByKey[code][normalizeKey(term)] = city
+			}
+		}
+	}
+
+	return c, nil
+}
+
+func mustLoad(countriesData, citiesData []byte) IntCountries {
+	c, err := Load(countriesData, citiesData)
+	if err != nil {
+		panic(fmt.Sprintf("invalid embedded country dataset: %s", err))
+	}
+	return c
+}
+
+// normalizeKey folds case and diacritics and drops everything but letters and digits,
+// so "Türkiye", "turkiye" and "TURKIYE" share a key.
+func normalizeKey(s string) string {
+	folded, _, err := transform.String(transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn))), s)
+	if err != nil {
+		folded = s
+	}
+	var sb strings.Builder
+	for _, r := range strings.ToLower(folded) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			sb.WriteRune(r)
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,78 @@
+package countries
+
+import (
+	"testing"
+)
+
+func TestLoad(t *testing.T) {
+	tests := []struct {
+		name          string
+		countriesData []byte
+		citiesData    []byte
+		wantErr       bool
+	}{
+		{
+			name:          "Success - Load embedded dataset",
+			countriesData: countriesFile,
+			citiesData:    citiesFile,
+			wantErr:       false,
+		},
+		{
+			name:          "Error - Invalid countries JSON",
+			countriesData: []byte(`{"countries":`),
+			citiesData:    citiesFile,
+			wantErr:       true,
+		},
+		{
+			name:          "Error - Invalid cities JSON",
+			countriesData: countriesFile,
+			citiesData:    []byte(`{"cities":`),
+			wantErr:       true,
+		},
+		{
+			name:          "Error - Country code is not 2 letters",
+			countriesData: []byte(`{"countries":[{"code":"SGP","name":"Singapore"}]}`),
+			citiesData:    []byte(`{}`),
+			wantErr:       true,
+		},
+		{
+			name:          "Error - Alias maps to two countries",
+			countriesData: []byte(`{"countries":[{"code":"SG","name":"Singapore","aliases":["Lion City"]},{"code":"MY","name":"Malaysia","aliases":["Lion City"]}]}`),
+			citiesData:    []byte(`{}`),
+			wantErr:       true,
+		},
+		{
+			name:          "Error - City aliases for unknown country",
+			countriesData: []byte(`{"countries":[{"code":"SG","name":"Singapore"}]}`),
+			citiesData:    []byte(`{"cities":{"MY":{"Kuala Lumpur":["KL"]}}}`),
+			wantErr:       true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Load(tt.countriesData, tt.citiesData)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Load() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_normalizeKey(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{name: "Success - Diacritics", s: "Türkiye", want: "turkiye"},
+		{name: "Success - Spaces and punctuation", s: " United States of America. ", want: "unitedstatesofamerica"},
+		{name: "Success - Empty", s: "", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := normalizeKey(tt.s); got != tt.want {
+				t.Errorf("normalizeKey() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
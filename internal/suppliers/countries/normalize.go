@@ -0,0 +1,28 @@
+package countries
+
+import "strings"
+
+// NormalizeCountry resolves a country code, alpha-3 code, name or alias to its
+// ISO 3166-1 alpha-2 code.
+func (c *intCountries) NormalizeCountry(raw string) (string, bool) {
+	code, ok := c.codeByKey[normalizeKey(raw)]
+	return code, ok
+}
+
+// CountryName returns the display name of an ISO 3166-1 alpha-2 code,
+// or the code itself when it is not in the dataset.
+func (c *intCountries) CountryName(code string) string {
+	if country, ok := c.countryByCode[strings.ToUpper(code)]; ok {
+		return country.Name
+	}
+	return code
+}
+
+// NormalizeCity returns the canonical name of a city within a country.
+// Cities that are not in the alias table are returned unchanged.
+func (c *intCountries) NormalizeCity(countryCode, raw string) string {
+	if city, ok := c.cityByKey[strings.ToUpper(countryCode)][normalizeKey(raw)]; ok {
+		return city
+	}
+	return raw
+}
@@ -0,0 +1,76 @@
+package countries
+
+import (
+	"testing"
+)
+
+func Test_intCountries_NormalizeCountry(t *testing.T) {
+	tests := []struct {
+		name     string
+		raw      string
+		wantCode string
+		wantOk   bool
+	}{
+		{name: "Success - Alpha-2 code", raw: "SG", wantCode: "SG", wantOk: true},
+		{name: "Success - Lowercase alpha-2 code", raw: "sg", wantCode: "SG", wantOk: true},
+		{name: "Success - Alpha-3 code", raw: "SGP", wantCode: "SG", wantOk: true},
+		{name: "Success - Display name", raw: "Singapore", wantCode: "SG", wantOk: true},
+		{name: "Success - ISO name", raw: "Korea, Republic of", wantCode: "KR", wantOk: true},
+		{name: "Success - Alias", raw: "UK", wantCode: "GB", wantOk: true},
+		{name: "Success - Alias without diacritics", raw: "Turkiye", wantCode: "TR", wantOk: true},
+		{name: "Error - Unknown country", raw: "Atlantis", wantCode: "", wantOk: false},
+		{name: "Error - Empty string", raw: "", wantCode: "", wantOk: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := Default().NormalizeCountry(tt.raw)
+			if got != tt.wantCode || ok != tt.wantOk {
+				t.Errorf("NormalizeCountry() = (%v, %v), want (%v, %v)", got, ok, tt.wantCode, tt.wantOk)
+			}
+		})
+	}
+}
+
+func Test_intCountries_CountryName(t *testing.T) {
+	tests := []struct {
+		name string
+		code string
+		want string
+	}{
+		{name: "Success - Known code", code: "SG", want: "Singapore"},
+		{name: "Success - Common name preferred over ISO name", code: "KR", want: "South Korea"},
+		{name: "Success - Lowercase code", code: "jp", want: "Japan"},
+		{name: "Success - Unknown code returned as is", code: "XX", want: "XX"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Default().CountryName(tt.code); got != tt.want {
+				t.Errorf("CountryName() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_intCountries_NormalizeCity(t *testing.T) {
+	tests := []struct {
+		name        string
+		countryCode string
+		raw         string
+		want        string
+	}{
+		{name: "Success - Canonical name", countryCode: "SG", raw: "Singapore", want: "Singapore"},
+		{name: "Success - Alias", countryCode: "SG", raw: "singapore city", want: "Singapore"},
+		{name: "Success - Alias with diacritics", countryCode: "JP", raw: "Tōkyō", want: "Tokyo"},
+		{name: "Success - Historical name", countryCode: "IN", raw: "Bombay", want: "Mumbai"},
+		{name: "Success - Alias only applies within its country", countryCode: "SG", raw: "Bombay", want: "Bombay"},
+		{name: "Success - Unknown city returned as is", countryCode: "SG", raw: "Jurong", want: "Jurong"},
+		{name: "Success - Unknown country returned as is", countryCode: "", raw: "Tokyo-to", want: "Tokyo-to"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Default().NormalizeCity(tt.countryCode, tt.raw); got != tt.want {
+				t.Errorf("NormalizeCity() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,18 @@
+package fetcher
+
+import (
+	"context"
+	"encoding/json"
+
+	"hotelsDataMerge/internal/suppliers/utils"
+)
+
+// GetSupplierData fetches the raw data of a single supplier
+func (i *intFetcher) GetSupplierData(ctx context.Context, supplierName utils.Suppliers) (json.RawMessage, error) {
+	rawResp, err := i.extSuppliers.GetSuppliersRawInfo(ctx, supplierName)
+	if err != nil {
+		return nil, err
+	}
+	i.recordPayload(ctx, supplierName, rawResp)
+	return rawResp, nil
+}
@@ -0,0 +1,84 @@
+package fetcher
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"reflect"
+	"slices"
+	"testing"
+
+	"hotelsDataMerge/internal/suppliers/utils"
+)
+
+type mockExtSuppliers struct {
+	responses map[utils.Suppliers]json.RawMessage
+	errors    map[utils.Suppliers]error
+}
+
+func (m *mockExtSuppliers) GetSuppliersRawInfo(ctx context.Context, supplierName utils.Suppliers) (json.RawMessage, error) {
+	if err, exists := m.errors[supplierName]; exists {
+		return nil, err
+	}
+	if resp, exists := m.responses[supplierName]; exists {
+		return resp, nil
+	}
+	if !slices.Contains(utils.SupplierPriority, supplierName) {
+		return nil, fmt.Errorf("unknown supplier %q", supplierName)
+	}
+	return json.RawMessage{}, nil
+}
+
+func Test_intFetcher_GetSupplierData(t *testing.T) {
+	extSuppliers := &mockExtSuppliers{
+		responses: map[utils.Suppliers]json.RawMessage{
+			utils.Acme: json.RawMessage(`[{"Id":"iJhz"}]`),
+		},
+		errors: map[utils.Suppliers]error{
+			utils.Patagonia: errors.New("connection refused"),
+		},
+	}
+	tests := []struct {
+		name         string
+		supplierName utils.Suppliers
+		want         json.RawMessage
+		wantErr      bool
+	}{
+		{
+			name:         "Success - Get data of one supplier",
+			supplierName: utils.Acme,
+			want:         json.RawMessage(`[{"Id":"iJhz"}]`),
+			wantErr:      false,
+		},
+		{
+			name:         "Error - Supplier request fails",
+			supplierName: utils.Patagonia,
+			want:         nil,
+			wantErr:      true,
+		},
+		{
+			name:         "Error - Unknown supplier",
+			supplierName: utils.Suppliers("unknown"),
+			want:         nil,
+			wantErr:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			i := &intFetcher{
+				logger:       slog.Default(),
+				extSuppliers: extSuppliers,
+			}
+			got, err := i.GetSupplierData(context.Background(), tt.supplierName)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetSupplierData() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetSupplierData() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package fetcher
 
 import (
+	"context"
 	"encoding/json"
 	"log/slog"
 
@@ -9,17 +10,25 @@ import (
 )
 
 type IntFetcher interface {
-	GetLatestSupplierData() (hotelRawMap map[utils.Suppliers]json.RawMessage, err error)
+	GetSupplierData(ctx context.Context, supplierName utils.Suppliers) (rawResp json.RawMessage, err error)
+}
+
+// Recorder archives the raw payloads fetched from the suppliers, e.g. to replay them later
+type Recorder interface {
+	Record(ctx context.Context, supplierName utils.Suppliers, rawResp json.RawMessage) error
 }
 
 type intFetcher struct {
 	logger       *slog.Logger
 	extSuppliers external.ExtSuppliers
+	recorder     Recorder
 }
 
-func Initialize(logger *slog.Logger, extSuppliers external.ExtSuppliers) IntFetcher {
+// Initialize returns the fetcher. When recorder is not nil, every payload fetched successfully is recorded.
+func Initialize(logger *slog.Logger, extSuppliers external.ExtSuppliers, recorder Recorder) IntFetcher {
 	return &intFetcher{
 		logger:       logger,
 		extSuppliers: extSuppliers,
+		recorder:     recorder,
 	}
 }
@@ -22,22 +22,22 @@ func TestInitialize(t *testing.T) {
 			name: "Success - Initialize with logger and external suppliers",
 			args: args{
 				logger:       slog.Default(),
-				extSuppliers: external.Initialize(slog.Default()),
+				extSuppliers: external.Initialize(slog.Default(), nil, external.Options{}),
 			},
 			want: &intFetcher{
 				logger:       slog.Default(),
-				extSuppliers: external.Initialize(slog.Default()),
+				extSuppliers: external.Initialize(slog.Default(), nil, external.Options{}),
 			},
 		},
 		{
 			name: "Success - Initialize with nil logger",
 			args: args{
 				logger:       nil,
-				extSuppliers: external.Initialize(slog.Default()),
+				extSuppliers: external.Initialize(slog.Default(), nil, external.Options{}),
 			},
 			want: &intFetcher{
 				logger:       nil,
-				extSuppliers: external.Initialize(slog.Default()),
+				extSuppliers: external.Initialize(slog.Default(), nil, external.Options{}),
 			},
 		},
 		{
@@ -65,7 +65,7 @@ func TestInitialize(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := Initialize(tt.args.logger, tt.args.extSuppliers); !reflect.DeepEqual(got, tt.want) {
+			if got := Initialize(tt.args.logger, tt.args.extSuppliers, nil); !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("Initialize() = %v, want %v", got, tt.want)
 			}
 		})
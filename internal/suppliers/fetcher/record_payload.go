@@ -0,0 +1,38 @@
+package fetcher
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"hotelsDataMerge/internal/suppliers/utils"
+)
+
+// Run identifies the refresh run a fetch belongs to, so that its payloads are recorded together
+type Run struct {
+	ID        int64
+	StartedAt time.Time
+}
+
+type runKey struct{}
+
+// WithRun returns a context whose fetches belong to run
+func WithRun(ctx context.Context, run Run) context.Context {
+	return context.WithValue(ctx, runKey{}, run)
+}
+
+// RunFromContext returns the run of the fetches made with ctx, if any
+func RunFromContext(ctx context.Context) (Run, bool) {
+	run, ok := ctx.Value(runKey{}).(Run)
+	return run, ok
+}
+
+// recordPayload records a fetched payload. A failed recording is only logged, so that it never fails a refresh.
+func (i *intFetcher) recordPayload(ctx context.Context, supplierName utils.Suppliers, rawResp json.RawMessage) {
+	if i.recorder == nil {
+		return
+	}
+	if err := i.recorder.Record(ctx, supplierName, rawResp); err != nil && i.logger != nil {
+		i.logger.WarnContext(ctx, "[Fetcher] Failed to record the supplier payload", "supplier", supplierName, "error", err)
+	}
+}
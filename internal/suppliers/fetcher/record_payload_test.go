@@ -0,0 +1,80 @@
+package fetcher
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"reflect"
+	"testing"
+	"time"
+
+	"hotelsDataMerge/internal/suppliers/utils"
+)
+
+type recorded struct {
+	run          Run
+	supplierName utils.Suppliers
+	rawResp      string
+}
+
+type mockRecorder struct {
+	recorded []recorded
+	err      error
+}
+
+func (m *mockRecorder) Record(ctx context.Context, supplierName utils.Suppliers, rawResp json.RawMessage) error {
+	run, _ := RunFromContext(ctx)
+	m.recorded = append(m.recorded, recorded{run: run, supplierName: supplierName, rawResp: string(rawResp)})
+	return m.err
+}
+
+func Test_intFetcher_recordPayload(t *testing.T) {
+	extSuppliers := &mockExtSuppliers{
+		responses: map[utils.Suppliers]json.RawMessage{
+			utils.Acme: json.RawMessage(`[{"Id":"iJhz"}]`),
+		},
+		errors: map[utils.Suppliers]error{
+			utils.Patagonia: errors.New("connection refused"),
+		},
+	}
+	run := Run{ID: 3, StartedAt: time.Date(2026, 10, 19, 8, 0, 0, 0, time.UTC)}
+
+	tests := []struct {
+		name         string
+		supplierName utils.Suppliers
+		recordErr    error
+		want         []recorded
+		wantErr      bool
+	}{
+		{
+			name:         "Success - Payload recorded with the run",
+			supplierName: utils.Acme,
+			want:         []recorded{{run: run, supplierName: utils.Acme, rawResp: `[{"Id":"iJhz"}]`}},
+		},
+		{
+			name:         "Success - Failed recording does not fail the fetch",
+			supplierName: utils.Acme,
+			recordErr:    errors.New("disk full"),
+			want:         []recorded{{run: run, supplierName: utils.Acme, rawResp: `[{"Id":"iJhz"}]`}},
+		},
+		{
+			name:         "Error - Failed fetch is not recorded",
+			supplierName: utils.Patagonia,
+			wantErr:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := &mockRecorder{err: tt.recordErr}
+			i := Initialize(slog.Default(), extSuppliers, recorder)
+			_, err := i.GetSupplierData(WithRun(context.Background(), run), tt.supplierName)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GetSupplierData() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(recorder.recorded, tt.want) {
+				t.Errorf("recorded = %+v, want %+v", recorder.recorded, tt.want)
+			}
+		})
+	}
+}
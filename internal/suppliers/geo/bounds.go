@@ -0,0 +1,54 @@
+package geo
+
+import (
+	"math"
+	"slices"
+)
+
+// Centroid returns the mean of the points on the unit sphere, so that points on both sides of
+// the antimeridian average to a longitude near ±180 rather than near 0. points must not be empty.
+func Centroid(points []Point) Point {
+	var x, y, z float64
+	for _, point := range points {
+		lat, lng := toRadians(point.Lat), toRadians(point.Lng)
+		x += math.Cos(lat) * math.Cos(lng)
+		y += math.Cos(lat) * math.Sin(lng)
+		z += math.Sin(lat)
+	}
+	n := float64(len(points))
+	x, y, z = x/n, y/n, z/n
+	return Point{
+		Lat: toDegrees(math.Atan2(z, math.Hypot(x, y))),
+		Lng: toDegrees(math.Atan2(y, x)),
+	}
+}
+
+// Bounds returns the south-west and north-east corners of the smallest box containing the points.
+// When the box crosses the antimeridian, the south-west longitude is greater than the north-east one.
+// points must not be empty.
+func Bounds(points []Point) (southWest, northEast Point) {
+	southWest, northEast = points[0], points[0]
+	lngs := make([]float64, 0, len(points))
+	for _, point := range points {
+		southWest.Lat = min(southWest.Lat, point.Lat)
+		northEast.Lat = max(northEast.Lat, point.Lat)
+		lngs = append(lngs, point.Lng)
+	}
+	slices.Sort(lngs)
+
+	// The box spans every longitude except the largest gap between two consecutive ones,
+	// the gap across the antimeridian included
+	southWest.Lng, northEast.Lng = lngs[0], lngs[len(lngs)-1]
+	largestGap := lngs[0] + 360 - lngs[len(lngs)-1]
+	for i := 1; i < len(lngs); i++ {
+		if gap := lngs[i] - lngs[i-1]; gap > largestGap {
+			largestGap = gap
+			southWest.Lng, northEast.Lng = lngs[i], lngs[i-1]
+		}
+	}
+	return southWest, northEast
+}
+
+func toDegrees(rad float64) float64 {
+	return rad * 180 / math.Pi
+}
@@ -0,0 +1,75 @@
+package geo
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCentroid(t *testing.T) {
+	tests := []struct {
+		name   string
+		points []Point
+		want   Point
+	}{
+		{
+			name:   "Success - Single point",
+			points: []Point{{Lat: 1.264751, Lng: 103.824006}},
+			want:   Point{Lat: 1.264751, Lng: 103.824006},
+		},
+		{
+			name:   "Success - Points on the equator",
+			points: []Point{{Lat: 0, Lng: 10}, {Lat: 0, Lng: 20}},
+			want:   Point{Lat: 0, Lng: 15},
+		},
+		{
+			name:   "Success - Points across the antimeridian",
+			points: []Point{{Lat: -17, Lng: 179}, {Lat: -17, Lng: -179}},
+			want:   Point{Lat: -17.00244, Lng: 180},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Centroid(tt.points)
+			lngDiff := math.Mod(math.Abs(got.Lng-tt.want.Lng), 360)
+			if math.Abs(got.Lat-tt.want.Lat) > 1e-5 || math.Min(lngDiff, 360-lngDiff) > 1e-5 {
+				t.Errorf("Centroid() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBounds(t *testing.T) {
+	tests := []struct {
+		name          string
+		points        []Point
+		wantSouthWest Point
+		wantNorthEast Point
+	}{
+		{
+			name:          "Success - Single point",
+			points:        []Point{{Lat: 1.264751, Lng: 103.824006}},
+			wantSouthWest: Point{Lat: 1.264751, Lng: 103.824006},
+			wantNorthEast: Point{Lat: 1.264751, Lng: 103.824006},
+		},
+		{
+			name:          "Success - Points on one side of the antimeridian",
+			points:        []Point{{Lat: 1.3, Lng: 103.9}, {Lat: 1.2, Lng: 103.8}, {Lat: 1.4, Lng: 104}},
+			wantSouthWest: Point{Lat: 1.2, Lng: 103.8},
+			wantNorthEast: Point{Lat: 1.4, Lng: 104},
+		},
+		{
+			name:          "Success - Points across the antimeridian",
+			points:        []Point{{Lat: -16, Lng: 179}, {Lat: -18, Lng: -179}, {Lat: -17, Lng: 178}},
+			wantSouthWest: Point{Lat: -18, Lng: 178},
+			wantNorthEast: Point{Lat: -16, Lng: -179},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotSouthWest, gotNorthEast := Bounds(tt.points)
+			if gotSouthWest != tt.wantSouthWest || gotNorthEast != tt.wantNorthEast {
+				t.Errorf("Bounds() = %v, %v, want %v, %v", gotSouthWest, gotNorthEast, tt.wantSouthWest, tt.wantNorthEast)
+			}
+		})
+	}
+}
@@ -0,0 +1,20 @@
+package geo
+
+// Consensus returns the indexes of the largest group of points that lie within thresholdKm
+// of one of them, in ascending order. When groups are the same size, the group around the
+// earliest point wins, so callers can pass points in supplier priority order.
+func Consensus(points []Point, thresholdKm float64) []int {
+	var best []int
+	for i := range points {
+		var members []int
+		for j := range points {
+			if Distance(points[i], points[j]) <= thresholdKm {
+				members = append(members, j)
+			}
+		}
+		if len(members) > len(best) {
+			best = members
+		}
+	}
+	return best
+}
@@ -0,0 +1,52 @@
+package geo
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestConsensus(t *testing.T) {
+	singapore := Point{Lat: 1.264751, Lng: 103.824006}
+	singaporeNearby := Point{Lat: 1.2647, Lng: 103.824}
+	paris := Point{Lat: 48.8566, Lng: 2.3522}
+	tokyo := Point{Lat: 35.6926, Lng: 139.690965}
+
+	tests := []struct {
+		name        string
+		points      []Point
+		thresholdKm float64
+		want        []int
+	}{
+		{
+			name:        "Success - No points",
+			points:      nil,
+			thresholdKm: 2,
+			want:        nil,
+		},
+		{
+			name:        "Success - Majority wins over earlier outlier",
+			points:      []Point{paris, singapore, singaporeNearby},
+			thresholdKm: 2,
+			want:        []int{1, 2},
+		},
+		{
+			name:        "Success - Tie goes to the earliest point",
+			points:      []Point{tokyo, paris},
+			thresholdKm: 2,
+			want:        []int{0},
+		},
+		{
+			name:        "Success - Every point agrees",
+			points:      []Point{singapore, singaporeNearby},
+			thresholdKm: 2,
+			want:        []int{0, 1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Consensus(tt.points, tt.thresholdKm); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Consensus() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package geo
+
+import "math"
+
+const earthRadiusKm = 6371.0
+
+// nullIslandToleranceDeg is how close to (0, 0) a point must be to be treated as a placeholder
+const nullIslandToleranceDeg = 0.0001
+
+type Point struct {
+	Lat float64
+	Lng float64
+}
+
+// ParsePoint returns the point for supplier coordinates when both values are numbers
+func ParsePoint(lat, lng any) (Point, bool) {
+	latFloat, ok := lat.(float64)
+	if !ok {
+		return Point{}, false
+	}
+	lngFloat, ok := lng.(float64)
+	if !ok {
+		return Point{}, false
+	}
+	return Point{Lat: latFloat, Lng: lngFloat}, true
+}
+
+// InRange reports whether the latitude is within [-90, 90] and the longitude within [-180, 180]
+func (p Point) InRange() bool {
+	return p.Lat >= -90 && p.Lat <= 90 && p.Lng >= -180 && p.Lng <= 180
+}
+
+// IsNullIsland reports whether the point is (0, 0), which suppliers send when coordinates are missing
+func (p Point) IsNullIsland() bool {
+	return math.Abs(p.Lat) < nullIslandToleranceDeg && math.Abs(p.Lng) < nullIslandToleranceDeg
+}
+
+// Swapped returns the point with latitude and longitude exchanged
+func (p Point) Swapped() Point {
+	return Point{Lat: p.Lng, Lng: p.Lat}
+}
+
+// Distance returns the great-circle distance between two points in kilometres
+func Distance(a, b Point) float64 {
+	lat1, lat2 := toRadians(a.Lat), toRadians(b.Lat)
+	deltaLat := lat2 - lat1
+	deltaLng := toRadians(b.Lng - a.Lng)
+
+	h := math.Sin(deltaLat/2)*math.Sin(deltaLat/2) +
+		math.Cos(lat1)*math.Cos(lat2)*math.Sin(deltaLng/2)*math.Sin(deltaLng/2)
+	return 2 * earthRadiusKm * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+func toRadians(deg float64) float64 {
+	return deg * math.Pi / 180
+}
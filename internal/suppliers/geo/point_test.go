@@ -0,0 +1,126 @@
+package geo
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestParsePoint(t *testing.T) {
+	tests := []struct {
+		name   string
+		lat    any
+		lng    any
+		want   Point
+		wantOk bool
+	}{
+		{
+			name:   "Success - Parse numeric coordinates",
+			lat:    1.264751,
+			lng:    103.824006,
+			want:   Point{Lat: 1.264751, Lng: 103.824006},
+			wantOk: true,
+		},
+		{
+			name:   "Error - Missing latitude",
+			lat:    nil,
+			lng:    103.824006,
+			want:   Point{},
+			wantOk: false,
+		},
+		{
+			name:   "Error - Non-numeric longitude",
+			lat:    1.264751,
+			lng:    "103.824006",
+			want:   Point{},
+			wantOk: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := ParsePoint(tt.lat, tt.lng)
+			if !reflect.DeepEqual(got, tt.want) || ok != tt.wantOk {
+				t.Errorf("ParsePoint() = %v, %v, want %v, %v", got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestPoint_Checks(t *testing.T) {
+	tests := []struct {
+		name           string
+		point          Point
+		wantInRange    bool
+		wantNullIsland bool
+	}{
+		{
+			name:           "Success - Valid point",
+			point:          Point{Lat: 35.6926, Lng: 139.690965},
+			wantInRange:    true,
+			wantNullIsland: false,
+		},
+		{
+			name:           "Success - Null island",
+			point:          Point{Lat: 0, Lng: 0.00001},
+			wantInRange:    true,
+			wantNullIsland: true,
+		},
+		{
+			name:           "Success - Latitude out of range",
+			point:          Point{Lat: 139.690965, Lng: 35.6926},
+			wantInRange:    false,
+			wantNullIsland: false,
+		},
+		{
+			name:           "Success - Longitude out of range",
+			point:          Point{Lat: 35.6926, Lng: 190},
+			wantInRange:    false,
+			wantNullIsland: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.point.InRange(); got != tt.wantInRange {
+				t.Errorf("InRange() = %v, want %v", got, tt.wantInRange)
+			}
+			if got := tt.point.IsNullIsland(); got != tt.wantNullIsland {
+				t.Errorf("IsNullIsland() = %v, want %v", got, tt.wantNullIsland)
+			}
+		})
+	}
+}
+
+func TestDistance(t *testing.T) {
+	tests := []struct {
+		name string
+		a    Point
+		b    Point
+		want float64
+	}{
+		{
+			name: "Success - Same point",
+			a:    Point{Lat: 1.264751, Lng: 103.824006},
+			b:    Point{Lat: 1.264751, Lng: 103.824006},
+			want: 0,
+		},
+		{
+			name: "Success - Paris to London",
+			a:    Point{Lat: 48.8566, Lng: 2.3522},
+			b:    Point{Lat: 51.5074, Lng: -0.1278},
+			want: 343.5,
+		},
+		{
+			name: "Success - Antipodal points",
+			a:    Point{Lat: 0, Lng: 0},
+			b:    Point{Lat: 0, Lng: 180},
+			want: math.Pi * earthRadiusKm,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Distance(tt.a, tt.b); math.Abs(got-tt.want) > 0.5 {
+				t.Errorf("Distance() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"hotelsDataMerge/internal/suppliers/fetcher"
 	"hotelsDataMerge/internal/suppliers/merger"
 	"hotelsDataMerge/internal/suppliers/parser"
+	"hotelsDataMerge/internal/suppliers/textnorm"
 )
 
 type IntSuppliers struct {
@@ -15,10 +16,26 @@ type IntSuppliers struct {
 	Merger  merger.IntMerger
 }
 
-func Initialize(logger *slog.Logger, extSuppliers external.ExtSuppliers) *IntSuppliers {
+// Options configures the parsing and merging of the suppliers data
+type Options struct {
+	// TextNormalization is the text cleanup every parser applies to the supplier values
+	TextNormalization textnorm.Options
+	Merger            merger.Options
+}
+
+// DefaultOptions returns the default options of every stage
+func DefaultOptions() Options {
+	return Options{
+		TextNormalization: textnorm.DefaultOptions(),
+		Merger:            merger.DefaultOptions(),
+	}
+}
+
+// Initialize returns the suppliers pipeline stages; recorder, when not nil, archives every fetched payload
+func Initialize(logger *slog.Logger, extSuppliers external.ExtSuppliers, recorder fetcher.Recorder, options Options) *IntSuppliers {
 	return &IntSuppliers{
-		Fetcher: fetcher.Initialize(logger, extSuppliers),
-		Parser:  parser.Initialize(logger),
-		Merger:  merger.Initialize(logger),
+		Fetcher: fetcher.Initialize(logger, extSuppliers, recorder),
+		Parser:  parser.Initialize(logger, textnorm.Initialize(options.TextNormalization)),
+		Merger:  merger.Initialize(logger, options.Merger),
 	}
 }
@@ -0,0 +1,24 @@
+package merger
+
+import (
+	"hotelsDataMerge/internal/hotels"
+	"hotelsDataMerge/internal/suppliers/quality"
+)
+
+// extractBookingPolicies derives a structured booking policy from every merged hotel's
+// booking conditions and reports the sentences that no rule recognized.
+func (i *intMerger) extractBookingPolicies(hotelByHotelIDMap map[string]hotels.Hotel, report *quality.Report) {
+	if i.policy == nil {
+		return
+	}
+
+	for hotelID, hotel := range hotelByHotelIDMap {
+		hotel.BookingPolicy = i.policy.Extract(hotel.BookingConditions)
+		if hotel.BookingPolicy != nil {
+			for _, sentence := range hotel.BookingPolicy.UnrecognizedSentences {
+				report.Add(hotelID, quality.IssueUnrecognizedCondition, "booking condition %q was not recognized", sentence)
+			}
+		}
+		hotelByHotelIDMap[hotelID] = hotel
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"strings"
 
 	"hotelsDataMerge/internal/hotels"
+	"hotelsDataMerge/internal/suppliers/countries"
 )
 
 func (b *hotelBuilder) WithID(existing, new string) *hotelBuilder {
@@ -74,8 +75,9 @@ func (b *hotelBuilder) WithLocation(existing, new *hotels.HotelLocation) *hotelB
 		merged.City = new.City
 	}
 
-	// If the new country is empty or a 2-letter code, prefer the existing one
-	if len(new.Country) == 0 || len(existing.Country) == 2 {
+	// Parsers normalize countries to ISO 3166-1 alpha-2 codes, so a recognised code
+	// is preferred over a value that could not be normalized
+	if len(new.Country) == 0 || (isCountryCode(existing.Country) && !isCountryCode(new.Country)) {
 		merged.Country = existing.Country
 	} else {
 		merged.Country = new.Country
@@ -86,6 +88,10 @@ func (b *hotelBuilder) WithLocation(existing, new *hotels.HotelLocation) *hotelB
 }
 
 func (b *hotelBuilder) WithDescription(existing, new string) *hotelBuilder {
+	if b.descriptionStrategy == DescriptionCombine {
+		b.hotel.Description = combineDescriptions(existing, new)
+		return b
+	}
 	if len(new) > len(existing) {
 		b.hotel.Description = new
 	} else {
@@ -94,6 +100,11 @@ func (b *hotelBuilder) WithDescription(existing, new string) *hotelBuilder {
 	return b
 }
 
+func (b *hotelBuilder) WithDescriptionStrategy(strategy DescriptionStrategy) *hotelBuilder {
+	b.descriptionStrategy = strategy
+	return b
+}
+
 func (b *hotelBuilder) WithAmenities(existing, new *hotels.HotelAmenities) *hotelBuilder {
 	if existing == nil {
 		b.hotel.Amenities = new
@@ -142,6 +153,11 @@ func (b *hotelBuilder) WithBookingConditions(existing, new []string) *hotelBuild
 	return b
 }
 
+func isCountryCode(country string) bool {
+	code, ok := countries.Default().NormalizeCountry(country)
+	return ok && code == country
+}
+
 func mergeStrings(existing, new []string) []string {
 	merged := make([]string, 0)
 	mergedMap := make(map[string]bool)
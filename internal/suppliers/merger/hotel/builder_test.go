@@ -312,7 +312,8 @@ func Test_hotelBuilder_WithBookingConditions(t *testing.T) {
 
 func Test_hotelBuilder_WithDescription(t *testing.T) {
 	type fields struct {
-		hotel hotels.Hotel
+		hotel               hotels.Hotel
+		descriptionStrategy DescriptionStrategy
 	}
 	type args struct {
 		existing string
@@ -384,11 +385,46 @@ func Test_hotelBuilder_WithDescription(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Success - Combine sentences from both descriptions",
+			fields: fields{
+				hotel:               hotels.Hotel{},
+				descriptionStrategy: DescriptionCombine,
+			},
+			args: args{
+				existing: "A beautiful luxury hotel with amazing amenities. Check-in from 3 PM.",
+				new:      "A nice hotel. Check-in from 3 PM.",
+			},
+			want: &hotelBuilder{
+				hotel: hotels.Hotel{
+					Description: "A beautiful luxury hotel with amazing amenities. Check-in from 3 PM. A nice hotel.",
+				},
+				descriptionStrategy: DescriptionCombine,
+			},
+		},
+		{
+			name: "Success - Combine with empty existing description",
+			fields: fields{
+				hotel:               hotels.Hotel{},
+				descriptionStrategy: DescriptionCombine,
+			},
+			args: args{
+				existing: "",
+				new:      "A nice hotel.",
+			},
+			want: &hotelBuilder{
+				hotel: hotels.Hotel{
+					Description: "A nice hotel.",
+				},
+				descriptionStrategy: DescriptionCombine,
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			b := &hotelBuilder{
-				hotel: tt.fields.hotel,
+				hotel:               tt.fields.hotel,
+				descriptionStrategy: tt.fields.descriptionStrategy,
 			}
 			if got := b.WithDescription(tt.args.existing, tt.args.new); !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("WithDescription() = %v, want %v", got, tt.want)
@@ -721,6 +757,67 @@ func Test_hotelBuilder_WithLocation(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Success - Keep existing ISO country code over unrecognised country",
+			fields: fields{
+				hotel: hotels.Hotel{},
+			},
+			args: args{
+				existing: &hotels.HotelLocation{
+					Country: "SG",
+				},
+				new: &hotels.HotelLocation{
+					Country: "Republic of Lion City",
+				},
+			},
+			want: &hotelBuilder{
+				hotel: hotels.Hotel{
+					Location: &hotels.HotelLocation{
+						Country: "SG",
+					},
+				},
+			},
+		},
+		{
+			name: "Success - Replace unrecognised country with ISO country code",
+			fields: fields{
+				hotel: hotels.Hotel{},
+			},
+			args: args{
+				existing: &hotels.HotelLocation{
+					Country: "Republic of Lion City",
+				},
+				new: &hotels.HotelLocation{
+					Country: "SG",
+				},
+			},
+			want: &hotelBuilder{
+				hotel: hotels.Hotel{
+					Location: &hotels.HotelLocation{
+						Country: "SG",
+					},
+				},
+			},
+		},
+		{
+			name: "Success - Keep existing country when new country is empty",
+			fields: fields{
+				hotel: hotels.Hotel{},
+			},
+			args: args{
+				existing: &hotels.HotelLocation{
+					Country: "JP",
+				},
+				new: &hotels.HotelLocation{},
+			},
+			want: &hotelBuilder{
+				hotel: hotels.Hotel{
+					Location: &hotels.HotelLocation{
+						Country: "JP",
+					},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
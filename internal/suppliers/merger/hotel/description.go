@@ -0,0 +1,96 @@
+package hotel
+
+import (
+	"strings"
+	"unicode"
+
+	"hotelsDataMerge/internal/suppliers/textnorm"
+)
+
+// DescriptionStrategy decides how descriptions from different suppliers are merged
+type DescriptionStrategy int
+
+const (
+	// DescriptionLongest keeps the longer of the two descriptions
+	DescriptionLongest DescriptionStrategy = iota
+	// DescriptionCombine keeps every distinct sentence, with the primary supplier's text first
+	DescriptionCombine
+)
+
+// DescriptionStrategies maps the names used in the configuration to their strategy
+var DescriptionStrategies = map[string]DescriptionStrategy{
+	"longest": DescriptionLongest,
+	"combine": DescriptionCombine,
+}
+
+// sentenceSimilarityThreshold is the share of the words of each sentence that must appear in the other
+// for a sentence to be considered a near-duplicate of a kept one
+const sentenceSimilarityThreshold = 0.8
+
+// negationWords are the words that reverse the meaning of a sentence, so that two sentences differing by
+// one of them are never near-duplicates however many words they share
+var negationWords = []string{"not", "no", "never", "without", "cannot"}
+
+// combineDescriptions splits both descriptions into sentences and appends every sentence
+// of the secondary description that is not a near-duplicate of a sentence already kept.
+func combineDescriptions(primary, secondary string) string {
+	var combined []string
+	var combinedWords []map[string]bool
+	for _, sentence := range append(textnorm.SplitSentences(primary), textnorm.SplitSentences(secondary)...) {
+		words := sentenceWords(sentence)
+		duplicate := false
+		for _, keptWords := range combinedWords {
+			if sentenceSimilarity(words, keptWords) >= sentenceSimilarityThreshold {
+				duplicate = true
+				break
+			}
+		}
+		if duplicate {
+			continue
+		}
+		combined = append(combined, sentence)
+		combinedWords = append(combinedWords, words)
+	}
+	return strings.Join(combined, " ")
+}
+
+// sentenceWords returns the set of lowercase words in a sentence, ignoring punctuation
+func sentenceWords(sentence string) map[string]bool {
+	words := make(map[string]bool)
+	for _, word := range strings.FieldsFunc(strings.ToLower(sentence), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		words[word] = true
+	}
+	return words
+}
+
+// sentenceSimilarity returns the smaller of the shares of each sentence's words that appear in the other,
+// so that a short sentence contained in a longer one, such as "Free parking." in "Free parking on site.",
+// is not a duplicate of it. Sentences where only one of them has a negation word are not similar at all.
+// A candidate without words adds nothing and is always a duplicate.
+func sentenceSimilarity(candidate, kept map[string]bool) float64 {
+	if len(candidate) == 0 {
+		return 1
+	}
+	for _, negation := range negationWords {
+		if candidate[negation] != kept[negation] {
+			return 0
+		}
+	}
+	return min(wordCoverage(candidate, kept), wordCoverage(kept, candidate))
+}
+
+// wordCoverage returns the share of the words that also appear in other
+func wordCoverage(words, other map[string]bool) float64 {
+	if len(words) == 0 {
+		return 0
+	}
+	common := 0
+	for word := range words {
+		if other[word] {
+			common++
+		}
+	}
+	return float64(common) / float64(len(words))
+}
@@ -0,0 +1,179 @@
+package hotel
+
+import "testing"
+
+// Descriptions below are taken from the Acme, Patagonia and Paperflies supplier payloads
+const (
+	acmeBeachVillas       = "This 5 star hotel is located on the coastline of Singapore."
+	patagoniaBeachVillas  = "Located at the western tip of Resorts World Sentosa, guests at the Beach Villas are guaranteed privacy while they enjoy spectacular views of glittering waters. Guests will find themselves in paradise with this series of exquisite tropical sanctuaries, making it the perfect setting for an idyllic retreat. Within each villa, guests will discover living areas and bedrooms that open out to mini gardens, private timber sundecks and verandahs elegantly framing either lush greenery or an expanse of sea. Guests are assured of a superior slumber with goose feather pillows and luxe mattresses paired with 400 thread count Egyptian cotton bed linen, tastefully paired with a full complement of luxurious in-room amenities and bathrooms boasting rain showers and free-standing tubs coupled with an exclusive array of ESPA amenities and toiletries. Guests also get to enjoy complimentary day access to the facilities at Asia's flagship spa - the world-renowned ESPA."
+	paperfliesBeachVillas = "Surrounded by tropical gardens, these upscale villas in elegant Colonial-style buildings are part of the Resorts World Sentosa complex and a 2-minute walk from the Waterfront train station. Featuring sundecks and pool, garden or sea views, the plush 1- to 3-bedroom villas offer free Wi-Fi and flat-screens, as well as free-standing baths, minibars, and tea and coffeemaking facilities. Upgraded villas add private pools, fridges and microwaves; some have wine cellars. A 4-bedroom unit offers a kitchen and a living room. There's 24-hour room and butler service. Amenities include posh restaurant, plus an outdoor pool, a hotel bar, and complimentary valet parking."
+
+	patagoniaInterContinental  = "InterContinental Singapore Robertson Quay is luxury's preferred address offering stylishly cosmopolitan riverside living for discerning travelers to Singapore. Prominently situated along the Singapore River, the 225-room inspiring luxury hotel is easily accessible to the Marina Bay Financial District, Central Business District, Orchard Road and Singapore Changi International Airport, all located a short drive away. The hotel features the latest in Club InterContinental design and service experience, and six dining and bar experiences including Publico Ristorante, an Italian-inspired cafe serving an all-day breakfast with 24-hour room service."
+	paperfliesInterContinental = "InterContinental Singapore Robertson Quay is luxury's preferred address offering stylishly cosmopolitan riverside living for discerning travelers to Singapore. Prominently situated along the Singapore River, the 225-room inspiring luxury hotel is easily accessible to the Marina Bay Financial District, Central Business District, Orchard Road and Singapore Changi International Airport, all located a short drive away. The hotel features the latest in Club InterContinental design and service experience, and five dining options including Publico, an Italian-inspired restaurant and bar."
+	acmeInterContinental       = "InterContinental Singapore Robertson Quay is luxury's preferred address offering stylishly cosmopolitan riverside living for discerning travelers to Singapore. Prominently situated along the Singapore River, the 225-room inspiring luxury hotel is easily accessible to the Marina Bay Financial District, Central Business District, Orchard Road and Singapore Changi International Airport, all located a short drive away."
+)
+
+func Test_combineDescriptions(t *testing.T) {
+	type args struct {
+		primary   string
+		secondary string
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			name: "Success - Unrelated descriptions keep every sentence, primary first",
+			args: args{
+				primary:   paperfliesBeachVillas,
+				secondary: acmeBeachVillas,
+			},
+			want: paperfliesBeachVillas + " " + acmeBeachVillas,
+		},
+		{
+			name: "Success - Identical sentences are dropped",
+			args: args{
+				primary:   paperfliesInterContinental,
+				secondary: acmeInterContinental,
+			},
+			want: paperfliesInterContinental,
+		},
+		{
+			name: "Success - Only the differing sentence of the secondary description is added",
+			args: args{
+				primary:   paperfliesInterContinental,
+				secondary: patagoniaInterContinental,
+			},
+			want: paperfliesInterContinental + " The hotel features the latest in Club InterContinental design and service experience, and six dining and bar experiences including Publico Ristorante, an Italian-inspired cafe serving an all-day breakfast with 24-hour room service.",
+		},
+		{
+			name: "Success - Near-duplicate sentence with extra words is dropped",
+			args: args{
+				primary:   "Pets are not allowed. Check-in from 3 PM.",
+				secondary: "Pets are strictly not allowed. Free parking on site.",
+			},
+			want: "Pets are not allowed. Check-in from 3 PM. Free parking on site.",
+		},
+		{
+			name: "Success - Short sentence contradicting a longer one is kept",
+			args: args{
+				primary:   "Pets are not allowed. Check-in from 3 PM.",
+				secondary: "Pets allowed.",
+			},
+			want: "Pets are not allowed. Check-in from 3 PM. Pets allowed.",
+		},
+		{
+			name: "Success - Sentence differing by a negation is kept",
+			args: args{
+				primary:   "Pets are allowed in all rooms of the hotel.",
+				secondary: "Pets are not allowed in all rooms of the hotel.",
+			},
+			want: "Pets are allowed in all rooms of the hotel. Pets are not allowed in all rooms of the hotel.",
+		},
+		{
+			name: "Success - Three suppliers merged one after another",
+			args: args{
+				primary:   combineDescriptions(paperfliesBeachVillas, patagoniaBeachVillas),
+				secondary: acmeBeachVillas,
+			},
+			want: paperfliesBeachVillas + " " + patagoniaBeachVillas + " " + acmeBeachVillas,
+		},
+		{
+			name: "Success - Empty primary description",
+			args: args{
+				primary:   "",
+				secondary: acmeBeachVillas,
+			},
+			want: acmeBeachVillas,
+		},
+		{
+			name: "Success - Empty secondary description",
+			args: args{
+				primary:   acmeBeachVillas,
+				secondary: "",
+			},
+			want: acmeBeachVillas,
+		},
+		{
+			name: "Success - Both descriptions empty",
+			args: args{
+				primary:   "",
+				secondary: "",
+			},
+			want: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := combineDescriptions(tt.args.primary, tt.args.secondary); got != tt.want {
+				t.Errorf("combineDescriptions() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_sentenceSimilarity(t *testing.T) {
+	tests := []struct {
+		name      string
+		candidate string
+		kept      string
+		want      float64
+	}{
+		{
+			name:      "Success - Identical sentences ignoring case and punctuation",
+			candidate: "Pets are not allowed.",
+			kept:      "pets are NOT allowed",
+			want:      1,
+		},
+		{
+			name:      "Success - Candidate contained in a longer kept sentence",
+			candidate: "Free parking.",
+			kept:      "Free parking on site.",
+			want:      0.5,
+		},
+		{
+			name:      "Success - Kept sentence contained in the candidate",
+			candidate: "Free parking on site.",
+			kept:      "Free parking.",
+			want:      0.5,
+		},
+		{
+			name:      "Success - Candidate adds one new word",
+			candidate: "Pets are strictly not allowed.",
+			kept:      "Pets are not allowed.",
+			want:      0.8,
+		},
+		{
+			name:      "Success - Sentences differing by a negation",
+			candidate: "Pets are not allowed in all rooms of the hotel.",
+			kept:      "Pets are allowed in all rooms of the hotel.",
+			want:      0,
+		},
+		{
+			name:      "Success - Sentences differing by another negation",
+			candidate: "Rooms come with breakfast.",
+			kept:      "Rooms come without breakfast.",
+			want:      0,
+		},
+		{
+			name:      "Success - Partially overlapping sentences",
+			candidate: "Free parking on site.",
+			kept:      "Free WiFi in all rooms.",
+			want:      0.2,
+		},
+		{
+			name:      "Success - Empty candidate",
+			candidate: "",
+			kept:      "Free WiFi.",
+			want:      1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := sentenceSimilarity(sentenceWords(tt.candidate), sentenceWords(tt.kept)); got != tt.want {
+				t.Errorf("sentenceSimilarity() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
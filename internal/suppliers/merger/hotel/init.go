@@ -12,6 +12,7 @@ type HotelBaseBuilder interface {
 	WithDestinationID(existing, new uint64) *hotelBuilder
 	WithName(existing, new string) *hotelBuilder
 	WithDescription(existing, new string) *hotelBuilder
+	WithDescriptionStrategy(strategy DescriptionStrategy) *hotelBuilder
 	WithLocation(existing, new *hotels.HotelLocation) *hotelBuilder
 	WithAmenities(existing, new *hotels.HotelAmenities) *hotelBuilder
 	WithImages(existing, new *hotels.HotelImages) *hotelBuilder
@@ -19,7 +20,8 @@ type HotelBaseBuilder interface {
 }
 
 type hotelBuilder struct {
-	hotel hotels.Hotel
+	hotel               hotels.Hotel
+	descriptionStrategy DescriptionStrategy
 }
 
 func NewHotelBuilder(existing hotels.Hotel) HotelBuilder {
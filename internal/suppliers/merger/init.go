@@ -1,21 +1,60 @@
 package merger
 
 import (
+	"context"
 	"log/slog"
+	"sync"
 
 	"hotelsDataMerge/internal/hotels"
+	mergerHotel "hotelsDataMerge/internal/suppliers/merger/hotel"
+	"hotelsDataMerge/internal/suppliers/policy"
+	"hotelsDataMerge/internal/suppliers/quality"
+	"hotelsDataMerge/internal/suppliers/taxonomy"
 )
 
 type IntMerger interface {
-	MergeHotelsData(mappedData []hotels.Hotel) (mergedHotels map[string]hotels.Hotel)
+	MergeHotelsData(ctx context.Context, mappedData []hotels.Hotel) (mergedHotels map[string]hotels.Hotel)
+	GetQualityReport() quality.Report
+}
+
+// Options configures how conflicting supplier values are merged
+type Options struct {
+	DescriptionStrategy mergerHotel.DescriptionStrategy
+	// CoordinateAgreementKm is the maximum distance between suppliers' coordinates for them
+	// to count as agreeing. Zero disables the cross-supplier check.
+	CoordinateAgreementKm float64
 }
 
 type intMerger struct {
-	logger *slog.Logger
+	logger   *slog.Logger
+	options  Options
+	taxonomy taxonomy.IntTaxonomy
+	policy   policy.IntExtractor
+
+	mu            sync.RWMutex
+	qualityReport quality.Report
+}
+
+// DefaultOptions keeps the longest description and requires suppliers' coordinates to agree within 2 km
+func DefaultOptions() Options {
+	return Options{
+		DescriptionStrategy:   mergerHotel.DescriptionLongest,
+		CoordinateAgreementKm: 2,
+	}
 }
 
-func Initialize(logger *slog.Logger) IntMerger {
+func Initialize(logger *slog.Logger, options Options) IntMerger {
 	return &intMerger{
-		logger: logger,
+		logger:   logger,
+		options:  options,
+		taxonomy: taxonomy.Default(),
+		policy:   policy.Default(),
 	}
 }
+
+// GetQualityReport returns the data-quality report of the last merge
+func (i *intMerger) GetQualityReport() quality.Report {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+	return i.qualityReport
+}
@@ -1,14 +1,22 @@
 package merger
 
 import (
+	"context"
 	"log/slog"
 	"reflect"
 	"testing"
+
+	"hotelsDataMerge/internal/hotels"
+	mergerHotel "hotelsDataMerge/internal/suppliers/merger/hotel"
+	"hotelsDataMerge/internal/suppliers/policy"
+	"hotelsDataMerge/internal/suppliers/quality"
+	"hotelsDataMerge/internal/suppliers/taxonomy"
 )
 
 func TestInitialize(t *testing.T) {
 	type args struct {
-		logger *slog.Logger
+		logger  *slog.Logger
+		options Options
 	}
 	tests := []struct {
 		name string
@@ -18,27 +26,108 @@ func TestInitialize(t *testing.T) {
 		{
 			name: "Success - Initialize with logger",
 			args: args{
-				logger: slog.Default(),
+				logger:  slog.Default(),
+				options: DefaultOptions(),
 			},
 			want: &intMerger{
-				logger: slog.Default(),
+				logger:   slog.Default(),
+				options:  Options{DescriptionStrategy: mergerHotel.DescriptionLongest, CoordinateAgreementKm: 2},
+				taxonomy: taxonomy.Default(),
+				policy:   policy.Default(),
 			},
 		},
 		{
-			name: "Success - Initialize with nil logger",
+			name: "Success - Initialize with nil logger and combined descriptions",
 			args: args{
-				logger: nil,
+				logger:  nil,
+				options: Options{DescriptionStrategy: mergerHotel.DescriptionCombine},
 			},
 			want: &intMerger{
-				logger: nil,
+				logger:   nil,
+				options:  Options{DescriptionStrategy: mergerHotel.DescriptionCombine},
+				taxonomy: taxonomy.Default(),
+				policy:   policy.Default(),
 			},
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := Initialize(tt.args.logger); !reflect.DeepEqual(got, tt.want) {
+			if got := Initialize(tt.args.logger, tt.args.options); !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("Initialize() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
+
+func Test_intMerger_GetQualityReport(t *testing.T) {
+	coordinate := func(v float64) any { return v }
+	tests := []struct {
+		name                  string
+		mappedData            []hotels.Hotel
+		wantUnmappedAmenities []string
+		wantIssues            map[quality.IssueKind]int
+	}{
+		{
+			name: "Success - Report amenities missing from taxonomy",
+			mappedData: []hotels.Hotel{
+				{
+					Id: "hotel1",
+					Amenities: &hotels.HotelAmenities{
+						General: []string{"WiFi", "Rooftop Garden"},
+						Room:    []string{"Heated Floor", "TV"},
+					},
+				},
+				{
+					Id: "hotel2",
+					Amenities: &hotels.HotelAmenities{
+						General: []string{"rooftop garden"},
+					},
+				},
+			},
+			wantUnmappedAmenities: []string{"heated floor", "rooftop garden"},
+			wantIssues:            map[quality.IssueKind]int{quality.IssueUnmappedAmenity: 3},
+		},
+		{
+			name: "Success - No issues",
+			mappedData: []hotels.Hotel{
+				{
+					Id: "hotel1",
+					Amenities: &hotels.HotelAmenities{
+						General: []string{"WiFi"},
+					},
+					Location: &hotels.HotelLocation{Lat: coordinate(1.264751), Lng: coordinate(103.824006)},
+				},
+			},
+			wantUnmappedAmenities: []string{},
+			wantIssues:            map[quality.IssueKind]int{},
+		},
+		{
+			name: "Success - Report coordinate issues",
+			mappedData: []hotels.Hotel{
+				{Id: "hotel1", Location: &hotels.HotelLocation{Lat: coordinate(0.0), Lng: coordinate(0.0)}},
+				{Id: "hotel1", Location: &hotels.HotelLocation{Lat: coordinate(1.264751), Lng: coordinate(103.824006)}},
+				{Id: "hotel2", Location: &hotels.HotelLocation{Lat: coordinate(120.0), Lng: coordinate(200.0)}},
+				{Id: "hotel3", Location: &hotels.HotelLocation{Lat: coordinate(103.824006), Lng: coordinate(1.264751)}},
+			},
+			wantUnmappedAmenities: []string{},
+			wantIssues: map[quality.IssueKind]int{
+				quality.IssueNullIsland:            1,
+				quality.IssueCoordinatesOutOfRange: 1,
+				quality.IssueSwappedCoordinates:    1,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			i := Initialize(nil, DefaultOptions())
+			i.MergeHotelsData(context.Background(), tt.mappedData)
+			got := i.GetQualityReport()
+			if !reflect.DeepEqual(got.UnmappedAmenities, tt.wantUnmappedAmenities) {
+				t.Errorf("GetQualityReport().UnmappedAmenities = %v, want %v", got.UnmappedAmenities, tt.wantUnmappedAmenities)
+			}
+			if byKind := got.CountByKind(); !reflect.DeepEqual(byKind, tt.wantIssues) {
+				t.Errorf("GetQualityReport().CountByKind() = %v, want %v", byKind, tt.wantIssues)
+			}
+		})
+	}
+}
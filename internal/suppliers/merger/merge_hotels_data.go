@@ -1,28 +1,65 @@
 package merger
 
 import (
+	"context"
+	"sort"
+	"time"
+
 	"hotelsDataMerge/internal/hotels"
 	mergerHotel "hotelsDataMerge/internal/suppliers/merger/hotel"
+	"hotelsDataMerge/internal/suppliers/quality"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
+var tracer = otel.Tracer("hotelsDataMerge/internal/suppliers/merger")
+
 // MergeHotelsData merges mergerHotel data using the Builder pattern
-func (i *intMerger) MergeHotelsData(mappedData []hotels.Hotel) map[string]hotels.Hotel {
+func (i *intMerger) MergeHotelsData(ctx context.Context, mappedData []hotels.Hotel) map[string]hotels.Hotel {
+	_, span := tracer.Start(ctx, "merger.MergeHotelsData", trace.WithAttributes(
+		attribute.Int("records", len(mappedData)),
+	))
+	defer span.End()
+
 	hotelByHotelIDMap := make(map[string]hotels.Hotel)
+	report := quality.NewReport(time.Now())
 
 	for _, hotel := range mappedData {
 		if mergedHotel, exists := hotelByHotelIDMap[hotel.Id]; !exists {
 			hotelByHotelIDMap[hotel.Id] = hotel
 		} else {
-			mergedHotel = buildMergedHotel(mergedHotel, hotel)
+			mergedHotel = i.buildMergedHotel(mergedHotel, hotel)
 			hotelByHotelIDMap[hotel.Id] = mergedHotel
 		}
 	}
 
+	i.resolveCoordinates(mappedData, hotelByHotelIDMap, report)
+	i.classifyAmenities(hotelByHotelIDMap, report)
+	i.extractBookingPolicies(hotelByHotelIDMap, report)
+
+	i.mu.Lock()
+	i.qualityReport = *report
+	i.mu.Unlock()
+	span.SetAttributes(
+		attribute.Int("hotels", len(hotelByHotelIDMap)),
+		attribute.Int("quality_issues", len(report.Issues)),
+	)
+
+	if i.logger != nil && len(report.Issues) > 0 {
+		i.logger.Warn("[Merger] Data quality issues found",
+			"issues", len(report.Issues),
+			"byKind", report.CountByKind(),
+		)
+	}
+
 	return hotelByHotelIDMap
 }
 
-func buildMergedHotel(existing, new hotels.Hotel) hotels.Hotel {
+func (i *intMerger) buildMergedHotel(existing, new hotels.Hotel) hotels.Hotel {
 	hotelBuilder := mergerHotel.NewHotelBuilder(existing)
+	hotelBuilder.WithDescriptionStrategy(i.options.DescriptionStrategy)
 
 	hotelBuilder.WithID(existing.Id, new.Id)
 	hotelBuilder.WithDestinationID(existing.DestinationId, new.DestinationId)
@@ -35,3 +72,39 @@ func buildMergedHotel(existing, new hotels.Hotel) hotels.Hotel {
 
 	return hotelBuilder.Build()
 }
+
+// classifyAmenities maps every merged hotel's amenities onto the taxonomy and
+// reports the strings that could not be mapped.
+func (i *intMerger) classifyAmenities(hotelByHotelIDMap map[string]hotels.Hotel, report *quality.Report) {
+	if i.taxonomy == nil {
+		return
+	}
+
+	unmappedSet := make(map[string]bool)
+	for hotelID, hotel := range hotelByHotelIDMap {
+		if hotel.Amenities == nil {
+			continue
+		}
+		classified, unmapped := i.taxonomy.Classify(hotel.Amenities)
+		for _, amenity := range unmapped {
+			unmappedSet[amenity] = true
+			report.Add(hotelID, quality.IssueUnmappedAmenity, "amenity %q is not in taxonomy %s", amenity, i.taxonomy.Version())
+		}
+		hotel.Amenities = classified
+		hotelByHotelIDMap[hotelID] = hotel
+	}
+
+	unmappedAmenities := make([]string, 0, len(unmappedSet))
+	for amenity := range unmappedSet {
+		unmappedAmenities = append(unmappedAmenities, amenity)
+	}
+	sort.Strings(unmappedAmenities)
+	report.UnmappedAmenities = unmappedAmenities
+
+	if i.logger != nil && len(unmappedAmenities) > 0 {
+		i.logger.Warn("[Merger] Amenities not found in taxonomy",
+			"taxonomyVersion", i.taxonomy.Version(),
+			"amenities", unmappedAmenities,
+		)
+	}
+}
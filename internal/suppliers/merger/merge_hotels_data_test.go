@@ -1,16 +1,23 @@
 package merger
 
 import (
+	"context"
 	"log/slog"
 	"reflect"
 	"testing"
 
 	"hotelsDataMerge/internal/hotels"
+	mergerHotel "hotelsDataMerge/internal/suppliers/merger/hotel"
+	"hotelsDataMerge/internal/suppliers/policy"
+	"hotelsDataMerge/internal/suppliers/taxonomy"
 )
 
 func Test_intMerger_MergeHotelsData(t *testing.T) {
 	type fields struct {
-		logger *slog.Logger
+		logger   *slog.Logger
+		options  Options
+		taxonomy taxonomy.IntTaxonomy
+		policy   policy.IntExtractor
 	}
 	type args struct {
 		mappedData []hotels.Hotel
@@ -233,6 +240,140 @@ func Test_intMerger_MergeHotelsData(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Success - Merge hotels and classify amenities with taxonomy",
+			fields: fields{
+				logger:   slog.Default(),
+				taxonomy: taxonomy.Default(),
+			},
+			args: args{
+				mappedData: []hotels.Hotel{
+					{
+						Id: "iJhz",
+						Amenities: &hotels.HotelAmenities{
+							General: []string{"Pool", "BusinessCenter", "WiFi ", "DryCleaning", " Breakfast", "Aircon"},
+						},
+					},
+					{
+						Id: "iJhz",
+						Amenities: &hotels.HotelAmenities{
+							General: []string{"outdoor pool", "business center", "childcare", "rooftop garden"},
+							Room:    []string{"aircon", "tv", "hair dryer", "tub"},
+						},
+					},
+				},
+			},
+			want: map[string]hotels.Hotel{
+				"iJhz": {
+					Id: "iJhz",
+					Amenities: &hotels.HotelAmenities{
+						General: []string{"breakfast", "business center", "childcare", "dry cleaning", "outdoor pool", "pool", "rooftop garden", "wifi"},
+						Room:    []string{"aircon", "bathtub", "hair dryer", "tv"},
+					},
+				},
+			},
+		},
+		{
+			name: "Success - Merge hotels with combined descriptions",
+			fields: fields{
+				logger:  slog.Default(),
+				options: Options{DescriptionStrategy: mergerHotel.DescriptionCombine},
+			},
+			args: args{
+				mappedData: []hotels.Hotel{
+					{
+						Id:          "hotel1",
+						Description: "Located on Sentosa. Check-in from 3 PM.",
+					},
+					{
+						Id:          "hotel1",
+						Description: "Located on sentosa. A 2-minute walk from the Waterfront station.",
+					},
+				},
+			},
+			want: map[string]hotels.Hotel{
+				"hotel1": {
+					Id:          "hotel1",
+					Description: "Located on Sentosa. Check-in from 3 PM. A 2-minute walk from the Waterfront station.",
+				},
+			},
+		},
+		{
+			name: "Success - Merge hotels and drop coordinates the suppliers disagree on",
+			fields: fields{
+				logger:  slog.Default(),
+				options: Options{CoordinateAgreementKm: 2},
+			},
+			args: args{
+				mappedData: []hotels.Hotel{
+					{
+						Id:       "hotel1",
+						Location: &hotels.HotelLocation{Lat: float64(48.8566), Lng: float64(2.3522), City: "Paris"},
+					},
+					{
+						Id:       "hotel1",
+						Location: &hotels.HotelLocation{Lat: float64(1.264751), Lng: float64(103.824006)},
+					},
+					{
+						Id:       "hotel1",
+						Location: &hotels.HotelLocation{Lat: float64(1.2647), Lng: float64(103.824)},
+					},
+					{
+						Id:       "hotel2",
+						Location: &hotels.HotelLocation{Lat: float64(0), Lng: float64(0), City: "Tokyo"},
+					},
+				},
+			},
+			want: map[string]hotels.Hotel{
+				"hotel1": {
+					Id:       "hotel1",
+					Location: &hotels.HotelLocation{Lat: float64(1.264751), Lng: float64(103.824006), City: "Paris"},
+				},
+				"hotel2": {
+					Id:       "hotel2",
+					Location: &hotels.HotelLocation{City: "Tokyo"},
+				},
+			},
+		},
+		{
+			name: "Success - Merge hotels and extract booking policy",
+			fields: fields{
+				logger: slog.Default(),
+				policy: policy.Default(),
+			},
+			args: args{
+				mappedData: []hotels.Hotel{
+					{
+						Id:                "hotel1",
+						BookingConditions: []string{"Pets are not allowed.", "Check-in from 3pm."},
+					},
+					{
+						Id: "hotel1",
+					},
+					{
+						Id:                "hotel2",
+						BookingConditions: []string{"Quiet hours after 10pm."},
+					},
+				},
+			},
+			want: map[string]hotels.Hotel{
+				"hotel1": {
+					Id:                "hotel1",
+					BookingConditions: []string{"Pets are not allowed.", "Check-in from 3pm."},
+					BookingPolicy: &hotels.BookingPolicy{
+						CheckInFrom: "15:00",
+						Pets:        hotels.PetsNotAllowed,
+					},
+				},
+				"hotel2": {
+					Id:                "hotel2",
+					BookingConditions: []string{"Quiet hours after 10pm."},
+					BookingPolicy: &hotels.BookingPolicy{
+						UnrecognizedSentences: []string{"Quiet hours after 10pm."},
+					},
+				},
+			},
+		},
 		{
 			name: "Success - Merge with nil logger",
 			fields: fields{
@@ -261,9 +402,12 @@ func Test_intMerger_MergeHotelsData(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			i := &intMerger{
-				logger: tt.fields.logger,
+				logger:   tt.fields.logger,
+				options:  tt.fields.options,
+				taxonomy: tt.fields.taxonomy,
+				policy:   tt.fields.policy,
 			}
-			if got := i.MergeHotelsData(tt.args.mappedData); !reflect.DeepEqual(got, tt.want) {
+			if got := i.MergeHotelsData(context.Background(), tt.args.mappedData); !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("MergeHotelsData() = %v, want %v", got, tt.want)
 			}
 		})
@@ -0,0 +1,86 @@
+package merger
+
+import (
+	"hotelsDataMerge/internal/hotels"
+	"hotelsDataMerge/internal/suppliers/geo"
+	"hotelsDataMerge/internal/suppliers/quality"
+)
+
+// resolveCoordinates replaces every merged hotel's coordinates with the ones the suppliers agree on.
+// Implausible coordinates are dropped or, when latitude and longitude were swapped, corrected,
+// and coordinates too far from the consensus are dropped as outliers.
+func (i *intMerger) resolveCoordinates(mappedData []hotels.Hotel, hotelByHotelIDMap map[string]hotels.Hotel, report *quality.Report) {
+	candidatesByHotelID := make(map[string][]geo.Point)
+	for _, hotel := range mappedData {
+		if hotel.Location == nil {
+			continue
+		}
+		point, ok := geo.ParsePoint(hotel.Location.Lat, hotel.Location.Lng)
+		if !ok {
+			continue
+		}
+		if point, ok = checkPlausibility(hotel.Id, point, report); ok {
+			candidatesByHotelID[hotel.Id] = append(candidatesByHotelID[hotel.Id], point)
+		}
+	}
+
+	for hotelID, hotel := range hotelByHotelIDMap {
+		if hotel.Location == nil {
+			continue
+		}
+		location := *hotel.Location
+		location.Lat, location.Lng = nil, nil
+		if point, ok := i.agreedPoint(hotelID, candidatesByHotelID[hotelID], report); ok {
+			location.Lat, location.Lng = point.Lat, point.Lng
+		}
+		hotel.Location = &location
+		hotelByHotelIDMap[hotelID] = hotel
+	}
+}
+
+// checkPlausibility rejects out-of-range and (0, 0) coordinates and corrects swapped ones
+func checkPlausibility(hotelID string, point geo.Point, report *quality.Report) (geo.Point, bool) {
+	if point.IsNullIsland() {
+		report.Add(hotelID, quality.IssueNullIsland, "dropped coordinates (%g, %g)", point.Lat, point.Lng)
+		return point, false
+	}
+	if point.InRange() {
+		return point, true
+	}
+	if point.Swapped().InRange() {
+		report.Add(hotelID, quality.IssueSwappedCoordinates, "swapped coordinates (%g, %g) to (%g, %g)", point.Lat, point.Lng, point.Lng, point.Lat)
+		return point.Swapped(), true
+	}
+	report.Add(hotelID, quality.IssueCoordinatesOutOfRange, "dropped coordinates (%g, %g)", point.Lat, point.Lng)
+	return point, false
+}
+
+// agreedPoint returns the highest-priority candidate within the largest group of agreeing candidates
+func (i *intMerger) agreedPoint(hotelID string, candidates []geo.Point, report *quality.Report) (geo.Point, bool) {
+	if len(candidates) == 0 {
+		return geo.Point{}, false
+	}
+	if i.options.CoordinateAgreementKm <= 0 {
+		return candidates[0], true
+	}
+
+	members := geo.Consensus(candidates, i.options.CoordinateAgreementKm)
+	agreed := candidates[members[0]]
+	inConsensus := make(map[int]bool)
+	for _, member := range members {
+		inConsensus[member] = true
+	}
+
+	for index, candidate := range candidates {
+		if inConsensus[index] {
+			continue
+		}
+		distance := geo.Distance(candidate, agreed)
+		if geo.Distance(candidate.Swapped(), agreed) <= i.options.CoordinateAgreementKm {
+			report.Add(hotelID, quality.IssueSwappedCoordinates, "dropped coordinates (%g, %g) that match the consensus once swapped", candidate.Lat, candidate.Lng)
+			continue
+		}
+		report.Add(hotelID, quality.IssueCoordinatesOutlier, "dropped coordinates (%g, %g), %.1f km from the consensus (%g, %g)", candidate.Lat, candidate.Lng, distance, agreed.Lat, agreed.Lng)
+	}
+	return agreed, true
+}
@@ -17,20 +17,21 @@ func (a *AcmeParser) ParseAndMapSuppliersData() ([]hotels.Hotel, error) {
 
 	mappedHotels := make([]hotels.Hotel, 0, len(parsedData))
 	for _, data := range parsedData {
+		country, city := utils.NormalizeCountryAndCity(a.TextNormalizer.Clean(data.Country), a.TextNormalizer.Clean(data.City))
 		hotel := hotels.Hotel{
 			Id:            data.Id,
 			DestinationId: data.DestinationId,
-			Name:          utils.TrimSpacesInString(data.Name),
+			Name:          a.TextNormalizer.Clean(data.Name),
 			Location: &hotels.HotelLocation{
 				Lat:     data.Latitude,
 				Lng:     data.Longitude,
-				Address: utils.TrimSpacesInString(data.Address),
-				City:    utils.TrimSpacesInString(data.City),
-				Country: utils.TrimSpacesInString(data.Country),
+				Address: a.TextNormalizer.Clean(data.Address),
+				City:    city,
+				Country: country,
 			},
-			Description: utils.TrimSpacesInString(data.Description),
+			Description: a.TextNormalizer.Clean(data.Description),
 			Amenities: &hotels.HotelAmenities{
-				General: utils.TrimSpacesInSlices(data.Facilities),
+				General: a.TextNormalizer.CleanSlice(data.Facilities),
 			},
 		}
 		mappedHotels = append(mappedHotels, hotel)
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"hotelsDataMerge/internal/hotels"
+	"hotelsDataMerge/internal/suppliers/textnorm"
 	"hotelsDataMerge/internal/suppliers/utils"
 )
 
@@ -248,9 +249,10 @@ func TestAcmeParser_ParseAndMapSuppliersData(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			a := &AcmeParser{
-				Logger:       tt.fields.Logger,
-				SupplierName: tt.fields.SupplierName,
-				RawData:      tt.fields.RawData,
+				Logger:         tt.fields.Logger,
+				SupplierName:   tt.fields.SupplierName,
+				RawData:        tt.fields.RawData,
+				TextNormalizer: textnorm.Initialize(textnorm.DefaultOptions()),
 			}
 			got, err := a.ParseAndMapSuppliersData()
 			if (err != nil) != tt.wantErr {
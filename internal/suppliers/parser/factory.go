@@ -8,11 +8,13 @@ import (
 	"hotelsDataMerge/internal/suppliers/parser/acme"
 	"hotelsDataMerge/internal/suppliers/parser/paperflies"
 	"hotelsDataMerge/internal/suppliers/parser/patagonia"
+	"hotelsDataMerge/internal/suppliers/textnorm"
 	"hotelsDataMerge/internal/suppliers/utils"
 )
 
 type DefaultParserFactory struct {
-	logger *slog.Logger
+	logger         *slog.Logger
+	textNormalizer textnorm.IntTextNormalizer
 }
 
 type ParserFactory interface {
@@ -23,21 +25,24 @@ func (f *DefaultParserFactory) CreateParser(supplierName utils.Suppliers, rawDat
 	switch supplierName {
 	case utils.Acme:
 		return &acme.AcmeParser{
-			Logger:       f.logger,
-			SupplierName: supplierName,
-			RawData:      rawData,
+			Logger:         f.logger,
+			SupplierName:   supplierName,
+			RawData:        rawData,
+			TextNormalizer: f.textNormalizer,
 		}
 	case utils.Patagonia:
 		return &patagonia.PatagoniaParser{
-			Logger:       f.logger,
-			SupplierName: supplierName,
-			RawData:      rawData,
+			Logger:         f.logger,
+			SupplierName:   supplierName,
+			RawData:        rawData,
+			TextNormalizer: f.textNormalizer,
 		}
 	case utils.Paperflies:
 		return &paperflies.PaperfliesParser{
-			Logger:       f.logger,
-			SupplierName: supplierName,
-			RawData:      rawData,
+			Logger:         f.logger,
+			SupplierName:   supplierName,
+			RawData:        rawData,
+			TextNormalizer: f.textNormalizer,
 		}
 	default:
 		return nil
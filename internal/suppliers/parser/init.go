@@ -1,25 +1,29 @@
 package parser
 
 import (
+	"context"
 	"encoding/json"
 	"log/slog"
 
 	"hotelsDataMerge/external"
 	"hotelsDataMerge/internal/hotels"
+	"hotelsDataMerge/internal/suppliers/textnorm"
 	"hotelsDataMerge/internal/suppliers/utils"
 )
 
 type IntParser interface {
-	ParseSuppliersData(resp map[utils.Suppliers]json.RawMessage) ([]hotels.Hotel, error)
+	ParseSuppliersData(ctx context.Context, resp map[utils.Suppliers]json.RawMessage) ([]hotels.Hotel, error)
 }
 
 type intParser struct {
-	logger       *slog.Logger
-	extSuppliers external.ExtSuppliers
+	logger         *slog.Logger
+	extSuppliers   external.ExtSuppliers
+	textNormalizer textnorm.IntTextNormalizer
 }
 
-func Initialize(logger *slog.Logger) IntParser {
+func Initialize(logger *slog.Logger, textNormalizer textnorm.IntTextNormalizer) IntParser {
 	return &intParser{
-		logger: logger,
+		logger:         logger,
+		textNormalizer: textNormalizer,
 	}
 }
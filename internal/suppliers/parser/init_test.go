@@ -4,11 +4,14 @@ import (
 	"log/slog"
 	"reflect"
 	"testing"
+
+	"hotelsDataMerge/internal/suppliers/textnorm"
 )
 
 func TestInitialize(t *testing.T) {
 	type args struct {
-		logger *slog.Logger
+		logger         *slog.Logger
+		textNormalizer textnorm.IntTextNormalizer
 	}
 	tests := []struct {
 		name string
@@ -18,10 +21,12 @@ func TestInitialize(t *testing.T) {
 		{
 			name: "Success - Initialize with logger",
 			args: args{
-				logger: slog.Default(),
+				logger:         slog.Default(),
+				textNormalizer: textnorm.Initialize(textnorm.DefaultOptions()),
 			},
 			want: &intParser{
-				logger: slog.Default(),
+				logger:         slog.Default(),
+				textNormalizer: textnorm.Initialize(textnorm.DefaultOptions()),
 			},
 		},
 		{
@@ -36,7 +41,7 @@ func TestInitialize(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := Initialize(tt.args.logger); !reflect.DeepEqual(got, tt.want) {
+			if got := Initialize(tt.args.logger, tt.args.textNormalizer); !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("Initialize() = %v, want %v", got, tt.want)
 			}
 		})
@@ -17,47 +17,48 @@ func (p *PaperfliesParser) ParseAndMapSuppliersData() ([]hotels.Hotel, error) {
 
 	mappedHotels := make([]hotels.Hotel, 0, len(parsedData))
 	for _, data := range parsedData {
+		country, _ := utils.NormalizeCountryAndCity(p.TextNormalizer.Clean(data.Location.Country), "")
 		hotel := hotels.Hotel{
 			Id:            data.HotelID,
 			DestinationId: data.DestinationID,
-			Name:          utils.TrimSpacesInString(data.HotelName),
+			Name:          p.TextNormalizer.Clean(data.HotelName),
 			Location: &hotels.HotelLocation{
-				Address: utils.TrimSpacesInString(data.Location.Address),
-				Country: utils.TrimSpacesInString(data.Location.Country),
+				Address: p.TextNormalizer.Clean(data.Location.Address),
+				Country: country,
 			},
-			Description: utils.TrimSpacesInString(data.Details),
+			Description: p.TextNormalizer.Clean(data.Details),
 			Amenities: &hotels.HotelAmenities{
-				General: utils.TrimSpacesInSlices(data.Amenities.General),
-				Room:    utils.TrimSpacesInSlices(data.Amenities.Room),
+				General: p.TextNormalizer.CleanSlice(data.Amenities.General),
+				Room:    p.TextNormalizer.CleanSlice(data.Amenities.Room),
 			},
 			Images: &hotels.HotelImages{
-				Rooms: mapRoomImages(data.Images.Rooms),
-				Site:  mapSiteImages(data.Images.Site),
+				Rooms: p.mapRoomImages(data.Images.Rooms),
+				Site:  p.mapSiteImages(data.Images.Site),
 			},
-			BookingConditions: utils.TrimSpacesInSlices(data.BookingConditions),
+			BookingConditions: p.TextNormalizer.CleanSlice(data.BookingConditions),
 		}
 		mappedHotels = append(mappedHotels, hotel)
 	}
 	return mappedHotels, nil
 }
 
-func mapRoomImages(roomsImageDetails []PaperfliesParsedDataImageDetails) []hotels.HotelImageDetails {
+func (p *PaperfliesParser) mapRoomImages(roomsImageDetails []PaperfliesParsedDataImageDetails) []hotels.HotelImageDetails {
 	var rooms []hotels.HotelImageDetails
 	for _, room := range roomsImageDetails {
 		rooms = append(rooms, hotels.HotelImageDetails{
 			Link:        room.Link,
-			Description: room.Caption,
+			Description: p.TextNormalizer.Clean(room.Caption),
 		})
 	}
 	return rooms
 }
 
-func mapSiteImages(siteImageDetails []PaperfliesParsedDataImageDetails) []hotels.HotelImageDetails {
+func (p *PaperfliesParser) mapSiteImages(siteImageDetails []PaperfliesParsedDataImageDetails) []hotels.HotelImageDetails {
 	var sites []hotels.HotelImageDetails
 	for _, site := range siteImageDetails {
 		sites = append(sites, hotels.HotelImageDetails{
 			Link:        site.Link,
-			Description: site.Caption,
+			Description: p.TextNormalizer.Clean(site.Caption),
 		})
 	}
 	return sites
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"hotelsDataMerge/internal/hotels"
+	"hotelsDataMerge/internal/suppliers/textnorm"
 	"hotelsDataMerge/internal/suppliers/utils"
 )
 
@@ -259,13 +260,64 @@ func TestPaperfliesParser_ParseAndMapSuppliersData(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "Success - Normalize country name to ISO code",
+			fields: fields{
+				Logger:       slog.Default(),
+				SupplierName: utils.Paperflies,
+				RawData: json.RawMessage(`[
+					{
+						"hotel_id": "iJhz",
+						"destination_id": 5432,
+						"hotel_name": "Beach Villas Singapore",
+						"location": {
+							"address": "8 Sentosa Gateway, Beach Villas, 098269",
+							"country": "Singapore"
+						},
+						"details": "",
+						"amenities": {
+							"general": [],
+							"room": []
+						},
+						"images": {
+							"rooms": [],
+							"site": []
+						},
+						"booking_conditions": []
+					}
+				]`),
+			},
+			want: []hotels.Hotel{
+				{
+					Id:            "iJhz",
+					DestinationId: 5432,
+					Name:          "Beach Villas Singapore",
+					Location: &hotels.HotelLocation{
+						Address: "8 Sentosa Gateway, Beach Villas, 098269",
+						Country: "SG",
+					},
+					Description: "",
+					Amenities: &hotels.HotelAmenities{
+						General: []string{},
+						Room:    []string{},
+					},
+					Images: &hotels.HotelImages{
+						Rooms: []hotels.HotelImageDetails{},
+						Site:  []hotels.HotelImageDetails{},
+					},
+					BookingConditions: []string{},
+				},
+			},
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			p := &PaperfliesParser{
-				Logger:       tt.fields.Logger,
-				SupplierName: tt.fields.SupplierName,
-				RawData:      tt.fields.RawData,
+				Logger:         tt.fields.Logger,
+				SupplierName:   tt.fields.SupplierName,
+				RawData:        tt.fields.RawData,
+				TextNormalizer: textnorm.Initialize(textnorm.DefaultOptions()),
 			}
 			got, err := p.ParseAndMapSuppliersData()
 			if (err != nil) != tt.wantErr {
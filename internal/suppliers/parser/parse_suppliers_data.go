@@ -1,22 +1,38 @@
 package parser
 
 import (
+	"context"
 	"encoding/json"
 
 	"hotelsDataMerge/internal/hotels"
 	"hotelsDataMerge/internal/suppliers/utils"
+	"hotelsDataMerge/internal/tracing"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
-func (i *intParser) ParseSuppliersData(resp map[utils.Suppliers]json.RawMessage) ([]hotels.Hotel, error) {
+var tracer = otel.Tracer("hotelsDataMerge/internal/suppliers/parser")
+
+func (i *intParser) ParseSuppliersData(ctx context.Context, resp map[utils.Suppliers]json.RawMessage) ([]hotels.Hotel, error) {
 	allHotels := make([]hotels.Hotel, 0)
 
-	for supplierName, rawData := range resp {
+	supplierNames := make([]utils.Suppliers, 0, len(resp))
+	for supplierName := range resp {
+		supplierNames = append(supplierNames, supplierName)
+	}
+
+	// Parse in priority order so that the merger sees the primary supplier first
+	for _, supplierName := range utils.SortByPriority(supplierNames) {
+		rawData := resp[supplierName]
 		factory := &DefaultParserFactory{
-			logger: i.logger,
+			logger:         i.logger,
+			textNormalizer: i.textNormalizer,
 		}
 		parser := factory.CreateParser(supplierName, rawData)
 		if parser != nil {
-			parsedHotels, err := parser.ParseAndMapSuppliersData()
+			parsedHotels, err := parseAndMap(ctx, supplierName, parser)
 			if err != nil {
 				return nil, err
 			}
@@ -25,3 +41,15 @@ func (i *intParser) ParseSuppliersData(resp map[utils.Suppliers]json.RawMessage)
 	}
 	return allHotels, nil
 }
+
+// parseAndMap runs one supplier's parser in its own span
+func parseAndMap(ctx context.Context, supplierName utils.Suppliers, parser ParserFactory) (parsedHotels []hotels.Hotel, err error) {
+	_, span := tracer.Start(ctx, "parser.ParseAndMapSuppliersData", trace.WithAttributes(
+		attribute.String("supplier", string(supplierName)),
+	))
+	defer func() { tracing.EndSpan(span, err) }()
+
+	parsedHotels, err = parser.ParseAndMapSuppliersData()
+	span.SetAttributes(attribute.Int("records", len(parsedHotels)))
+	return parsedHotels, err
+}
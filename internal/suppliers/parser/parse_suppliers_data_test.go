@@ -1,12 +1,14 @@
 package parser
 
 import (
+	"context"
 	"encoding/json"
 	"log/slog"
 	"testing"
 
 	"hotelsDataMerge/external"
 	"hotelsDataMerge/internal/hotels"
+	"hotelsDataMerge/internal/suppliers/textnorm"
 	"hotelsDataMerge/internal/suppliers/utils"
 )
 
@@ -231,10 +233,11 @@ func Test_intParser_ParseSuppliersData(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			i := &intParser{
-				logger:       tt.fields.logger,
-				extSuppliers: tt.fields.extSuppliers,
+				logger:         tt.fields.logger,
+				extSuppliers:   tt.fields.extSuppliers,
+				textNormalizer: textnorm.Initialize(textnorm.DefaultOptions()),
 			}
-			got, err := i.ParseSuppliersData(tt.args.resp)
+			got, err := i.ParseSuppliersData(context.Background(), tt.args.resp)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("ParseSuppliersData() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -4,13 +4,15 @@ import (
 	"encoding/json"
 	"log/slog"
 
+	"hotelsDataMerge/internal/suppliers/textnorm"
 	"hotelsDataMerge/internal/suppliers/utils"
 )
 
 type PatagoniaParser struct {
-	Logger       *slog.Logger
-	SupplierName utils.Suppliers
-	RawData      json.RawMessage
+	Logger         *slog.Logger
+	SupplierName   utils.Suppliers
+	RawData        json.RawMessage
+	TextNormalizer textnorm.IntTextNormalizer
 }
 
 type PatagoniaParsedData struct {
@@ -5,7 +5,6 @@ import (
 	"log/slog"
 
 	"hotelsDataMerge/internal/hotels"
-	"hotelsDataMerge/internal/suppliers/utils"
 )
 
 func (p *PatagoniaParser) ParseAndMapSuppliersData() ([]hotels.Hotel, error) {
@@ -20,19 +19,19 @@ func (p *PatagoniaParser) ParseAndMapSuppliersData() ([]hotels.Hotel, error) {
 		hotel := hotels.Hotel{
 			Id:            data.Id,
 			DestinationId: data.Destination,
-			Name:          utils.TrimSpacesInString(data.Name),
+			Name:          p.TextNormalizer.Clean(data.Name),
 			Location: &hotels.HotelLocation{
 				Lat:     data.Lat,
 				Lng:     data.Lng,
-				Address: utils.TrimSpacesInString(data.Address),
+				Address: p.TextNormalizer.Clean(data.Address),
 			},
-			Description: utils.TrimSpacesInString(data.Info),
+			Description: p.TextNormalizer.Clean(data.Info),
 			Amenities: &hotels.HotelAmenities{
-				General: utils.TrimSpacesInSlices(data.Amenities),
+				General: p.TextNormalizer.CleanSlice(data.Amenities),
 			},
 			Images: &hotels.HotelImages{
-				Rooms:     mapRoomImages(data.Images.Rooms),
-				Amenities: mapAmenitiesImages(data.Images.Amenities),
+				Rooms:     p.mapRoomImages(data.Images.Rooms),
+				Amenities: p.mapAmenitiesImages(data.Images.Amenities),
 			},
 		}
 		mappedHotels = append(mappedHotels, hotel)
@@ -41,23 +40,23 @@ func (p *PatagoniaParser) ParseAndMapSuppliersData() ([]hotels.Hotel, error) {
 	return mappedHotels, nil
 }
 
-func mapRoomImages(roomsImageDetails []PatagoniaParsedDataImageDetails) []hotels.HotelImageDetails {
+func (p *PatagoniaParser) mapRoomImages(roomsImageDetails []PatagoniaParsedDataImageDetails) []hotels.HotelImageDetails {
 	var rooms []hotels.HotelImageDetails
 	for _, room := range roomsImageDetails {
 		rooms = append(rooms, hotels.HotelImageDetails{
 			Link:        room.Url,
-			Description: room.Description,
+			Description: p.TextNormalizer.Clean(room.Description),
 		})
 	}
 	return rooms
 }
 
-func mapAmenitiesImages(amenitiesImageDetails []PatagoniaParsedDataImageDetails) []hotels.HotelImageDetails {
+func (p *PatagoniaParser) mapAmenitiesImages(amenitiesImageDetails []PatagoniaParsedDataImageDetails) []hotels.HotelImageDetails {
 	var amenities []hotels.HotelImageDetails
 	for _, amenity := range amenitiesImageDetails {
 		amenities = append(amenities, hotels.HotelImageDetails{
 			Link:        amenity.Url,
-			Description: amenity.Description,
+			Description: p.TextNormalizer.Clean(amenity.Description),
 		})
 	}
 	return amenities
@@ -0,0 +1,42 @@
+package policy
+
+import (
+	"strings"
+
+	"hotelsDataMerge/internal/hotels"
+	"hotelsDataMerge/internal/suppliers/textnorm"
+)
+
+// sectionSeparator is used by suppliers to join unrelated conditions into one string
+const sectionSeparator = "==="
+
+// Extract splits the booking conditions into sentences and runs every rule on each of them.
+// Sentences that no rule recognizes are kept in UnrecognizedSentences. When several sentences
+// set the same field, the later one wins.
+func (e *intExtractor) Extract(conditions []string) *hotels.BookingPolicy {
+	if len(conditions) == 0 {
+		return nil
+	}
+
+	policy := &hotels.BookingPolicy{}
+	for _, condition := range conditions {
+		for _, section := range strings.Split(condition, sectionSeparator) {
+			for _, sentence := range textnorm.SplitSentences(section) {
+				e.apply(sentence, policy)
+			}
+		}
+	}
+	return policy
+}
+
+func (e *intExtractor) apply(sentence string, policy *hotels.BookingPolicy) {
+	recognized := false
+	for _, rule := range e.rules {
+		if rule(sentence, policy) {
+			recognized = true
+		}
+	}
+	if !recognized {
+		policy.UnrecognizedSentences = append(policy.UnrecognizedSentences, sentence)
+	}
+}
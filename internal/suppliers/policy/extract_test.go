@@ -0,0 +1,115 @@
+package policy
+
+import (
+	"reflect"
+	"testing"
+
+	"hotelsDataMerge/internal/hotels"
+)
+
+// Booking conditions below are taken from the Paperflies supplier payload
+var paperfliesBeachVillas = []string{
+	"All children are welcome. One child under 12 years stays free of charge when using existing beds. One child under 2 years stays free of charge in a child's cot/crib. One child under 4 years stays free of charge when using existing beds. One older child or adult is charged SGD 82.39 per person per night in an extra bed. The maximum number of children's cots/cribs in a room is 1. There is no capacity for extra beds in the room.",
+	"Pets are not allowed.",
+	"WiFi is available in all areas and is free of charge.",
+	"Free private parking is possible on site (reservation is not needed).",
+	"Guests are required to show a photo identification and credit card upon check-in. Please note that all Special Requests are subject to availability and additional charges may apply. Payment before arrival via bank transfer is required. The property will contact you after you book to provide instructions. Please note that the full amount of the reservation is due before arrival.",
+}
+
+func boolPtr(value bool) *bool {
+	return &value
+}
+
+func Test_intExtractor_Extract(t *testing.T) {
+	tests := []struct {
+		name       string
+		conditions []string
+		want       *hotels.BookingPolicy
+	}{
+		{
+			name:       "Success - Supplier booking conditions",
+			conditions: paperfliesBeachVillas,
+			want: &hotels.BookingPolicy{
+				Pets:               hotels.PetsNotAllowed,
+				PrepaymentRequired: true,
+				PhotoIDRequired:    true,
+				CreditCardRequired: true,
+				Children: &hotels.ChildPolicy{
+					ChildrenAllowed:    boolPtr(true),
+					FreeStayUnderAge:   12,
+					MaxCribs:           1,
+					ExtraBedsAvailable: false,
+					ExtraBedCharge:     "SGD 82.39 per person per night",
+				},
+				UnrecognizedSentences: []string{
+					"WiFi is available in all areas and is free of charge.",
+					"Free private parking is possible on site (reservation is not needed).",
+					"Please note that all Special Requests are subject to availability and additional charges may apply.",
+					"The property will contact you after you book to provide instructions.",
+				},
+			},
+		},
+		{
+			name: "Success - Check-in, check-out and cancellation",
+			conditions: []string{
+				"Check-in is from 3 pm and check-out is until 11:30 a.m.",
+				"Free cancellation up to 2 days before arrival.",
+				"A damage deposit of USD 100 is required on arrival. Pets are allowed on request.",
+			},
+			want: &hotels.BookingPolicy{
+				CheckInFrom:     "15:00",
+				CheckOutUntil:   "11:30",
+				Pets:            hotels.PetsOnRequest,
+				Cancellation:    &hotels.CancellationPolicy{FreeCancellation: true, FreeCancellationHours: 48},
+				DepositRequired: true,
+			},
+		},
+		{
+			name: "Success - Sections joined with separator",
+			conditions: []string{
+				"Bag checks will be conducted prior to entry to Adventure Cove Waterpark. === Prepayment for non refundable bookings will be charged by RWS Call Centre.",
+			},
+			want: &hotels.BookingPolicy{
+				PrepaymentRequired:    true,
+				UnrecognizedSentences: []string{"Bag checks will be conducted prior to entry to Adventure Cove Waterpark."},
+			},
+		},
+		{
+			name: "Success - Non-refundable and adults only",
+			conditions: []string{
+				"This rate is non-refundable. Adults only.",
+			},
+			want: &hotels.BookingPolicy{
+				Cancellation: &hotels.CancellationPolicy{NonRefundable: true},
+				Children:     &hotels.ChildPolicy{ChildrenAllowed: boolPtr(false)},
+			},
+		},
+		{
+			name:       "Success - Extra beds do not tell whether children are allowed",
+			conditions: []string{"No extra beds."},
+			want: &hotels.BookingPolicy{
+				Children: &hotels.ChildPolicy{ExtraBedsAvailable: false},
+			},
+		},
+		{
+			name:       "Success - Cribs do not tell whether children are allowed",
+			conditions: []string{"The maximum number of cribs is 2."},
+			want: &hotels.BookingPolicy{
+				Children: &hotels.ChildPolicy{MaxCribs: 2},
+			},
+		},
+		{
+			name:       "Success - No booking conditions",
+			conditions: []string{},
+			want:       nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := Initialize()
+			if got := e.Extract(tt.conditions); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Extract() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
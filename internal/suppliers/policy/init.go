@@ -0,0 +1,37 @@
+package policy
+
+import "hotelsDataMerge/internal/hotels"
+
+var defaultExtractor = Initialize()
+
+type IntExtractor interface {
+	Extract(conditions []string) *hotels.BookingPolicy
+}
+
+// rule updates the policy from a single sentence and reports whether it recognized the sentence
+type rule func(sentence string, policy *hotels.BookingPolicy) bool
+
+type intExtractor struct {
+	rules []rule
+}
+
+// Default returns an extractor with the built-in rules, shared across callers.
+func Default() IntExtractor {
+	return defaultExtractor
+}
+
+func Initialize() IntExtractor {
+	return &intExtractor{
+		rules: []rule{
+			checkInRule,
+			checkOutRule,
+			petsRule,
+			cancellationRule,
+			depositRule,
+			prepaymentRule,
+			identificationRule,
+			childrenRule,
+			extraBedRule,
+		},
+	}
+}
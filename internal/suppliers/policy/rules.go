@@ -0,0 +1,222 @@
+package policy
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"hotelsDataMerge/internal/hotels"
+)
+
+// timePattern matches "15:00", "3.30 pm", "3pm", "noon" and "midnight", but not bare numbers
+const timePattern = `(noon|midday|midnight|\d{1,2}[:.]\d{2}(?:\s*[ap]\.?m\b\.?)?|\d{1,2}\s*[ap]\.?m\b\.?)`
+
+var (
+	checkInPattern  = regexp.MustCompile(`(?i)\bcheck[- ]?in\b[^.\d]{0,30}?` + timePattern)
+	checkOutPattern = regexp.MustCompile(`(?i)\bcheck[- ]?out\b[^.\d]{0,30}?` + timePattern)
+	clockPattern    = regexp.MustCompile(`^(\d{1,2})(?:[:.](\d{2}))?\s*(?:([ap])\.?m\.?)?$`)
+
+	petsOnRequestPattern  = regexp.MustCompile(`(?i)\bpets?\b[^.]*\bon request\b`)
+	petsNotAllowedPattern = regexp.MustCompile(`(?i)\bpets? (?:are|is) not (?:allowed|permitted|accepted)\b|\bno pets\b|\bpets? not (?:allowed|permitted|accepted)\b`)
+	petsAllowedPattern    = regexp.MustCompile(`(?i)\bpets? (?:are |is )?(?:allowed|permitted|accepted|welcome)\b`)
+
+	cancellationPattern  = regexp.MustCompile(`(?i)\bcancel`)
+	nonRefundablePattern = regexp.MustCompile(`(?i)\b(?:is|are) non[- ]?refundable\b|\bno refunds?\b|\bcannot be cancell?ed\b|\bfree cancell?ation is not (?:available|possible)\b`)
+	freeOfChargePattern  = regexp.MustCompile(`(?i)\bfree\b|\bwithout (?:charge|penalty|fee)\b|\bno (?:charge|penalty|fee)\b`)
+	windowPattern        = regexp.MustCompile(`(?i)\b(\d+)\s*(hours?|days?)\b`)
+
+	depositPattern     = regexp.MustCompile(`(?i)\bdeposits?\b`)
+	safeDepositPattern = regexp.MustCompile(`(?i)\bsafe(?:ty)? deposit\b`)
+	prepaymentPattern  = regexp.MustCompile(`(?i)\bpre-?payment\b|\bpayment (?:before arrival|in advance)\b|\bpaid in advance\b|\bfull amount\b[^.]*\bdue before arrival\b`)
+
+	photoIDPattern     = regexp.MustCompile(`(?i)\bphoto (?:identification|id)\b|\bpassport\b|\bgovernment[- ]issued (?:id|identification)\b|\bvalid id\b`)
+	creditCardPattern  = regexp.MustCompile(`(?i)\bcredit card\b`)
+	requirementPattern = regexp.MustCompile(`(?i)\brequire|\bmust\b|\bshow\b|\bpresent\b|\bneeded\b`)
+
+	childrenAllowedPattern    = regexp.MustCompile(`(?i)\bchildren (?:of all ages )?are (?:welcome|allowed)\b`)
+	childrenNotAllowedPattern = regexp.MustCompile(`(?i)\bchildren are not (?:allowed|accepted)\b|\badults only\b|\bno children\b`)
+	childFreeStayPattern      = regexp.MustCompile(`(?i)\bchild(?:ren)? (?:under|below|younger than) (\d+)(?: years?)?(?: old)? stays? free\b`)
+	maxCribsPattern           = regexp.MustCompile(`(?i)\bmaximum number of [^.]*\b(?:cots?|cribs?)\b[^.]* is (\d+)`)
+
+	extraBedChargePattern = regexp.MustCompile(`(?i)\bis charged (.+?) (?:in|for) an extra bed\b`)
+	noExtraBedPattern     = regexp.MustCompile(`(?i)\bno (?:capacity for )?extra beds\b|\bextra beds are not (?:available|possible)\b`)
+)
+
+func checkInRule(sentence string, policy *hotels.BookingPolicy) bool {
+	return setTime(checkInPattern, sentence, &policy.CheckInFrom)
+}
+
+func checkOutRule(sentence string, policy *hotels.BookingPolicy) bool {
+	return setTime(checkOutPattern, sentence, &policy.CheckOutUntil)
+}
+
+func setTime(pattern *regexp.Regexp, sentence string, field *string) bool {
+	match := pattern.FindStringSubmatch(sentence)
+	if match == nil {
+		return false
+	}
+	clock, ok := parseClock(match[1])
+	if !ok {
+		return false
+	}
+	*field = clock
+	return true
+}
+
+// parseClock converts a time of day to 24-hour "HH:MM"
+func parseClock(raw string) (string, bool) {
+	raw = strings.ToLower(strings.TrimSpace(raw))
+	switch raw {
+	case "noon", "midday":
+		return "12:00", true
+	case "midnight":
+		return "00:00", true
+	}
+
+	match := clockPattern.FindStringSubmatch(raw)
+	if match == nil {
+		return "", false
+	}
+	hour, _ := strconv.Atoi(match[1])
+	minute := 0
+	if len(match[2]) > 0 {
+		minute, _ = strconv.Atoi(match[2])
+	}
+	switch match[3] {
+	case "a", "p":
+		if hour < 1 || hour > 12 {
+			return "", false
+		}
+		hour %= 12
+		if match[3] == "p" {
+			hour += 12
+		}
+	default:
+		if hour > 23 {
+			return "", false
+		}
+	}
+	if minute > 59 {
+		return "", false
+	}
+	return fmt.Sprintf("%02d:%02d", hour, minute), true
+}
+
+func petsRule(sentence string, policy *hotels.BookingPolicy) bool {
+	switch {
+	case petsOnRequestPattern.MatchString(sentence):
+		policy.Pets = hotels.PetsOnRequest
+	case petsNotAllowedPattern.MatchString(sentence):
+		policy.Pets = hotels.PetsNotAllowed
+	case petsAllowedPattern.MatchString(sentence):
+		policy.Pets = hotels.PetsAllowed
+	default:
+		return false
+	}
+	return true
+}
+
+func cancellationRule(sentence string, policy *hotels.BookingPolicy) bool {
+	if nonRefundablePattern.MatchString(sentence) {
+		policy.Cancellation = &hotels.CancellationPolicy{NonRefundable: true}
+		return true
+	}
+	if !cancellationPattern.MatchString(sentence) || !freeOfChargePattern.MatchString(sentence) {
+		return false
+	}
+
+	cancellation := &hotels.CancellationPolicy{FreeCancellation: true}
+	if match := windowPattern.FindStringSubmatch(sentence); match != nil {
+		hours, _ := strconv.Atoi(match[1])
+		if strings.HasPrefix(strings.ToLower(match[2]), "day") {
+			hours *= 24
+		}
+		cancellation.FreeCancellationHours = hours
+	}
+	policy.Cancellation = cancellation
+	return true
+}
+
+func depositRule(sentence string, policy *hotels.BookingPolicy) bool {
+	if !depositPattern.MatchString(sentence) || safeDepositPattern.MatchString(sentence) {
+		return false
+	}
+	policy.DepositRequired = true
+	return true
+}
+
+func prepaymentRule(sentence string, policy *hotels.BookingPolicy) bool {
+	if !prepaymentPattern.MatchString(sentence) {
+		return false
+	}
+	policy.PrepaymentRequired = true
+	return true
+}
+
+func identificationRule(sentence string, policy *hotels.BookingPolicy) bool {
+	recognized := false
+	if photoIDPattern.MatchString(sentence) {
+		policy.PhotoIDRequired = true
+		recognized = true
+	}
+	if creditCardPattern.MatchString(sentence) && requirementPattern.MatchString(sentence) {
+		policy.CreditCardRequired = true
+		recognized = true
+	}
+	return recognized
+}
+
+func childrenRule(sentence string, policy *hotels.BookingPolicy) bool {
+	if childrenNotAllowedPattern.MatchString(sentence) {
+		setChildrenAllowed(policy, false)
+		return true
+	}
+
+	recognized := false
+	if childrenAllowedPattern.MatchString(sentence) {
+		setChildrenAllowed(policy, true)
+		recognized = true
+	}
+	if match := childFreeStayPattern.FindStringSubmatch(sentence); match != nil {
+		age, _ := strconv.Atoi(match[1])
+		children := setChildrenAllowed(policy, true)
+		children.FreeStayUnderAge = max(children.FreeStayUnderAge, age)
+		recognized = true
+	}
+	if match := maxCribsPattern.FindStringSubmatch(sentence); match != nil {
+		children := childPolicy(policy)
+		children.MaxCribs, _ = strconv.Atoi(match[1])
+		recognized = true
+	}
+	return recognized
+}
+
+func extraBedRule(sentence string, policy *hotels.BookingPolicy) bool {
+	if noExtraBedPattern.MatchString(sentence) {
+		childPolicy(policy).ExtraBedsAvailable = false
+		return true
+	}
+	match := extraBedChargePattern.FindStringSubmatch(sentence)
+	if match == nil {
+		return false
+	}
+	children := childPolicy(policy)
+	children.ExtraBedsAvailable = true
+	children.ExtraBedCharge = match[1]
+	return true
+}
+
+func childPolicy(policy *hotels.BookingPolicy) *hotels.ChildPolicy {
+	if policy.Children == nil {
+		policy.Children = &hotels.ChildPolicy{}
+	}
+	return policy.Children
+}
+
+// setChildrenAllowed is only called by childrenRule: a sentence about extra beds says nothing about children
+func setChildrenAllowed(policy *hotels.BookingPolicy, allowed bool) *hotels.ChildPolicy {
+	children := childPolicy(policy)
+	children.ChildrenAllowed = &allowed
+	return children
+}
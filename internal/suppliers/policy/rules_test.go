@@ -0,0 +1,59 @@
+package policy
+
+import (
+	"testing"
+
+	"hotelsDataMerge/internal/hotels"
+)
+
+func Test_parseClock(t *testing.T) {
+	tests := []struct {
+		name   string
+		raw    string
+		want   string
+		wantOk bool
+	}{
+		{name: "Success - 24-hour time", raw: "15:00", want: "15:00", wantOk: true},
+		{name: "Success - Dotted time", raw: "14.30", want: "14:30", wantOk: true},
+		{name: "Success - Afternoon", raw: "3 pm", want: "15:00", wantOk: true},
+		{name: "Success - Morning with minutes", raw: "11:30 a.m.", want: "11:30", wantOk: true},
+		{name: "Success - Midnight in 12-hour time", raw: "12am", want: "00:00", wantOk: true},
+		{name: "Success - Noon", raw: "Noon", want: "12:00", wantOk: true},
+		{name: "Error - Hour out of range", raw: "25:00", want: "", wantOk: false},
+		{name: "Error - 12-hour time out of range", raw: "13 pm", want: "", wantOk: false},
+		{name: "Error - Minutes out of range", raw: "10:75", want: "", wantOk: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := parseClock(tt.raw)
+			if got != tt.want || ok != tt.wantOk {
+				t.Errorf("parseClock() = %v, %v, want %v, %v", got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+}
+
+func Test_rules(t *testing.T) {
+	tests := []struct {
+		name     string
+		rule     rule
+		sentence string
+		want     bool
+	}{
+		{name: "Success - Check-in time", rule: checkInRule, sentence: "Check-in starts at 14:00.", want: true},
+		{name: "Success - Check-in without time", rule: checkInRule, sentence: "Please bring your ID to check-in.", want: false},
+		{name: "Success - Check-in with a count", rule: checkInRule, sentence: "Check-in requires 2 forms of ID.", want: false},
+		{name: "Success - Pets welcome", rule: petsRule, sentence: "Pets are welcome.", want: true},
+		{name: "Success - Cancellation without a waiver", rule: cancellationRule, sentence: "Cancellations are charged the first night.", want: false},
+		{name: "Success - Safe deposit box", rule: depositRule, sentence: "Rooms have a safety deposit box.", want: false},
+		{name: "Success - Credit card mention only", rule: identificationRule, sentence: "We accept credit card payments.", want: false},
+		{name: "Success - Free stay for children", rule: childrenRule, sentence: "Children below 6 years old stay free.", want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.rule(tt.sentence, &hotels.BookingPolicy{}); got != tt.want {
+				t.Errorf("rule(%q) = %v, want %v", tt.sentence, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package quality
+
+import (
+	"fmt"
+	"time"
+)
+
+type IssueKind string
+
+const (
+	IssueCoordinatesOutOfRange IssueKind = "coordinates_out_of_range"
+	IssueNullIsland            IssueKind = "null_island"
+	IssueSwappedCoordinates    IssueKind = "swapped_coordinates"
+	IssueCoordinatesOutlier    IssueKind = "coordinates_outlier"
+	IssueUnmappedAmenity       IssueKind = "unmapped_amenity"
+	IssueUnrecognizedCondition IssueKind = "unrecognized_booking_condition"
+)
+
+type Issue struct {
+	HotelID string    `json:"hotel_id"`
+	Kind    IssueKind `json:"kind"`
+	Detail  string    `json:"detail"`
+}
+
+// Report lists the data-quality problems found while merging one batch of supplier data
+type Report struct {
+	GeneratedAt       time.Time `json:"generated_at"`
+	Issues            []Issue   `json:"issues"`
+	UnmappedAmenities []string  `json:"unmapped_amenities"`
+}
+
+func NewReport(generatedAt time.Time) *Report {
+	return &Report{
+		GeneratedAt: generatedAt,
+	}
+}
+
+func (r *Report) Add(hotelID string, kind IssueKind, format string, args ...any) {
+	r.Issues = append(r.Issues, Issue{
+		HotelID: hotelID,
+		Kind:    kind,
+		Detail:  fmt.Sprintf(format, args...),
+	})
+}
+
+// CountByKind returns the number of issues of each kind
+func (r *Report) CountByKind() map[IssueKind]int {
+	counts := make(map[IssueKind]int)
+	for _, issue := range r.Issues {
+		counts[issue.Kind]++
+	}
+	return counts
+}
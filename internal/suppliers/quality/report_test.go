@@ -0,0 +1,32 @@
+package quality
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestReport_Add(t *testing.T) {
+	generatedAt := time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)
+	report := NewReport(generatedAt)
+	report.Add("iJhz", IssueNullIsland, "dropped coordinates (%g, %g)", 0.0, 0.0)
+	report.Add("iJhz", IssueUnmappedAmenity, "amenity %q is not in taxonomy %s", "rooftop garden", "2026.10.1")
+	report.Add("SjyX", IssueUnmappedAmenity, "amenity %q is not in taxonomy %s", "heated floor", "2026.10.1")
+
+	want := &Report{
+		GeneratedAt: generatedAt,
+		Issues: []Issue{
+			{HotelID: "iJhz", Kind: IssueNullIsland, Detail: "dropped coordinates (0, 0)"},
+			{HotelID: "iJhz", Kind: IssueUnmappedAmenity, Detail: `amenity "rooftop garden" is not in taxonomy 2026.10.1`},
+			{HotelID: "SjyX", Kind: IssueUnmappedAmenity, Detail: `amenity "heated floor" is not in taxonomy 2026.10.1`},
+		},
+	}
+	if !reflect.DeepEqual(report, want) {
+		t.Errorf("Add() = %v, want %v", report, want)
+	}
+
+	wantCounts := map[IssueKind]int{IssueNullIsland: 1, IssueUnmappedAmenity: 2}
+	if got := report.CountByKind(); !reflect.DeepEqual(got, wantCounts) {
+		t.Errorf("CountByKind() = %v, want %v", got, wantCounts)
+	}
+}
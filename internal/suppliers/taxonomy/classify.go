@@ -0,0 +1,69 @@
+package taxonomy
+
+import (
+	"sort"
+	"strings"
+
+	"hotelsDataMerge/internal/hotels"
+)
+
+func (t *intTaxonomy) Lookup(raw string) (Amenity, bool) {
+	key := normalizeKey(raw)
+	if len(key) == 0 {
+		return Amenity{}, false
+	}
+	amenity, ok := t.index[key]
+	return amenity, ok
+}
+
+// Classify maps every supplier amenity onto its canonical label and default category.
+// Strings that are not in the taxonomy keep the category the supplier sent them in and
+// are returned as unmapped so that they can be curated.
+func (t *intTaxonomy) Classify(amenities *hotels.HotelAmenities) (*hotels.HotelAmenities, []string) {
+	if amenities == nil {
+		return nil, nil
+	}
+
+	seen := make(map[string]bool)
+	unmappedSeen := make(map[string]bool)
+	classified := &hotels.HotelAmenities{}
+	var unmapped []string
+
+	add := func(raw string, supplierCategory Category) {
+		trimmed := strings.ToLower(strings.TrimSpace(raw))
+		if len(trimmed) == 0 {
+			return
+		}
+
+		label, category := trimmed, supplierCategory
+		if amenity, ok := t.Lookup(trimmed); ok {
+			label, category = amenity.Label, amenity.Category
+		} else if !unmappedSeen[trimmed] {
+			unmappedSeen[trimmed] = true
+			unmapped = append(unmapped, trimmed)
+		}
+
+		if seen[normalizeKey(label)] {
+			return
+		}
+		seen[normalizeKey(label)] = true
+
+		if category == CategoryRoom {
+			classified.Room = append(classified.Room, label)
+		} else {
+			classified.General = append(classified.General, label)
+		}
+	}
+
+	for _, amenity := range amenities.General {
+		add(amenity, CategoryGeneral)
+	}
+	for _, amenity := range amenities.Room {
+		add(amenity, CategoryRoom)
+	}
+
+	sort.Strings(classified.General)
+	sort.Strings(classified.Room)
+	sort.Strings(unmapped)
+	return classified, unmapped
+}
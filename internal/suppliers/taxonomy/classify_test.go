@@ -0,0 +1,101 @@
+package taxonomy
+
+import (
+	"reflect"
+	"testing"
+
+	"hotelsDataMerge/internal/hotels"
+)
+
+func Test_intTaxonomy_Lookup(t *testing.T) {
+	tests := []struct {
+		name     string
+		raw      string
+		wantCode string
+		wantOk   bool
+	}{
+		{name: "Success - Exact label", raw: "business center", wantCode: "business_center", wantOk: true},
+		{name: "Success - Concatenated label", raw: "BusinessCenter", wantCode: "business_center", wantOk: true},
+		{name: "Success - Synonym", raw: "air conditioner", wantCode: "aircon", wantOk: true},
+		{name: "Success - Synonym with padding", raw: " Tub ", wantCode: "bathtub", wantOk: true},
+		{name: "Success - Laundry is not dry cleaning", raw: "Laundry Service", wantCode: "laundry", wantOk: true},
+		{name: "Success - Dry cleaning", raw: "drycleaning", wantCode: "dry_cleaning", wantOk: true},
+		{name: "Error - Unknown amenity", raw: "rooftop garden", wantOk: false},
+		{name: "Error - Internet is not necessarily wifi", raw: "internet", wantOk: false},
+		{name: "Error - Empty string", raw: "  ", wantOk: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := Default().Lookup(tt.raw)
+			if ok != tt.wantOk {
+				t.Fatalf("Lookup() ok = %v, want %v", ok, tt.wantOk)
+			}
+			if got.Code != tt.wantCode {
+				t.Errorf("Lookup() code = %v, want %v", got.Code, tt.wantCode)
+			}
+		})
+	}
+}
+
+func Test_intTaxonomy_Classify(t *testing.T) {
+	tests := []struct {
+		name         string
+		amenities    *hotels.HotelAmenities
+		want         *hotels.HotelAmenities
+		wantUnmapped []string
+	}{
+		{
+			name:         "Success - Nil amenities",
+			amenities:    nil,
+			want:         nil,
+			wantUnmapped: nil,
+		},
+		{
+			name: "Success - Collapse near-duplicates",
+			amenities: &hotels.HotelAmenities{
+				General: []string{"businesscenter", "business center", "aircon", "wifi"},
+				Room:    []string{"air conditioner", "tv"},
+			},
+			want: &hotels.HotelAmenities{
+				General: []string{"business center", "wifi"},
+				Room:    []string{"aircon", "tv"},
+			},
+			wantUnmapped: nil,
+		},
+		{
+			name: "Success - Move amenities to their default category",
+			amenities: &hotels.HotelAmenities{
+				General: []string{"Tv", "Kettle"},
+				Room:    []string{"wifi"},
+			},
+			want: &hotels.HotelAmenities{
+				General: []string{"wifi"},
+				Room:    []string{"kettle", "tv"},
+			},
+			wantUnmapped: nil,
+		},
+		{
+			name: "Success - Keep and report unmapped amenities",
+			amenities: &hotels.HotelAmenities{
+				General: []string{"Rooftop Garden", "pool"},
+				Room:    []string{"Heated Floor", "heated floor"},
+			},
+			want: &hotels.HotelAmenities{
+				General: []string{"pool", "rooftop garden"},
+				Room:    []string{"heated floor"},
+			},
+			wantUnmapped: []string{"heated floor", "rooftop garden"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotUnmapped := Default().Classify(tt.amenities)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Classify() got = %v, want %v", got, tt.want)
+			}
+			if !reflect.DeepEqual(gotUnmapped, tt.wantUnmapped) {
+				t.Errorf("Classify() unmapped = %v, want %v", gotUnmapped, tt.wantUnmapped)
+			}
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package taxonomy
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"hotelsDataMerge/internal/hotels"
+)
+
+//go:embed amenities.json
+var amenitiesFile []byte
+
+var defaultTaxonomy = mustLoad(amenitiesFile)
+
+type Category string
+
+const (
+	CategoryGeneral Category = "general"
+	CategoryRoom    Category = "room"
+)
+
+type Amenity struct {
+	Code     string   `json:"code"`
+	Label    string   `json:"label"`
+	Category Category `json:"category"`
+	Synonyms []string `json:"synonyms"`
+}
+
+type amenitiesDocument struct {
+	Version   string    `json:"version"`
+	Amenities []Amenity `json:"amenities"`
+}
+
+type IntTaxonomy interface {
+	Version() string
+	Lookup(raw string) (Amenity, bool)
+	Classify(amenities *hotels.HotelAmenities) (classified *hotels.HotelAmenities, unmapped []string)
+}
+
+type intTaxonomy struct {
+	version string
+	index   map[string]Amenity
+}
+
+// Default returns the taxonomy embedded in the binary.
+func Default() IntTaxonomy {
+	return defaultTaxonomy
+}
+
+// Load parses a taxonomy document and indexes every code, label and synonym.
+func Load(data []byte) (IntTaxonomy, error) {
+	var doc amenitiesDocument
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	if len(doc.Version) == 0 {
+		return nil, fmt.Errorf("amenity taxonomy has no version")
+	}
+
+	index := make(map[string]Amenity)
+	for _, amenity := range doc.Amenities {
+		if len(amenity.Code) == 0 || len(amenity.Label) == 0 {
+			return nil, fmt.Errorf("amenity %q must have a code and a label", amenity.Code)
+		}
+		if amenity.Category != CategoryGeneral && amenity.Category != CategoryRoom {
+			return nil, fmt.Errorf("amenity %q has unknown category %q", amenity.Code, amenity.Category)
+		}
+		terms := append([]string{amenity.Code, amenity.Label}, amenity.Synonyms...)
+		for _, term := range terms {
+			key := normalizeKey(term)
+			if existing, ok := index[key]; ok && existing.Code != amenity.Code {
+				return nil, fmt.Errorf("term %q maps to both %q and %q", term, existing.Code, amenity.Code)
+			}
+			index[key] = amenity
+		}
+	}
+
+	return &intTaxonomy{
+		version: doc.Version,
+		index:   index,
+	}, nil
+}
+
+func mustLoad(data []byte) IntTaxonomy {
+	t, err := Load(data)
+	if err != nil {
+		panic(fmt.Sprintf("invalid embedded amenity taxonomy: %s", err))
+	}
+	return t
+}
+
+func (t *intTaxonomy) Version() string {
+	return t.version
+}
+
+// normalizeKey folds case and drops everything but letters and digits,
+// so "BusinessCenter", "business center" and "business-center" share a key.
+func normalizeKey(s string) string {
+	var sb strings.Builder
+	for _, r := range strings.ToLower(s) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			sb.WriteRune(r)
+		}
+	}
+	return sb.String()
+}
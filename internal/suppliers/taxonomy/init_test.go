@@ -0,0 +1,90 @@
+package taxonomy
+
+import (
+	"testing"
+)
+
+func TestLoad(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    []byte
+		wantErr bool
+	}{
+		{
+			name:    "Success - Load embedded taxonomy",
+			data:    amenitiesFile,
+			wantErr: false,
+		},
+		{
+			name:    "Success - Load minimal taxonomy",
+			data:    []byte(`{"version":"1","amenities":[{"code":"tv","label":"tv","category":"room"}]}`),
+			wantErr: false,
+		},
+		{
+			name:    "Error - Invalid JSON",
+			data:    []byte(`{"version":`),
+			wantErr: true,
+		},
+		{
+			name:    "Error - Missing version",
+			data:    []byte(`{"amenities":[]}`),
+			wantErr: true,
+		},
+		{
+			name:    "Error - Missing label",
+			data:    []byte(`{"version":"1","amenities":[{"code":"tv","category":"room"}]}`),
+			wantErr: true,
+		},
+		{
+			name:    "Error - Unknown category",
+			data:    []byte(`{"version":"1","amenities":[{"code":"tv","label":"tv","category":"lobby"}]}`),
+			wantErr: true,
+		},
+		{
+			name: "Error - Synonym mapped to two amenities",
+			data: []byte(`{"version":"1","amenities":[
+				{"code":"pool","label":"pool","category":"general","synonyms":["swimming pool"]},
+				{"code":"outdoor_pool","label":"outdoor pool","category":"general","synonyms":["swimming pool"]}
+			]}`),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Load(tt.data)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Load() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDefault(t *testing.T) {
+	got := Default()
+	if got == nil {
+		t.Fatal("Default() returned nil")
+	}
+	if got.Version() != "2026.10.2" {
+		t.Errorf("Default().Version() = %s, want %s", got.Version(), "2026.10.2")
+	}
+}
+
+func Test_normalizeKey(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{name: "Success - Camel case", s: "BusinessCenter", want: "businesscenter"},
+		{name: "Success - Spaces and padding", s: " business center ", want: "businesscenter"},
+		{name: "Success - Punctuation", s: "Wi-Fi", want: "wifi"},
+		{name: "Success - Empty", s: "", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := normalizeKey(tt.s); got != tt.want {
+				t.Errorf("normalizeKey() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package textnorm
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/net/html"
+	"golang.org/x/text/unicode/norm"
+)
+
+var punctuationReplacer = strings.NewReplacer(
+	"‘", "'", // left single quotation mark
+	"’", "'", // right single quotation mark
+	"‚", "'", // single low-9 quotation mark
+	"‛", "'", // single high-reversed-9 quotation mark
+	"′", "'", // prime
+	"“", `"`, // left double quotation mark
+	"”", `"`, // right double quotation mark
+	"„", `"`, // double low-9 quotation mark
+	"″", `"`, // double prime
+	"…", "...", // horizontal ellipsis
+)
+
+// blockElements are HTML elements that separate words when their tags are removed
+var blockElements = map[string]bool{
+	"address": true, "article": true, "blockquote": true, "br": true, "dd": true, "div": true,
+	"dl": true, "dt": true, "footer": true, "h1": true, "h2": true, "h3": true, "h4": true,
+	"h5": true, "h6": true, "header": true, "hr": true, "li": true, "ol": true, "p": true,
+	"section": true, "table": true, "td": true, "th": true, "tr": true, "ul": true,
+}
+
+// Clean runs the enabled steps of the pipeline and always trims surrounding whitespace.
+func (n *intTextNormalizer) Clean(s string) string {
+	if n.options.StripHTML {
+		s = stripHTML(s)
+	}
+	if n.options.UnicodeNFC {
+		s = norm.NFC.String(s)
+	}
+	if n.options.RemoveControlChars {
+		s = removeControlChars(s)
+	}
+	if n.options.FixPunctuation {
+		s = fixPunctuation(s)
+	}
+	if n.options.CollapseWhitespace {
+		s = collapseWhitespace(s)
+	}
+	return strings.TrimSpace(s)
+}
+
+func (n *intTextNormalizer) CleanSlice(s []string) []string {
+	var result []string
+	for _, str := range s {
+		result = append(result, n.Clean(str))
+	}
+	return result
+}
+
+// stripHTML converts an HTML fragment to plain text, decoding entities and
+// dropping tags as well as the content of script and style elements.
+func stripHTML(s string) string {
+	if !strings.ContainsAny(s, "<&") {
+		return s
+	}
+
+	var sb strings.Builder
+	skipDepth := 0
+	tokenizer := html.NewTokenizer(strings.NewReader(s))
+	for {
+		switch tokenizer.Next() {
+		case html.ErrorToken:
+			return sb.String()
+		case html.TextToken:
+			if skipDepth == 0 {
+				sb.Write(tokenizer.Text())
+			}
+		case html.StartTagToken, html.SelfClosingTagToken, html.EndTagToken:
+			token := tokenizer.Token()
+			if token.Data == "script" || token.Data == "style" {
+				if token.Type == html.StartTagToken {
+					skipDepth++
+				} else if token.Type == html.EndTagToken && skipDepth > 0 {
+					skipDepth--
+				}
+			}
+			if blockElements[token.Data] {
+				sb.WriteString(" ")
+			}
+		}
+	}
+}
+
+// removeControlChars drops control and invisible formatting characters such as
+// zero-width spaces and byte order marks, keeping ordinary whitespace.
+func removeControlChars(s string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) {
+			return r
+		}
+		if unicode.IsControl(r) || unicode.Is(unicode.Cf, r) {
+			return -1
+		}
+		return r
+	}, s)
+}
+
+// fixPunctuation replaces typographic quotes and ellipses with their ASCII
+// equivalents and removes spaces left in front of punctuation marks.
+func fixPunctuation(s string) string {
+	s = punctuationReplacer.Replace(s)
+
+	runes := []rune(s)
+	var sb strings.Builder
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		if r == ' ' {
+			j := i
+			for j < len(runes) && runes[j] == ' ' {
+				j++
+			}
+			if j < len(runes) && strings.ContainsRune(",.;:!?", runes[j]) {
+				i = j - 1
+				continue
+			}
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
+
+// collapseWhitespace replaces every run of Unicode whitespace, including
+// tabs, newlines and non-breaking spaces, with a single space.
+func collapseWhitespace(s string) string {
+	return strings.Join(strings.FieldsFunc(s, unicode.IsSpace), " ")
+}
@@ -0,0 +1,131 @@
+package textnorm
+
+import (
+	"reflect"
+	"testing"
+)
+
+func Test_intTextNormalizer_Clean(t *testing.T) {
+	tests := []struct {
+		name    string
+		options Options
+		s       string
+		want    string
+	}{
+		{
+			name:    "Success - Trim surrounding spaces",
+			options: DefaultOptions(),
+			s:       "  Beach Villas Singapore  ",
+			want:    "Beach Villas Singapore",
+		},
+		{
+			name:    "Success - Collapse tabs, newlines and non-breaking spaces",
+			options: DefaultOptions(),
+			s:       "Beach\tVillas\n\nSingapore\u00a0\u00a0Sentosa",
+			want:    "Beach Villas Singapore Sentosa",
+		},
+		{
+			name:    "Success - Strip tags and decode entities",
+			options: DefaultOptions(),
+			s:       "<p>Pets are <b>not</b> allowed.</p><p>Free&nbsp;parking &amp; WiFi</p>",
+			want:    "Pets are not allowed. Free parking & WiFi",
+		},
+		{
+			name:    "Success - Drop script and style content",
+			options: DefaultOptions(),
+			s:       "Hotel<script>alert(1)</script><style>p{}</style> Info",
+			want:    "Hotel Info",
+		},
+		{
+			name:    "Success - Line breaks separate words",
+			options: DefaultOptions(),
+			s:       "Check-in 3PM<br/>Check-out 12PM",
+			want:    "Check-in 3PM Check-out 12PM",
+		},
+		{
+			name:    "Success - Compose decomposed characters",
+			options: DefaultOptions(),
+			s:       "Cafe\u0301 Ko\u0308ln",
+			want:    "Caf\u00e9 K\u00f6ln",
+		},
+		{
+			name:    "Success - Remove control and zero-width characters",
+			options: DefaultOptions(),
+			s:       "\ufeffBeach\u200b Villas\u0007",
+			want:    "Beach Villas",
+		},
+		{
+			name:    "Success - Fix smart punctuation",
+			options: DefaultOptions(),
+			s:       "Asia’s “flagship” spa , open daily…",
+			want:    `Asia's "flagship" spa, open daily...`,
+		},
+		{
+			name:    "Success - Keep decimal numbers and dashes",
+			options: DefaultOptions(),
+			s:       "SGD 82.39 per night – breakfast included",
+			want:    "SGD 82.39 per night – breakfast included",
+		},
+		{
+			name:    "Success - Plain text with ampersand",
+			options: DefaultOptions(),
+			s:       "Bed & Breakfast",
+			want:    "Bed & Breakfast",
+		},
+		{
+			name:    "Success - Every step disabled only trims",
+			options: Options{},
+			s:       " <b>Asia’s</b>\tspa ",
+			want:    "<b>Asia’s</b>\tspa",
+		},
+		{
+			name: "Success - Only HTML stripping enabled",
+			options: Options{
+				StripHTML: true,
+			},
+			s:    "<b>Asia’s</b>  spa",
+			want: "Asia’s  spa",
+		},
+		{
+			name:    "Success - Empty string",
+			options: DefaultOptions(),
+			s:       "",
+			want:    "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n := Initialize(tt.options)
+			if got := n.Clean(tt.s); got != tt.want {
+				t.Errorf("Clean() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_intTextNormalizer_CleanSlice(t *testing.T) {
+	tests := []struct {
+		name string
+		s    []string
+		want []string
+	}{
+		{
+			name: "Success - Clean every string",
+			s:    []string{" WiFi ", "Business Center", "<i>Pool</i>"},
+			want: []string{"WiFi", "Business Center", "Pool"},
+		},
+		{
+			name: "Success - Nil slice",
+			s:    nil,
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n := Initialize(DefaultOptions())
+			if got := n.CleanSlice(tt.s); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CleanSlice() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package textnorm
+
+// Options switches the individual steps of the text cleanup pipeline on or off.
+// Steps run in the order the fields are declared.
+type Options struct {
+	StripHTML          bool
+	UnicodeNFC         bool
+	RemoveControlChars bool
+	FixPunctuation     bool
+	CollapseWhitespace bool
+}
+
+type IntTextNormalizer interface {
+	Clean(s string) string
+	CleanSlice(s []string) []string
+}
+
+type intTextNormalizer struct {
+	options Options
+}
+
+// DefaultOptions enables every step of the pipeline.
+func DefaultOptions() Options {
+	return Options{
+		StripHTML:          true,
+		UnicodeNFC:         true,
+		RemoveControlChars: true,
+		FixPunctuation:     true,
+		CollapseWhitespace: true,
+	}
+}
+
+func Initialize(options Options) IntTextNormalizer {
+	return &intTextNormalizer{
+		options: options,
+	}
+}
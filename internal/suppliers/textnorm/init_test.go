@@ -0,0 +1,42 @@
+package textnorm
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestInitialize(t *testing.T) {
+	tests := []struct {
+		name    string
+		options Options
+		want    IntTextNormalizer
+	}{
+		{
+			name:    "Success - Initialize with default options",
+			options: DefaultOptions(),
+			want: &intTextNormalizer{
+				options: Options{
+					StripHTML:          true,
+					UnicodeNFC:         true,
+					RemoveControlChars: true,
+					FixPunctuation:     true,
+					CollapseWhitespace: true,
+				},
+			},
+		},
+		{
+			name:    "Success - Initialize with every step disabled",
+			options: Options{},
+			want: &intTextNormalizer{
+				options: Options{},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Initialize(tt.options); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Initialize() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package textnorm
+
+import (
+	"strings"
+	"unicode"
+)
+
+var abbreviations = map[string]bool{
+	"approx": true, "dr": true, "e.g": true, "i.e": true, "jr": true, "mr": true, "mrs": true,
+	"ms": true, "mt": true, "no": true, "nos": true, "rd": true, "sr": true, "st": true, "vs": true,
+}
+
+// SplitSentences splits text after '.', '!' or '?' when the next word starts a new sentence,
+// leaving decimals, initials and common abbreviations intact.
+func SplitSentences(text string) []string {
+	runes := []rune(strings.TrimSpace(text))
+	var sentences []string
+	start := 0
+	for i := 0; i < len(runes); i++ {
+		if !strings.ContainsRune(".!?", runes[i]) {
+			continue
+		}
+		end := i + 1
+		for end < len(runes) && strings.ContainsRune(`"')]`, runes[end]) {
+			end++
+		}
+		if end < len(runes) && !unicode.IsSpace(runes[end]) {
+			continue
+		}
+		next := end
+		for next < len(runes) && unicode.IsSpace(runes[next]) {
+			next++
+		}
+		if next < len(runes) && !startsSentence(runes[next]) {
+			continue
+		}
+		if runes[i] == '.' && isAbbreviation(runes[start:i]) {
+			continue
+		}
+		sentences = appendSentence(sentences, string(runes[start:end]))
+		start = next
+		i = next - 1
+	}
+	return appendSentence(sentences, string(runes[start:]))
+}
+
+func appendSentence(sentences []string, sentence string) []string {
+	sentence = strings.TrimSpace(sentence)
+	if len(sentence) == 0 {
+		return sentences
+	}
+	return append(sentences, sentence)
+}
+
+func startsSentence(r rune) bool {
+	return unicode.IsUpper(r) || unicode.IsDigit(r) || strings.ContainsRune(`"'(`, r)
+}
+
+// isAbbreviation reports whether the last word before a full stop is an initial or a known abbreviation
+func isAbbreviation(text []rune) bool {
+	fields := strings.Fields(string(text))
+	if len(fields) == 0 {
+		return false
+	}
+	word := []rune(fields[len(fields)-1])
+	if len(word) == 1 && unicode.IsUpper(word[0]) {
+		return true
+	}
+	return abbreviations[strings.ToLower(string(word))]
+}
@@ -0,0 +1,66 @@
+package textnorm
+
+import (
+	"reflect"
+	"testing"
+)
+
+// paperfliesBeachVillas is taken from the Paperflies supplier payload
+const paperfliesBeachVillas = "Surrounded by tropical gardens, these upscale villas in elegant Colonial-style buildings are part of the Resorts World Sentosa complex and a 2-minute walk from the Waterfront train station. Featuring sundecks and pool, garden or sea views, the plush 1- to 3-bedroom villas offer free Wi-Fi and flat-screens, as well as free-standing baths, minibars, and tea and coffeemaking facilities. Upgraded villas add private pools, fridges and microwaves; some have wine cellars. A 4-bedroom unit offers a kitchen and a living room. There's 24-hour room and butler service. Amenities include posh restaurant, plus an outdoor pool, a hotel bar, and complimentary valet parking."
+
+func TestSplitSentences(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want []string
+	}{
+		{
+			name: "Success - Supplier description",
+			text: paperfliesBeachVillas,
+			want: []string{
+				"Surrounded by tropical gardens, these upscale villas in elegant Colonial-style buildings are part of the Resorts World Sentosa complex and a 2-minute walk from the Waterfront train station.",
+				"Featuring sundecks and pool, garden or sea views, the plush 1- to 3-bedroom villas offer free Wi-Fi and flat-screens, as well as free-standing baths, minibars, and tea and coffeemaking facilities.",
+				"Upgraded villas add private pools, fridges and microwaves; some have wine cellars.",
+				"A 4-bedroom unit offers a kitchen and a living room.",
+				"There's 24-hour room and butler service.",
+				"Amenities include posh restaurant, plus an outdoor pool, a hotel bar, and complimentary valet parking.",
+			},
+		},
+		{
+			name: "Success - Decimals, abbreviations and initials are not sentence ends",
+			text: "One older child is charged SGD 82.39 per night. Located on St. Andrew's Rd. near the J. Smith gallery! Open daily?",
+			want: []string{
+				"One older child is charged SGD 82.39 per night.",
+				"Located on St. Andrew's Rd. near the J. Smith gallery!",
+				"Open daily?",
+			},
+		},
+		{
+			name: "Success - Lowercase continuation is not a sentence end",
+			text: "Rooms sleep 2 adults max. and 1 child.",
+			want: []string{
+				"Rooms sleep 2 adults max. and 1 child.",
+			},
+		},
+		{
+			name: "Success - Text without final punctuation",
+			text: "Pets are not allowed. Free parking",
+			want: []string{
+				"Pets are not allowed.",
+				"Free parking",
+			},
+		},
+		{
+			name: "Success - Empty text",
+			text: "   ",
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SplitSentences(tt.text); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SplitSentences() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,6 +1,11 @@
 package utils
 
-import "strings"
+import (
+	"sort"
+	"strings"
+
+	"hotelsDataMerge/internal/suppliers/countries"
+)
 
 type Suppliers string
 
@@ -10,15 +15,44 @@ const (
 	Paperflies Suppliers = "paperflies"
 )
 
+// SupplierPriority is the order in which supplier data is merged.
+// The first supplier is the primary one, e.g. its description sentences come first.
+var SupplierPriority = []Suppliers{Paperflies, Patagonia, Acme}
+
+// SortByPriority orders suppliers as in SupplierPriority, followed by any other suppliers in alphabetical order
+func SortByPriority(suppliers []Suppliers) []Suppliers {
+	rank := make(map[Suppliers]int)
+	for i, supplier := range SupplierPriority {
+		rank[supplier] = i
+	}
+	sorted := append([]Suppliers{}, suppliers...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		rankI, okI := rank[sorted[i]]
+		rankJ, okJ := rank[sorted[j]]
+		switch {
+		case okI && okJ:
+			return rankI < rankJ
+		case okI != okJ:
+			return okI
+		default:
+			return sorted[i] < sorted[j]
+		}
+	})
+	return sorted
+}
+
 func TrimSpacesInString(s string) string {
 	return strings.Trim(s, " ")
 }
 
-func TrimSpacesInSlices(s []string) []string {
-	var result []string
-	for _, str := range s {
-		trimmed := TrimSpacesInString(str)
-		result = append(result, trimmed)
+// NormalizeCountryAndCity converts a supplier country to its ISO 3166-1 alpha-2 code
+// and the city to its canonical name within that country.
+// Countries that are not in the dataset are returned trimmed but otherwise unchanged.
+func NormalizeCountryAndCity(country, city string) (string, string) {
+	country = TrimSpacesInString(country)
+	city = TrimSpacesInString(city)
+	if code, ok := countries.Default().NormalizeCountry(country); ok {
+		country = code
 	}
-	return result
+	return country, countries.Default().NormalizeCity(country, city)
 }
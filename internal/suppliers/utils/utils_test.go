@@ -4,73 +4,6 @@ import (
 	"testing"
 )
 
-func TestTrimSpacesInSlices(t *testing.T) {
-	type args struct {
-		s []string
-	}
-	tests := []struct {
-		name string
-		args args
-		want []string
-	}{
-		{
-			name: "Success - Trim spaces from strings with leading/trailing spaces",
-			args: args{
-				s: []string{"  hello  ", " world ", "  test  "},
-			},
-			want: []string{"hello", "world", "test"},
-		},
-		{
-			name: "Success - Trim spaces from strings with no spaces",
-			args: args{
-				s: []string{"hello", "world", "test"},
-			},
-			want: []string{"hello", "world", "test"},
-		},
-		{
-			name: "Success - Trim spaces from strings with only spaces",
-			args: args{
-				s: []string{"   ", "  ", " "},
-			},
-			want: []string{"", "", ""},
-		},
-		{
-			name: "Success - Empty slice",
-			args: args{
-				s: []string{},
-			},
-			want: []string{},
-		},
-		{
-			name: "Success - Single string with spaces",
-			args: args{
-				s: []string{"  single  "},
-			},
-			want: []string{"single"},
-		},
-		{
-			name: "Success - Mixed content",
-			args: args{
-				s: []string{"  no spaces", "with spaces  ", "  both sides  ", ""},
-			},
-			want: []string{"no spaces", "with spaces", "both sides", ""},
-		},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			if got := TrimSpacesInSlices(tt.args.s); len(got) != len(tt.want) {
-				t.Errorf("TrimSpacesInSlices() length = %d, want %d", len(got), len(tt.want))
-			} else {
-				for i, str := range got {
-					if str != tt.want[i] {
-						t.Errorf("TrimSpacesInSlices()[%d] = %q, want %q", i, str, tt.want[i])
-					}
-				}
-			}
-		})
-	}
-}
-
 func TestTrimSpacesInString(t *testing.T) {
 	type args struct {
 		s string
@@ -145,3 +78,92 @@ func TestTrimSpacesInString(t *testing.T) {
 		})
 	}
 }
+
+func TestNormalizeCountryAndCity(t *testing.T) {
+	type args struct {
+		country string
+		city    string
+	}
+	tests := []struct {
+		name        string
+		args        args
+		wantCountry string
+		wantCity    string
+	}{
+		{
+			name:        "Success - Alpha-2 code and canonical city",
+			args:        args{country: "SG", city: "Singapore"},
+			wantCountry: "SG",
+			wantCity:    "Singapore",
+		},
+		{
+			name:        "Success - Country name and city alias",
+			args:        args{country: " Singapore ", city: "Singapore City"},
+			wantCountry: "SG",
+			wantCity:    "Singapore",
+		},
+		{
+			name:        "Success - Country alias",
+			args:        args{country: "UK", city: "City of London"},
+			wantCountry: "GB",
+			wantCity:    "London",
+		},
+		{
+			name:        "Success - Unknown country trimmed",
+			args:        args{country: "  Test Country  ", city: "  Test City  "},
+			wantCountry: "Test Country",
+			wantCity:    "Test City",
+		},
+		{
+			name:        "Success - Empty values",
+			args:        args{country: "", city: ""},
+			wantCountry: "",
+			wantCity:    "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotCountry, gotCity := NormalizeCountryAndCity(tt.args.country, tt.args.city)
+			if gotCountry != tt.wantCountry || gotCity != tt.wantCity {
+				t.Errorf("NormalizeCountryAndCity() = (%v, %v), want (%v, %v)", gotCountry, gotCity, tt.wantCountry, tt.wantCity)
+			}
+		})
+	}
+}
+
+func TestSortByPriority(t *testing.T) {
+	tests := []struct {
+		name      string
+		suppliers []Suppliers
+		want      []Suppliers
+	}{
+		{
+			name:      "Success - Known suppliers in priority order",
+			suppliers: []Suppliers{Acme, Paperflies, Patagonia},
+			want:      []Suppliers{Paperflies, Patagonia, Acme},
+		},
+		{
+			name:      "Success - Unknown suppliers last in alphabetical order",
+			suppliers: []Suppliers{"zeta", Acme, "beta", Paperflies},
+			want:      []Suppliers{Paperflies, Acme, "beta", "zeta"},
+		},
+		{
+			name:      "Success - Empty slice",
+			suppliers: []Suppliers{},
+			want:      []Suppliers{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := SortByPriority(tt.suppliers)
+			if len(got) != len(tt.want) {
+				t.Fatalf("SortByPriority() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("SortByPriority() = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
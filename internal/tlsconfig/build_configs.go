@@ -0,0 +1,67 @@
+package tlsconfig
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+)
+
+func (c *intCertificates) ServerConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		// Some servers, such as net/http, only check that a certificate is configured
+		GetCertificate: func(hello *tls.ClientHelloInfo) (*tls.Certificate, error) {
+			return c.loaded.Load().certificate, nil
+		},
+		// Built per connection, so that reloaded client CAs apply to new connections
+		GetConfigForClient: func(hello *tls.ClientHelloInfo) (*tls.Config, error) {
+			loaded := c.loaded.Load()
+			config := &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				Certificates: []tls.Certificate{*loaded.certificate},
+				NextProtos:   []string{"h2", "http/1.1"},
+			}
+			if loaded.clientCAs != nil {
+				config.ClientCAs = loaded.clientCAs
+				config.ClientAuth = tls.RequireAndVerifyClientCert
+				if c.options.ClientAuth == ClientAuthVerifyIfGiven {
+					config.ClientAuth = tls.VerifyClientCertIfGiven
+				}
+			}
+			return config, nil
+		},
+	}
+}
+
+func (c *intCertificates) ClientConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			return c.loaded.Load().clientCertificate, nil
+		},
+		// The root CAs can be reloaded, so the server certificate is verified in VerifyConnection
+		// against the current ones instead of against a fixed RootCAs
+		InsecureSkipVerify: true,
+		VerifyConnection: func(state tls.ConnectionState) error {
+			return verifyServer(state, c.loaded.Load().rootCAs)
+		},
+	}
+}
+
+// verifyServer does the verification tls.Config does by default: the certificate chain must lead to
+// one of the roots and the leaf must be valid for the server name
+func verifyServer(state tls.ConnectionState, rootCAs *x509.CertPool) error {
+	if len(state.PeerCertificates) == 0 {
+		return errors.New("tls: the server sent no certificate")
+	}
+	intermediates := x509.NewCertPool()
+	for _, certificate := range state.PeerCertificates[1:] {
+		intermediates.AddCert(certificate)
+	}
+	_, err := state.PeerCertificates[0].Verify(x509.VerifyOptions{
+		DNSName:       state.ServerName,
+		Roots:         rootCAs,
+		Intermediates: intermediates,
+	})
+	return err
+}
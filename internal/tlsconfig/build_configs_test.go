@@ -0,0 +1,131 @@
+package tlsconfig
+
+import (
+	"crypto/tls"
+	"io"
+	"log/slog"
+	"testing"
+)
+
+// handshake serves one connection with the server config and returns the error seen by the client
+func handshake(t *testing.T, serverConfig *tls.Config, clientConfig *tls.Config, serverName string) error {
+	t.Helper()
+	lis, err := tls.Listen("tcp", "127.0.0.1:0", serverConfig)
+	if err != nil {
+		t.Fatalf("Listen() error = %v", err)
+	}
+	defer lis.Close()
+	go func() {
+		conn, err := lis.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		if err := conn.(*tls.Conn).Handshake(); err == nil {
+			_, _ = conn.Write([]byte("ok"))
+		}
+	}()
+
+	clientConfig = clientConfig.Clone()
+	clientConfig.ServerName = serverName
+	conn, err := tls.Dial("tcp", lis.Addr().String(), clientConfig)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	// With TLS 1.3, a rejected client certificate is only reported on the first read
+	_, err = io.ReadAll(conn)
+	return err
+}
+
+func Test_intCertificates_Handshake(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCA(t, dir, "ca")
+	otherCA := newTestCA(t, dir, "other-ca")
+	serverCert, serverKey := ca.issue(t, dir, "server", 2)
+	clientCert, clientKey := ca.issue(t, dir, "client", 3)
+	otherClientCert, otherClientKey := otherCA.issue(t, dir, "other-client", 4)
+
+	tests := []struct {
+		name       string
+		server     Options
+		client     Options
+		serverName string
+		wantErr    bool
+	}{
+		{
+			name:       "Success - TLS",
+			server:     Options{CertFile: serverCert, KeyFile: serverKey},
+			client:     Options{CertFile: clientCert, KeyFile: clientKey, RootCAFile: ca.certFile},
+			serverName: "localhost",
+			wantErr:    false,
+		},
+		{
+			name:       "Success - Mutual TLS",
+			server:     Options{CertFile: serverCert, KeyFile: serverKey, ClientCAFile: ca.certFile},
+			client:     Options{CertFile: clientCert, KeyFile: clientKey, RootCAFile: ca.certFile},
+			serverName: "127.0.0.1",
+			wantErr:    false,
+		},
+		{
+			name:       "Success - Client certificate verified if given",
+			server:     Options{CertFile: serverCert, KeyFile: serverKey, ClientCAFile: otherCA.certFile, ClientAuth: ClientAuthVerifyIfGiven},
+			client:     Options{CertFile: otherClientCert, KeyFile: otherClientKey, RootCAFile: ca.certFile},
+			serverName: "localhost",
+			wantErr:    false,
+		},
+		{
+			name:       "Error - Client certificate from another CA",
+			server:     Options{CertFile: serverCert, KeyFile: serverKey, ClientCAFile: ca.certFile},
+			client:     Options{CertFile: otherClientCert, KeyFile: otherClientKey, RootCAFile: ca.certFile},
+			serverName: "localhost",
+			wantErr:    true,
+		},
+		{
+			name:       "Error - Server certificate from another CA",
+			server:     Options{CertFile: serverCert, KeyFile: serverKey},
+			client:     Options{CertFile: clientCert, KeyFile: clientKey, RootCAFile: otherCA.certFile},
+			serverName: "localhost",
+			wantErr:    true,
+		},
+		{
+			name:       "Error - Server name not in the certificate",
+			server:     Options{CertFile: serverCert, KeyFile: serverKey},
+			client:     Options{CertFile: clientCert, KeyFile: clientKey, RootCAFile: ca.certFile},
+			serverName: "hotels.example.com",
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, err := Initialize(slog.Default(), tt.server)
+			if err != nil {
+				t.Fatalf("Initialize() server error = %v", err)
+			}
+			client, err := Initialize(slog.Default(), tt.client)
+			if err != nil {
+				t.Fatalf("Initialize() client error = %v", err)
+			}
+			err = handshake(t, server.ServerConfig(), client.ClientConfig(), tt.serverName)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("handshake() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_intCertificates_ServerConfig_ClientCertificateRequired(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCA(t, dir, "ca")
+	serverCert, serverKey := ca.issue(t, dir, "server", 2)
+	server, err := Initialize(slog.Default(), Options{CertFile: serverCert, KeyFile: serverKey, ClientCAFile: ca.certFile})
+	if err != nil {
+		t.Fatalf("Initialize() error = %v", err)
+	}
+	clientConfig := server.ClientConfig()
+	clientConfig.GetClientCertificate = nil
+
+	if err := handshake(t, server.ServerConfig(), clientConfig, "localhost"); err == nil {
+		t.Error("handshake() without a client certificate error = nil, want an error")
+	}
+}
@@ -0,0 +1,99 @@
+package tlsconfig
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync/atomic"
+	"time"
+)
+
+type ClientAuth string
+
+const (
+	// ClientAuthRequire rejects clients without a certificate signed by the client CA
+	ClientAuthRequire ClientAuth = "require"
+	// ClientAuthVerifyIfGiven accepts clients without a certificate, but verifies the certificates that are sent
+	ClientAuthVerifyIfGiven ClientAuth = "verify_if_given"
+)
+
+var ErrInvalidOptions = errors.New("invalid TLS options")
+
+type Options struct {
+	// CertFile and KeyFile are the PEM certificate chain and private key served by the listeners
+	CertFile string
+	KeyFile  string
+	// ClientCAFile is the PEM bundle that client certificates must chain to; it enables mutual TLS
+	ClientCAFile string
+	// ClientAuth is how client certificates are checked when ClientCAFile is set, ClientAuthRequire by default
+	ClientAuth ClientAuth
+	// RootCAFile is the PEM bundle the server certificate is verified against when dialing; CertFile by default
+	RootCAFile string
+	// ClientCertFile and ClientKeyFile are presented when the server asks for a client certificate;
+	// CertFile and KeyFile by default
+	ClientCertFile string
+	ClientKeyFile  string
+}
+
+type IntCertificates interface {
+	// ServerConfig returns the config of the listeners, which always serves the last loaded files
+	ServerConfig() *tls.Config
+	// ClientConfig returns the config used to dial the listeners, which always uses the last loaded files
+	ClientConfig() *tls.Config
+	// Reload reads every file again; on error, the previously loaded files stay in use
+	Reload() error
+	// Watch reloads the files whenever one of them is modified, checking every interval until ctx is done
+	Watch(ctx context.Context, interval time.Duration)
+}
+
+type intCertificates struct {
+	logger  *slog.Logger
+	options Options
+
+	loaded atomic.Pointer[material]
+	// modTimes holds the modification time of each file when it was last loaded, and is only used by Watch
+	modTimes map[string]time.Time
+}
+
+// material is everything read from the files, swapped as a whole on reload
+type material struct {
+	certificate       *tls.Certificate
+	clientCertificate *tls.Certificate
+	clientCAs         *x509.CertPool
+	rootCAs           *x509.CertPool
+}
+
+func Initialize(logger *slog.Logger, options Options) (IntCertificates, error) {
+	if len(options.CertFile) == 0 || len(options.KeyFile) == 0 {
+		return nil, fmt.Errorf("%w: a certificate and a key file are required", ErrInvalidOptions)
+	}
+	switch options.ClientAuth {
+	case "":
+		options.ClientAuth = ClientAuthRequire
+	case ClientAuthRequire, ClientAuthVerifyIfGiven:
+	default:
+		return nil, fmt.Errorf("%w: unknown client auth %q", ErrInvalidOptions, options.ClientAuth)
+	}
+	if (len(options.ClientCertFile) == 0) != (len(options.ClientKeyFile) == 0) {
+		return nil, fmt.Errorf("%w: the client certificate and key files must be set together", ErrInvalidOptions)
+	}
+	if len(options.ClientCertFile) == 0 {
+		options.ClientCertFile, options.ClientKeyFile = options.CertFile, options.KeyFile
+	}
+	if len(options.RootCAFile) == 0 {
+		options.RootCAFile = options.CertFile
+	}
+
+	c := &intCertificates{
+		logger:  logger,
+		options: options,
+	}
+	c.modTimes = c.statFiles()
+	if err := c.Reload(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
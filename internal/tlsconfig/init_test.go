@@ -0,0 +1,168 @@
+package tlsconfig
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"log/slog"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// testCA is a self-signed CA that issues the certificates of a test
+type testCA struct {
+	certificate *x509.Certificate
+	key         *ecdsa.PrivateKey
+	certFile    string
+}
+
+func newTestCA(t *testing.T, dir string, name string) *testCA {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("GenerateKey() error = %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("CreateCertificate() error = %v", err)
+	}
+	certificate, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("ParseCertificate() error = %v", err)
+	}
+	certFile := filepath.Join(dir, name+".crt")
+	writePEM(t, certFile, "CERTIFICATE", der)
+	return &testCA{certificate: certificate, key: key, certFile: certFile}
+}
+
+// issue writes a certificate for localhost and 127.0.0.1 signed by the CA, and its key
+func (ca *testCA) issue(t *testing.T, dir string, name string, serial int64) (certFile string, keyFile string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("GenerateKey() error = %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: name},
+		DNSNames:     []string{"localhost"},
+		IPAddresses:  []net.IP{net.IPv4(127, 0, 0, 1)},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.certificate, &key.PublicKey, ca.key)
+	if err != nil {
+		t.Fatalf("CreateCertificate() error = %v", err)
+	}
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatalf("MarshalPKCS8PrivateKey() error = %v", err)
+	}
+	certFile, keyFile = filepath.Join(dir, name+".crt"), filepath.Join(dir, name+".key")
+	writePEM(t, certFile, "CERTIFICATE", der)
+	writePEM(t, keyFile, "PRIVATE KEY", keyDER)
+	return certFile, keyFile
+}
+
+func writePEM(t *testing.T, path string, blockType string, der []byte) {
+	t.Helper()
+	data := pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der})
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+}
+
+func TestInitialize(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCA(t, dir, "ca")
+	serverCert, serverKey := ca.issue(t, dir, "server", 2)
+	clientCert, clientKey := ca.issue(t, dir, "client", 3)
+	notPEM := filepath.Join(dir, "not.pem")
+	if err := os.WriteFile(notPEM, []byte("not a certificate"), 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		options     Options
+		wantErr     bool
+		wantInvalid bool
+	}{
+		{
+			name:    "Success - Server certificate only",
+			options: Options{CertFile: serverCert, KeyFile: serverKey, RootCAFile: ca.certFile},
+			wantErr: false,
+		},
+		{
+			name: "Success - Mutual TLS",
+			options: Options{
+				CertFile:       serverCert,
+				KeyFile:        serverKey,
+				ClientCAFile:   ca.certFile,
+				ClientAuth:     ClientAuthVerifyIfGiven,
+				RootCAFile:     ca.certFile,
+				ClientCertFile: clientCert,
+				ClientKeyFile:  clientKey,
+			},
+			wantErr: false,
+		},
+		{
+			name:        "Error - Missing key file",
+			options:     Options{CertFile: serverCert},
+			wantErr:     true,
+			wantInvalid: true,
+		},
+		{
+			name:        "Error - Unknown client auth",
+			options:     Options{CertFile: serverCert, KeyFile: serverKey, ClientAuth: "optional"},
+			wantErr:     true,
+			wantInvalid: true,
+		},
+		{
+			name:        "Error - Client certificate without key",
+			options:     Options{CertFile: serverCert, KeyFile: serverKey, ClientCertFile: clientCert},
+			wantErr:     true,
+			wantInvalid: true,
+		},
+		{
+			name:    "Error - Key does not match the certificate",
+			options: Options{CertFile: serverCert, KeyFile: clientKey},
+			wantErr: true,
+		},
+		{
+			name:    "Error - Client CA file without certificates",
+			options: Options{CertFile: serverCert, KeyFile: serverKey, ClientCAFile: notPEM},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Initialize(slog.Default(), tt.options)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Initialize() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if errors.Is(err, ErrInvalidOptions) != tt.wantInvalid {
+				t.Errorf("Initialize() error = %v, want ErrInvalidOptions %v", err, tt.wantInvalid)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package tlsconfig
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"maps"
+	"os"
+	"time"
+)
+
+func (c *intCertificates) Reload() error {
+	certificate, err := tls.LoadX509KeyPair(c.options.CertFile, c.options.KeyFile)
+	if err != nil {
+		return fmt.Errorf("failed to load the certificate: %w", err)
+	}
+	clientCertificate, err := tls.LoadX509KeyPair(c.options.ClientCertFile, c.options.ClientKeyFile)
+	if err != nil {
+		return fmt.Errorf("failed to load the client certificate: %w", err)
+	}
+	rootCAs, err := loadCertPool(c.options.RootCAFile)
+	if err != nil {
+		return fmt.Errorf("failed to load the root CAs: %w", err)
+	}
+	var clientCAs *x509.CertPool
+	if len(c.options.ClientCAFile) > 0 {
+		if clientCAs, err = loadCertPool(c.options.ClientCAFile); err != nil {
+			return fmt.Errorf("failed to load the client CAs: %w", err)
+		}
+	}
+
+	c.loaded.Store(&material{
+		certificate:       &certificate,
+		clientCertificate: &clientCertificate,
+		clientCAs:         clientCAs,
+		rootCAs:           rootCAs,
+	})
+	return nil
+}
+
+func (c *intCertificates) Watch(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		modTimes := c.statFiles()
+		if maps.Equal(modTimes, c.modTimes) {
+			continue
+		}
+		// Recorded even when the reload fails, so that a broken file is reported once and retried on its next change
+		c.modTimes = modTimes
+		if err := c.Reload(); err != nil {
+			c.logger.Error("[TLS] Failed to reload certificates, keeping the previous ones", "error", err)
+			continue
+		}
+		c.logger.Info("[TLS] Certificates reloaded")
+	}
+}
+
+// statFiles returns the modification time of every file; files that cannot be read have a zero time
+func (c *intCertificates) statFiles() map[string]time.Time {
+	modTimes := make(map[string]time.Time)
+	for _, path := range []string{
+		c.options.CertFile, c.options.KeyFile,
+		c.options.ClientCertFile, c.options.ClientKeyFile,
+		c.options.RootCAFile, c.options.ClientCAFile,
+	} {
+		if len(path) == 0 {
+			continue
+		}
+		var modTime time.Time
+		if info, err := os.Stat(path); err == nil {
+			modTime = info.ModTime()
+		}
+		modTimes[path] = modTime
+	}
+	return modTimes
+}
+
+func loadCertPool(path string) (*x509.CertPool, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(data) {
+		return nil, fmt.Errorf("no PEM certificate in %s", path)
+	}
+	return pool, nil
+}
@@ -0,0 +1,58 @@
+package tlsconfig
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+)
+
+func currentSerial(t *testing.T, c IntCertificates) int64 {
+	t.Helper()
+	certificate, err := c.ServerConfig().GetCertificate(nil)
+	if err != nil {
+		t.Fatalf("GetCertificate() error = %v", err)
+	}
+	return certificate.Leaf.SerialNumber.Int64()
+}
+
+func Test_intCertificates_Watch(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCA(t, dir, "ca")
+	certFile, keyFile := ca.issue(t, dir, "server", 2)
+	c, err := Initialize(slog.Default(), Options{CertFile: certFile, KeyFile: keyFile})
+	if err != nil {
+		t.Fatalf("Initialize() error = %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go c.Watch(ctx, 10*time.Millisecond)
+
+	waitForSerial := func(want int64) {
+		t.Helper()
+		deadline := time.Now().Add(5 * time.Second)
+		for currentSerial(t, c) != want {
+			if time.Now().After(deadline) {
+				t.Fatalf("certificate serial = %v, want %v", currentSerial(t, c), want)
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+
+	// A renewed certificate is picked up
+	ca.issue(t, dir, "server", 3)
+	waitForSerial(3)
+
+	// A broken file keeps the previous certificate until it is fixed
+	if err := os.WriteFile(keyFile, []byte("truncated"), 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	time.Sleep(50 * time.Millisecond)
+	if got := currentSerial(t, c); got != 3 {
+		t.Errorf("certificate serial after a broken key = %v, want 3", got)
+	}
+	ca.issue(t, dir, "server", 4)
+	waitForSerial(4)
+}
@@ -0,0 +1,67 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.34.0"
+)
+
+type Exporter string
+
+const (
+	// ExporterOTLP sends spans to an OTLP/gRPC collector, configured with the standard
+	// OTEL_EXPORTER_OTLP_* environment variables (localhost:4317 by default)
+	ExporterOTLP Exporter = "otlp"
+	// ExporterStdout writes spans to stdout as JSON, to check them locally without a collector
+	ExporterStdout Exporter = "stdout"
+	// ExporterNone records no spans, but still propagates incoming trace context
+	ExporterNone Exporter = "none"
+)
+
+type Options struct {
+	Exporter    Exporter
+	ServiceName string
+}
+
+// Shutdown flushes the spans that have not been exported yet
+type Shutdown func(ctx context.Context) error
+
+// Initialize installs the global tracer provider and the W3C trace context propagator.
+// The returned Shutdown must be called before the process exits.
+func Initialize(ctx context.Context, options Options) (Shutdown, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	var exporter sdktrace.SpanExporter
+	var err error
+	switch options.Exporter {
+	case ExporterNone, "":
+		return func(context.Context) error { return nil }, nil
+	case ExporterOTLP:
+		exporter, err = otlptracegrpc.New(ctx)
+	case ExporterStdout:
+		exporter, err = stdouttrace.New(stdouttrace.WithWriter(os.Stdout))
+	default:
+		return nil, fmt.Errorf("unknown traces exporter %q, expected one of otlp, stdout or none", options.Exporter)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create %s traces exporter: %w", options.Exporter, err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewSchemaless(semconv.ServiceName(options.ServiceName))),
+	)
+	otel.SetTracerProvider(provider)
+	return provider.Shutdown, nil
+}
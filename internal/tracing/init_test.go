@@ -0,0 +1,96 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestInitialize(t *testing.T) {
+	tests := []struct {
+		name    string
+		options Options
+		wantErr bool
+	}{
+		{
+			name:    "Success - No exporter",
+			options: Options{Exporter: ExporterNone, ServiceName: "test"},
+			wantErr: false,
+		},
+		{
+			name:    "Success - Exporter not set",
+			options: Options{ServiceName: "test"},
+			wantErr: false,
+		},
+		{
+			name:    "Success - Stdout exporter",
+			options: Options{Exporter: ExporterStdout, ServiceName: "test"},
+			wantErr: false,
+		},
+		{
+			name:    "Error - Unknown exporter",
+			options: Options{Exporter: "jaeger", ServiceName: "test"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			shutdown, err := Initialize(context.Background(), tt.options)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Initialize() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if err := shutdown(context.Background()); err != nil {
+				t.Errorf("Shutdown() error = %v", err)
+			}
+		})
+	}
+}
+
+func TestEndSpan(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus codes.Code
+		wantEvents int
+	}{
+		{
+			name:       "Success - Span without error",
+			err:        nil,
+			wantStatus: codes.Unset,
+			wantEvents: 0,
+		},
+		{
+			name:       "Success - Span with error",
+			err:        errors.New("connection refused"),
+			wantStatus: codes.Error,
+			wantEvents: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := tracetest.NewSpanRecorder()
+			provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+			_, span := provider.Tracer("test").Start(context.Background(), "span")
+
+			EndSpan(span, tt.err)
+
+			ended := recorder.Ended()
+			if len(ended) != 1 {
+				t.Fatalf("EndSpan() ended %d spans, want 1", len(ended))
+			}
+			if got := ended[0].Status().Code; got != tt.wantStatus {
+				t.Errorf("EndSpan() status = %v, want %v", got, tt.wantStatus)
+			}
+			if got := len(ended[0].Events()); got != tt.wantEvents {
+				t.Errorf("EndSpan() events = %v, want %v", got, tt.wantEvents)
+			}
+		})
+	}
+}
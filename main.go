@@ -2,8 +2,9 @@ package main
 
 import (
 	"context"
+	"errors"
+	"flag"
 	"fmt"
-	"log"
 	"log/slog"
 	"net"
 	"net/http"
@@ -11,94 +12,384 @@ import (
 	"time"
 
 	"hotelsDataMerge/external"
-	"hotelsDataMerge/internal/hotels"
+	"hotelsDataMerge/internal/auth"
+	"hotelsDataMerge/internal/cli"
+	"hotelsDataMerge/internal/config"
+	"hotelsDataMerge/internal/health"
+	"hotelsDataMerge/internal/lifecycle"
+	"hotelsDataMerge/internal/metrics"
+	"hotelsDataMerge/internal/openapi"
+	"hotelsDataMerge/internal/pipeline"
+	"hotelsDataMerge/internal/ratelimit"
 	"hotelsDataMerge/internal/suppliers"
+	"hotelsDataMerge/internal/suppliers/utils"
+	"hotelsDataMerge/internal/tlsconfig"
+	"hotelsDataMerge/internal/tracing"
 	"hotelsDataMerge/proto"
 	"hotelsDataMerge/server"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 )
 
-const fetchSuppliersPeriod = time.Second * 5
+const (
+	serviceName = "hotelsDataMerge"
+	// healthUpdateInterval is how often the gRPC health status is recomputed from the readiness
+	healthUpdateInterval = 2 * time.Second
+)
 
 func main() {
-	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
-	extSuppliers := external.Initialize(logger)
-	intSuppliers := suppliers.Initialize(logger, extSuppliers)
-
-	go func() {
-		processSuppliersData(intSuppliers, logger)
-		time.Sleep(fetchSuppliersPeriod)
-	}()
-
-	svc := server.NewHotelsDataMergeService(logger)
-	setupServer(svc, logger)
-	setupGrpcGateway(logger)
+	os.Exit(run(os.Args[1:]))
+}
+
+// run starts the application and blocks until it has shut down, returning the process exit code.
+// The first argument may name a command: serve, the default, or one of the offline commands of the cli package.
+func run(args []string) int {
+	if len(args) > 0 && cli.IsCommand(args[0]) {
+		return cli.Initialize(os.Stdout, os.Stderr, os.LookupEnv).Run(context.Background(), args)
+	}
+	if len(args) > 0 && args[0] == "serve" {
+		args = args[1:]
+	}
+	loaded, err := config.Load(args, os.LookupEnv)
+	if errors.Is(err, flag.ErrHelp) {
+		fmt.Fprintln(os.Stdout, "Usage: hotelsDataMerge [command] [flags]")
+		cli.Usage(os.Stdout)
+		fmt.Fprintln(os.Stdout, "Flags of serve:")
+		config.Usage(os.Stdout)
+		return lifecycle.ExitOK
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Invalid configuration:", err)
+		return lifecycle.ExitConfig
+	}
+	cfg := loaded.Config
+	if loaded.PrintOnly {
+		if err := cfg.Print(os.Stdout); err != nil {
+			fmt.Fprintln(os.Stderr, "Failed to print config:", err)
+			return lifecycle.ExitFailure
+		}
+		return lifecycle.ExitOK
+	}
+
+	logger := newLogger(cfg.Log)
+	logger.Info("[Config] Configuration loaded", "file", loaded.File)
+	app, err := setupApp(cfg, logger)
+	if err != nil {
+		logger.Error("Failed to start", "error", err)
+		return lifecycle.ExitFailure
+	}
+	return lifecycle.ExitCode(app.Run(context.Background()))
+}
+
+// setupApp builds every component of the application. On shutdown, the gRPC health checks report
+// NOT_SERVING first, then the gateway stops accepting requests, then the gRPC servers drain, then the
+// refresh scheduler and any refresh in progress are cancelled, and finally the pending spans are flushed.
+func setupApp(cfg config.Config, logger *slog.Logger) (lifecycle.IntLifecycle, error) {
+	shutdownTracing, err := tracing.Initialize(context.Background(), tracing.Options{
+		Exporter:    tracing.Exporter(cfg.Tracing.Exporter),
+		ServiceName: serviceName,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize tracing: %w", err)
+	}
+	app := lifecycle.Initialize(logger, cfg.Server.ShutdownTimeout)
+
+	appMetrics := metrics.Initialize()
+	suppliersURLMap := external.SuppliersURLMap(cfg.Suppliers.SupplierURLs())
+	extSuppliers := external.Initialize(logger, appMetrics, external.Options{
+		SupplierURLs: suppliersURLMap,
+		Timeout:      cfg.Suppliers.Timeout,
+	})
+	extSuppliers, recorder, err := cfg.Archive.SetupSuppliers(extSuppliers, logger)
+	if err != nil {
+		return nil, err
+	}
+	intSuppliers := suppliers.Initialize(logger, extSuppliers, recorder, cfg.SuppliersOptions())
+	intPipeline := pipeline.Initialize(logger, intSuppliers, appMetrics)
+
+	rateLimitConfig, limiter, err := setupRateLimiter(cfg.RateLimit)
+	if err != nil {
+		return nil, err
+	}
+	authenticator, err := setupAuthenticator(cfg.Auth, logger)
+	if err != nil {
+		return nil, err
+	}
+	certificates, err := setupTLS(cfg.TLS, logger)
+	if err != nil {
+		return nil, err
+	}
+	options := serverOptions(certificates, authenticator, limiter, appMetrics, accessLogOptions(cfg.Log.Access), logger)
+
+	app.Add(lifecycle.Component{
+		Name: "refresh",
+		Run: func(ctx context.Context) error {
+			if _, err := intPipeline.TriggerRefresh(ctx, pipeline.TriggerStartup, nil, true); err != nil {
+				logger.Error("Failed to refresh suppliers data", "error", err)
+			}
+			intPipeline.RunScheduler(ctx, cfg.Refresh.Interval)
+			return nil
+		},
+	})
+	intHealth := health.Initialize(intPipeline, healthConfig(cfg.Health))
+	healthServer := grpchealth.NewServer()
+	svc := server.NewHotelsDataMergeService(logger, rateLimitConfig.MaxHotelIDs)
+	if err := setupServer(app, svc, healthServer, cfg, options, logger); err != nil {
+		return nil, err
+	}
+	if err := setupAdminServer(app, server.NewAdminService(logger, intPipeline, suppliersURLMap), healthServer, cfg, options, logger); err != nil {
+		return nil, err
+	}
+	if err := setupGrpcGateway(app, intHealth, certificates, cfg, appMetrics, logger); err != nil {
+		return nil, err
+	}
+	if certificates != nil && cfg.TLS.ReloadInterval > 0 {
+		app.Add(lifecycle.Component{
+			Name: "tls",
+			Run: func(ctx context.Context) error {
+				certificates.Watch(ctx, cfg.TLS.ReloadInterval)
+				return nil
+			},
+		})
+	}
+	// Added last so that it is stopped first, letting load balancers drain the servers
+	app.Add(lifecycle.Component{
+		Name: "health",
+		Run: func(ctx context.Context) error {
+			services := []string{"", proto.HotelDataMerge_ServiceDesc.ServiceName}
+			intHealth.UpdateGRPCStatus(ctx, healthServer, services, healthUpdateInterval)
+			return nil
+		},
+		Stop: func(ctx context.Context) error {
+			healthServer.Shutdown()
+			return nil
+		},
+	})
+
+	app.OnShutdown(lifecycle.Hook{Name: "pipeline", Run: intPipeline.Shutdown})
+	app.OnShutdown(lifecycle.Hook{Name: "tracing", Run: shutdownTracing})
+	return app, nil
+}
+
+// newLogger returns the application logger, which adds the request ID to every line logged while serving a call
+func newLogger(logConfig config.LogConfig) *slog.Logger {
+	options := &slog.HandlerOptions{Level: logConfig.SlogLevel()}
+	var handler slog.Handler = slog.NewTextHandler(os.Stdout, options)
+	if logConfig.Format == "json" {
+		handler = slog.NewJSONHandler(os.Stdout, options)
+	}
+	return slog.New(server.NewRequestIDLogHandler(handler))
 }
 
-func processSuppliersData(intSuppliers *suppliers.IntSuppliers, logger *slog.Logger) {
-	if !external.FetchSuppliersMutex.TryLock() {
-		logger.Info("Skipping suppliers data fetch - GetHotels operation in progress")
-		return
+// accessLogOptions logs calls at Info, unless their method has its own level in log.access
+func accessLogOptions(accessConfig config.AccessLogConfig) server.AccessLogOptions {
+	return server.AccessLogOptions{
+		DefaultLevel:    slog.LevelInfo,
+		MethodLevels:    accessConfig.SlogMethodLevels(),
+		MaxPayloadBytes: accessConfig.MaxPayloadBytes,
+		RedactFields:    accessConfig.RedactFields,
 	}
-	defer external.FetchSuppliersMutex.Unlock()
+}
 
-	logger.Info("Starting suppliers data fetch and processing")
+func healthConfig(healthConfig config.HealthConfig) health.Config {
+	criticalSuppliers := make([]utils.Suppliers, 0, len(healthConfig.CriticalSuppliers))
+	for _, supplierName := range healthConfig.CriticalSuppliers {
+		criticalSuppliers = append(criticalSuppliers, utils.Suppliers(supplierName))
+	}
+	return health.Config{
+		MaxSnapshotAge:    healthConfig.MaxSnapshotAge,
+		CriticalSuppliers: criticalSuppliers,
+	}
+}
 
-	rawResp, err := intSuppliers.Fetcher.GetLatestSupplierData()
+// setupAuthenticator loads the API keys and JWT settings from auth.config_file.
+// Without it, authentication is disabled.
+func setupAuthenticator(authConfig config.AuthConfig, logger *slog.Logger) (auth.IntAuthenticator, error) {
+	if len(authConfig.ConfigFile) == 0 {
+		logger.Warn("auth.config_file is not set - gRPC and REST APIs are unauthenticated")
+		return nil, nil
+	}
+	authFileConfig, err := auth.LoadConfig(authConfig.ConfigFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load auth config: %w", err)
+	}
+	authenticator, err := auth.Initialize(authFileConfig)
 	if err != nil {
-		logger.Error("Failed to fetch suppliers data", "error", err)
-		return
+		return nil, fmt.Errorf("failed to initialize authentication: %w", err)
 	}
+	return authenticator, nil
+}
+
+// setupRateLimiter loads the per-client rate limits from rate_limit.config_file,
+// or uses the default limits without it
+func setupRateLimiter(rateLimitConfig config.RateLimitConfig) (ratelimit.Config, ratelimit.IntLimiter, error) {
+	limits := ratelimit.DefaultConfig()
+	if len(rateLimitConfig.ConfigFile) > 0 {
+		var err error
+		if limits, err = ratelimit.LoadConfig(rateLimitConfig.ConfigFile); err != nil {
+			return ratelimit.Config{}, nil, fmt.Errorf("failed to load rate limit config: %w", err)
+		}
+	}
+	limiter, err := ratelimit.Initialize(limits)
+	if err != nil {
+		return ratelimit.Config{}, nil, fmt.Errorf("failed to initialize rate limiting: %w", err)
+	}
+	return limits, limiter, nil
+}
 
-	mappedData, err := intSuppliers.Parser.ParseSuppliersData(rawResp)
+// setupTLS loads the certificates from tls.cert_file and tls.key_file, and the client CAs for mutual TLS
+// from tls.client_ca_file. Without a certificate, every listener is plaintext.
+func setupTLS(tlsConfig config.TLSConfig, logger *slog.Logger) (tlsconfig.IntCertificates, error) {
+	if len(tlsConfig.CertFile) == 0 {
+		return nil, nil
+	}
+	certificates, err := tlsconfig.Initialize(logger, tlsconfig.Options{
+		CertFile:       tlsConfig.CertFile,
+		KeyFile:        tlsConfig.KeyFile,
+		ClientCAFile:   tlsConfig.ClientCAFile,
+		ClientAuth:     tlsconfig.ClientAuth(tlsConfig.ClientAuth),
+		RootCAFile:     tlsConfig.CAFile,
+		ClientCertFile: tlsConfig.GatewayCertFile,
+		ClientKeyFile:  tlsConfig.GatewayKeyFile,
+	})
 	if err != nil {
-		logger.Error("Failed to parse and map suppliers data", "error", err)
-		return
+		return nil, fmt.Errorf("failed to load TLS certificates: %w", err)
 	}
+	return certificates, nil
+}
 
-	mergedHotels := intSuppliers.Merger.MergeHotelsData(mappedData)
-	hotels.SaveMaps(mergedHotels)
-	logger.Info("Suppliers data fetched and processed successfully")
+// serverOptions returns the options shared by the gRPC servers. The interceptors run in order: request ID,
+// metrics, access log, panic recovery, failed authentication limit, authentication and rate limiting, so that
+// the request ID is in every log line, recovered panics are counted and logged as INTERNAL errors, rejected
+// calls are still logged, clients with bad credentials are limited by their IP and authenticated clients are
+// rate limited by their ID rather than their IP.
+func serverOptions(certificates tlsconfig.IntCertificates, authenticator auth.IntAuthenticator, limiter ratelimit.IntLimiter, appMetrics *metrics.Metrics, logOptions server.AccessLogOptions, logger *slog.Logger) []grpc.ServerOption {
+	options := []grpc.ServerOption{
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.ChainUnaryInterceptor(
+			server.RequestIDUnaryInterceptor(),
+			appMetrics.UnaryServerInterceptor(),
+			server.AccessLogUnaryInterceptor(logger, logOptions),
+			server.RecoveryUnaryInterceptor(logger),
+			server.AuthFailureLimitUnaryInterceptor(logger, limiter, appMetrics),
+			server.AuthUnaryInterceptor(logger, authenticator),
+			server.RateLimitUnaryInterceptor(logger, limiter, appMetrics),
+		),
+		grpc.ChainStreamInterceptor(
+			server.RequestIDStreamInterceptor(),
+			appMetrics.StreamServerInterceptor(),
+			server.AccessLogStreamInterceptor(logger, logOptions),
+			server.RecoveryStreamInterceptor(logger),
+			server.AuthFailureLimitStreamInterceptor(logger, limiter, appMetrics),
+			server.AuthStreamInterceptor(logger, authenticator),
+			server.RateLimitStreamInterceptor(logger, limiter, appMetrics),
+		),
+	}
+	if certificates != nil {
+		options = append(options, grpc.Creds(credentials.NewTLS(certificates.ServerConfig())))
+	}
+	return options
 }
 
-func setupServer(svc proto.HotelDataMergeServer, logger *slog.Logger) {
-	svr := grpc.NewServer()
+func setupServer(app lifecycle.IntLifecycle, svc proto.HotelDataMergeServer, healthServer healthpb.HealthServer, cfg config.Config, options []grpc.ServerOption, logger *slog.Logger) error {
+	svr := grpc.NewServer(options...)
 	proto.RegisterHotelDataMergeServer(svr, svc)
-	lis, err := net.Listen("tcp", fmt.Sprintf(":%s", "8080"))
+	healthpb.RegisterHealthServer(svr, healthServer)
+	if cfg.Server.Reflection {
+		reflection.Register(svr)
+	}
+	lis, err := net.Listen("tcp", cfg.Server.GRPCAddress)
 	if err != nil {
-		log.Panicln("Failed to listen to tcp port", err)
+		return fmt.Errorf("failed to listen to tcp port: %w", err)
 	}
 	logger.Info(fmt.Sprintf("gRPC server listening at: %s", lis.Addr().String()))
-	go func() {
-		if err := svr.Serve(lis); err != nil {
-			log.Panicln("Failed to serve", err)
-		}
-	}()
+	app.Add(lifecycle.GRPCServer("grpc", svr, lis))
+	return nil
 }
 
-func setupGrpcGateway(logger *slog.Logger) {
+func setupAdminServer(app lifecycle.IntLifecycle, svc proto.HotelDataMergeAdminServer, healthServer healthpb.HealthServer, cfg config.Config, options []grpc.ServerOption, logger *slog.Logger) error {
+	svr := grpc.NewServer(options...)
+	proto.RegisterHotelDataMergeAdminServer(svr, svc)
+	healthpb.RegisterHealthServer(svr, healthServer)
+	if cfg.Server.Reflection {
+		reflection.Register(svr)
+	}
+	lis, err := net.Listen("tcp", cfg.Server.AdminAddress)
+	if err != nil {
+		return fmt.Errorf("failed to listen to admin tcp port: %w", err)
+	}
+	logger.Info(fmt.Sprintf("Admin gRPC server listening at: %s", lis.Addr().String()))
+	app.Add(lifecycle.GRPCServer("admin", svr, lis))
+	return nil
+}
+
+func setupGrpcGateway(app lifecycle.IntLifecycle, intHealth health.IntHealth, certificates tlsconfig.IntCertificates, cfg config.Config, appMetrics *metrics.Metrics, logger *slog.Logger) error {
+	transportCredentials := insecure.NewCredentials()
+	gwServer := &http.Server{}
+	if certificates != nil {
+		// The gateway verifies the gRPC server against tls.ca_file, and presents its client certificate
+		// when the server asks for one
+		transportCredentials = credentials.NewTLS(certificates.ClientConfig())
+		gwServer.TLSConfig = certificates.ServerConfig()
+	}
 	conn, err := grpc.NewClient(
-		"0.0.0.0:8080",
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		cfg.Server.GatewayTarget,
+		grpc.WithTransportCredentials(transportCredentials),
+		// Propagates the gateway's trace context to the gRPC server
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
 	)
 	if err != nil {
-		log.Fatalln("Failed to dial server:", err)
+		return fmt.Errorf("failed to dial server: %w", err)
 	}
-	mux := runtime.NewServeMux()
+	mux := runtime.NewServeMux(
+		runtime.WithErrorHandler(server.HTTPErrorHandler),
+		runtime.WithRoutingErrorHandler(server.HTTPRoutingErrorHandler),
+		runtime.WithIncomingHeaderMatcher(server.HTTPIncomingHeaderMatcher),
+		runtime.WithOutgoingHeaderMatcher(server.HTTPOutgoingHeaderMatcher),
+	)
 	err = proto.RegisterHotelDataMergeHandler(context.Background(), mux, conn)
 	if err != nil {
-		log.Fatalln("Failed to register gateway:", err)
+		return fmt.Errorf("failed to register gateway: %w", err)
+	}
+	openAPIHandler, err := server.OpenAPIHandler(
+		openapi.Info{Title: "Hotels Data Merge API", Version: "v1"},
+		proto.File_proto_hotelsdatamerge_proto.Services().ByName("HotelDataMerge"),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to generate the OpenAPI document: %w", err)
 	}
-	gwServer := &http.Server{
-		Addr:    fmt.Sprintf(":%s", "8090"),
-		Handler: mux,
+	httpMux := http.NewServeMux()
+	httpMux.Handle("/metrics", appMetrics.Handler())
+	httpMux.Handle("/openapi.json", openAPIHandler)
+	httpMux.Handle("/explorer", server.ExplorerHandler())
+	// Exports are not served by the gateway, which can only stream JSON, but by a handler calling ExportHotels
+	exportHandler := server.ExportHandler(proto.NewHotelDataMergeClient(conn))
+	httpMux.Handle("GET /v1/exports/hotels", otelhttp.NewHandler(appMetrics.InstrumentHandler(exportHandler), "export"))
+	httpMux.Handle("/healthz", server.HealthzHandler())
+	httpMux.Handle("/readyz", server.ReadyzHandler(intHealth))
+	httpMux.Handle("/", otelhttp.NewHandler(appMetrics.InstrumentHandler(server.FieldsQueryParam(mux)), "gateway"))
+	gwServer.Handler = httpMux
+	lis, err := net.Listen("tcp", cfg.Server.GatewayAddress)
+	if err != nil {
+		return fmt.Errorf("failed to listen to gateway tcp port: %w", err)
 	}
-	logger.Info(fmt.Sprintf("Serving gRPC-Gateway on: %s", gwServer.Addr))
-	if err = gwServer.ListenAndServe(); err != nil {
-		log.Fatalln("Failed to serve gateway:", err)
+	logger.Info(fmt.Sprintf("Serving gRPC-Gateway on: %s", lis.Addr().String()))
+
+	gateway := lifecycle.HTTPServer("gateway", gwServer, lis)
+	stopGateway := gateway.Stop
+	gateway.Stop = func(ctx context.Context) error {
+		err := stopGateway(ctx)
+		return errors.Join(err, conn.Close())
 	}
+	app.Add(gateway)
+	return nil
 }
@@ -0,0 +1,1050 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.7
+// 	protoc        v6.32.0
+// source: proto/admin.proto
+
+package proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type RefreshState int32
+
+const (
+	RefreshState_REFRESH_STATE_UNKNOWN             RefreshState = 0
+	RefreshState_REFRESH_STATE_RUNNING             RefreshState = 1
+	RefreshState_REFRESH_STATE_SUCCEEDED           RefreshState = 2
+	RefreshState_REFRESH_STATE_PARTIALLY_SUCCEEDED RefreshState = 3
+	RefreshState_REFRESH_STATE_FAILED              RefreshState = 4
+)
+
+// Enum value maps for RefreshState.
+var (
+	RefreshState_name = map[int32]string{
+		0: "REFRESH_STATE_UNKNOWN",
+		1: "REFRESH_STATE_RUNNING",
+		2: "REFRESH_STATE_SUCCEEDED",
+		3: "REFRESH_STATE_PARTIALLY_SUCCEEDED",
+		4: "REFRESH_STATE_FAILED",
+	}
+	RefreshState_value = map[string]int32{
+		"REFRESH_STATE_UNKNOWN":             0,
+		"REFRESH_STATE_RUNNING":             1,
+		"REFRESH_STATE_SUCCEEDED":           2,
+		"REFRESH_STATE_PARTIALLY_SUCCEEDED": 3,
+		"REFRESH_STATE_FAILED":              4,
+	}
+)
+
+func (x RefreshState) Enum() *RefreshState {
+	p := new(RefreshState)
+	*p = x
+	return p
+}
+
+func (x RefreshState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RefreshState) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_admin_proto_enumTypes[0].Descriptor()
+}
+
+func (RefreshState) Type() protoreflect.EnumType {
+	return &file_proto_admin_proto_enumTypes[0]
+}
+
+func (x RefreshState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RefreshState.Descriptor instead.
+func (RefreshState) EnumDescriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{0}
+}
+
+type SupplierState int32
+
+const (
+	SupplierState_SUPPLIER_STATE_UNKNOWN SupplierState = 0
+	SupplierState_SUPPLIER_STATE_OK      SupplierState = 1
+	SupplierState_SUPPLIER_STATE_FAILED  SupplierState = 2
+	SupplierState_SUPPLIER_STATE_SKIPPED SupplierState = 3
+)
+
+// Enum value maps for SupplierState.
+var (
+	SupplierState_name = map[int32]string{
+		0: "SUPPLIER_STATE_UNKNOWN",
+		1: "SUPPLIER_STATE_OK",
+		2: "SUPPLIER_STATE_FAILED",
+		3: "SUPPLIER_STATE_SKIPPED",
+	}
+	SupplierState_value = map[string]int32{
+		"SUPPLIER_STATE_UNKNOWN": 0,
+		"SUPPLIER_STATE_OK":      1,
+		"SUPPLIER_STATE_FAILED":  2,
+		"SUPPLIER_STATE_SKIPPED": 3,
+	}
+)
+
+func (x SupplierState) Enum() *SupplierState {
+	p := new(SupplierState)
+	*p = x
+	return p
+}
+
+func (x SupplierState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SupplierState) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_admin_proto_enumTypes[1].Descriptor()
+}
+
+func (SupplierState) Type() protoreflect.EnumType {
+	return &file_proto_admin_proto_enumTypes[1]
+}
+
+func (x SupplierState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SupplierState.Descriptor instead.
+func (SupplierState) EnumDescriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{1}
+}
+
+type TriggerRefreshRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Suppliers []string               `protobuf:"bytes,1,rep,name=suppliers,proto3" json:"suppliers,omitempty"`
+	// async returns the run as soon as it starts instead of waiting for it to finish
+	Async         bool `protobuf:"varint,2,opt,name=async,proto3" json:"async,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TriggerRefreshRequest) Reset() {
+	*x = TriggerRefreshRequest{}
+	mi := &file_proto_admin_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TriggerRefreshRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerRefreshRequest) ProtoMessage() {}
+
+func (x *TriggerRefreshRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggerRefreshRequest.ProtoReflect.Descriptor instead.
+func (*TriggerRefreshRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TriggerRefreshRequest) GetSuppliers() []string {
+	if x != nil {
+		return x.Suppliers
+	}
+	return nil
+}
+
+func (x *TriggerRefreshRequest) GetAsync() bool {
+	if x != nil {
+		return x.Async
+	}
+	return false
+}
+
+type TriggerRefreshResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Run           *RefreshRun            `protobuf:"bytes,1,opt,name=run,proto3" json:"run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TriggerRefreshResponse) Reset() {
+	*x = TriggerRefreshResponse{}
+	mi := &file_proto_admin_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TriggerRefreshResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerRefreshResponse) ProtoMessage() {}
+
+func (x *TriggerRefreshResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggerRefreshResponse.ProtoReflect.Descriptor instead.
+func (*TriggerRefreshResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TriggerRefreshResponse) GetRun() *RefreshRun {
+	if x != nil {
+		return x.Run
+	}
+	return nil
+}
+
+type GetRefreshStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRefreshStatusRequest) Reset() {
+	*x = GetRefreshStatusRequest{}
+	mi := &file_proto_admin_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRefreshStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRefreshStatusRequest) ProtoMessage() {}
+
+func (x *GetRefreshStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRefreshStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetRefreshStatusRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{2}
+}
+
+type GetRefreshStatusResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// current is unset when no refresh is running
+	Current *RefreshRun `protobuf:"bytes,1,opt,name=current,proto3" json:"current,omitempty"`
+	// last is unset until the first refresh has finished
+	Last          *RefreshRun `protobuf:"bytes,2,opt,name=last,proto3" json:"last,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRefreshStatusResponse) Reset() {
+	*x = GetRefreshStatusResponse{}
+	mi := &file_proto_admin_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRefreshStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRefreshStatusResponse) ProtoMessage() {}
+
+func (x *GetRefreshStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRefreshStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetRefreshStatusResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetRefreshStatusResponse) GetCurrent() *RefreshRun {
+	if x != nil {
+		return x.Current
+	}
+	return nil
+}
+
+func (x *GetRefreshStatusResponse) GetLast() *RefreshRun {
+	if x != nil {
+		return x.Last
+	}
+	return nil
+}
+
+type ListSuppliersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSuppliersRequest) Reset() {
+	*x = ListSuppliersRequest{}
+	mi := &file_proto_admin_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSuppliersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSuppliersRequest) ProtoMessage() {}
+
+func (x *ListSuppliersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSuppliersRequest.ProtoReflect.Descriptor instead.
+func (*ListSuppliersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{4}
+}
+
+type ListSuppliersResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// suppliers are listed in merge priority order
+	Suppliers     []*Supplier `protobuf:"bytes,1,rep,name=suppliers,proto3" json:"suppliers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSuppliersResponse) Reset() {
+	*x = ListSuppliersResponse{}
+	mi := &file_proto_admin_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSuppliersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSuppliersResponse) ProtoMessage() {}
+
+func (x *ListSuppliersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSuppliersResponse.ProtoReflect.Descriptor instead.
+func (*ListSuppliersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListSuppliersResponse) GetSuppliers() []*Supplier {
+	if x != nil {
+		return x.Suppliers
+	}
+	return nil
+}
+
+type Supplier struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Name     string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Url      string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Priority int32                  `protobuf:"varint,3,opt,name=priority,proto3" json:"priority,omitempty"`
+	// last_status is the supplier's status in the last refresh it was part of
+	LastStatus    *SupplierRefreshStatus `protobuf:"bytes,4,opt,name=last_status,json=lastStatus,proto3" json:"last_status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Supplier) Reset() {
+	*x = Supplier{}
+	mi := &file_proto_admin_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Supplier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Supplier) ProtoMessage() {}
+
+func (x *Supplier) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Supplier.ProtoReflect.Descriptor instead.
+func (*Supplier) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Supplier) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Supplier) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Supplier) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *Supplier) GetLastStatus() *SupplierRefreshStatus {
+	if x != nil {
+		return x.LastStatus
+	}
+	return nil
+}
+
+type GetQualityReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetQualityReportRequest) Reset() {
+	*x = GetQualityReportRequest{}
+	mi := &file_proto_admin_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetQualityReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQualityReportRequest) ProtoMessage() {}
+
+func (x *GetQualityReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQualityReportRequest.ProtoReflect.Descriptor instead.
+func (*GetQualityReportRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{7}
+}
+
+type GetQualityReportResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// report is unset until a refresh has merged the suppliers data
+	Report        *QualityReport `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetQualityReportResponse) Reset() {
+	*x = GetQualityReportResponse{}
+	mi := &file_proto_admin_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetQualityReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQualityReportResponse) ProtoMessage() {}
+
+func (x *GetQualityReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQualityReportResponse.ProtoReflect.Descriptor instead.
+func (*GetQualityReportResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetQualityReportResponse) GetReport() *QualityReport {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+type QualityReport struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// run_id is the ID of the refresh whose merge produced the report
+	RunId       int64                  `protobuf:"varint,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	GeneratedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=generated_at,json=generatedAt,proto3" json:"generated_at,omitempty"`
+	Issues      []*QualityIssue        `protobuf:"bytes,3,rep,name=issues,proto3" json:"issues,omitempty"`
+	// unmapped_amenities are the supplier amenities missing from the amenity taxonomy
+	UnmappedAmenities []string `protobuf:"bytes,4,rep,name=unmapped_amenities,json=unmappedAmenities,proto3" json:"unmapped_amenities,omitempty"`
+	// issue_counts is the number of issues of each kind
+	IssueCounts   map[string]int32 `protobuf:"bytes,5,rep,name=issue_counts,json=issueCounts,proto3" json:"issue_counts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QualityReport) Reset() {
+	*x = QualityReport{}
+	mi := &file_proto_admin_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QualityReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QualityReport) ProtoMessage() {}
+
+func (x *QualityReport) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QualityReport.ProtoReflect.Descriptor instead.
+func (*QualityReport) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *QualityReport) GetRunId() int64 {
+	if x != nil {
+		return x.RunId
+	}
+	return 0
+}
+
+func (x *QualityReport) GetGeneratedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.GeneratedAt
+	}
+	return nil
+}
+
+func (x *QualityReport) GetIssues() []*QualityIssue {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
+func (x *QualityReport) GetUnmappedAmenities() []string {
+	if x != nil {
+		return x.UnmappedAmenities
+	}
+	return nil
+}
+
+func (x *QualityReport) GetIssueCounts() map[string]int32 {
+	if x != nil {
+		return x.IssueCounts
+	}
+	return nil
+}
+
+type QualityIssue struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	HotelId string                 `protobuf:"bytes,1,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	// kind is e.g. null_island, coordinates_outlier, unmapped_amenity or unrecognized_booking_condition
+	Kind          string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Detail        string `protobuf:"bytes,3,opt,name=detail,proto3" json:"detail,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QualityIssue) Reset() {
+	*x = QualityIssue{}
+	mi := &file_proto_admin_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QualityIssue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QualityIssue) ProtoMessage() {}
+
+func (x *QualityIssue) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QualityIssue.ProtoReflect.Descriptor instead.
+func (*QualityIssue) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *QualityIssue) GetHotelId() string {
+	if x != nil {
+		return x.HotelId
+	}
+	return ""
+}
+
+func (x *QualityIssue) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *QualityIssue) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+type RefreshRun struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Id            int64                    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Trigger       string                   `protobuf:"bytes,2,opt,name=trigger,proto3" json:"trigger,omitempty"`
+	State         RefreshState             `protobuf:"varint,3,opt,name=state,proto3,enum=proto.RefreshState" json:"state,omitempty"`
+	StartedAt     *timestamppb.Timestamp   `protobuf:"bytes,4,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	FinishedAt    *timestamppb.Timestamp   `protobuf:"bytes,5,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	Duration      *durationpb.Duration     `protobuf:"bytes,6,opt,name=duration,proto3" json:"duration,omitempty"`
+	Suppliers     []*SupplierRefreshStatus `protobuf:"bytes,7,rep,name=suppliers,proto3" json:"suppliers,omitempty"`
+	HotelCount    int32                    `protobuf:"varint,8,opt,name=hotel_count,json=hotelCount,proto3" json:"hotel_count,omitempty"`
+	Error         string                   `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshRun) Reset() {
+	*x = RefreshRun{}
+	mi := &file_proto_admin_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshRun) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshRun) ProtoMessage() {}
+
+func (x *RefreshRun) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshRun.ProtoReflect.Descriptor instead.
+func (*RefreshRun) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RefreshRun) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RefreshRun) GetTrigger() string {
+	if x != nil {
+		return x.Trigger
+	}
+	return ""
+}
+
+func (x *RefreshRun) GetState() RefreshState {
+	if x != nil {
+		return x.State
+	}
+	return RefreshState_REFRESH_STATE_UNKNOWN
+}
+
+func (x *RefreshRun) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *RefreshRun) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
+}
+
+func (x *RefreshRun) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *RefreshRun) GetSuppliers() []*SupplierRefreshStatus {
+	if x != nil {
+		return x.Suppliers
+	}
+	return nil
+}
+
+func (x *RefreshRun) GetHotelCount() int32 {
+	if x != nil {
+		return x.HotelCount
+	}
+	return 0
+}
+
+func (x *RefreshRun) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type SupplierRefreshStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Supplier      string                 `protobuf:"bytes,1,opt,name=supplier,proto3" json:"supplier,omitempty"`
+	State         SupplierState          `protobuf:"varint,2,opt,name=state,proto3,enum=proto.SupplierState" json:"state,omitempty"`
+	RecordCount   int32                  `protobuf:"varint,3,opt,name=record_count,json=recordCount,proto3" json:"record_count,omitempty"`
+	FetchDuration *durationpb.Duration   `protobuf:"bytes,4,opt,name=fetch_duration,json=fetchDuration,proto3" json:"fetch_duration,omitempty"`
+	LastSuccessAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_success_at,json=lastSuccessAt,proto3" json:"last_success_at,omitempty"`
+	Error         string                 `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SupplierRefreshStatus) Reset() {
+	*x = SupplierRefreshStatus{}
+	mi := &file_proto_admin_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SupplierRefreshStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SupplierRefreshStatus) ProtoMessage() {}
+
+func (x *SupplierRefreshStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SupplierRefreshStatus.ProtoReflect.Descriptor instead.
+func (*SupplierRefreshStatus) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SupplierRefreshStatus) GetSupplier() string {
+	if x != nil {
+		return x.Supplier
+	}
+	return ""
+}
+
+func (x *SupplierRefreshStatus) GetState() SupplierState {
+	if x != nil {
+		return x.State
+	}
+	return SupplierState_SUPPLIER_STATE_UNKNOWN
+}
+
+func (x *SupplierRefreshStatus) GetRecordCount() int32 {
+	if x != nil {
+		return x.RecordCount
+	}
+	return 0
+}
+
+func (x *SupplierRefreshStatus) GetFetchDuration() *durationpb.Duration {
+	if x != nil {
+		return x.FetchDuration
+	}
+	return nil
+}
+
+func (x *SupplierRefreshStatus) GetLastSuccessAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSuccessAt
+	}
+	return nil
+}
+
+func (x *SupplierRefreshStatus) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+var File_proto_admin_proto protoreflect.FileDescriptor
+
+const file_proto_admin_proto_rawDesc = "" +
+	"\n" +
+	"\x11proto/admin.proto\x12\x05proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"K\n" +
+	"\x15TriggerRefreshRequest\x12\x1c\n" +
+	"\tsuppliers\x18\x01 \x03(\tR\tsuppliers\x12\x14\n" +
+	"\x05async\x18\x02 \x01(\bR\x05async\"=\n" +
+	"\x16TriggerRefreshResponse\x12#\n" +
+	"\x03run\x18\x01 \x01(\v2\x11.proto.RefreshRunR\x03run\"\x19\n" +
+	"\x17GetRefreshStatusRequest\"n\n" +
+	"\x18GetRefreshStatusResponse\x12+\n" +
+	"\acurrent\x18\x01 \x01(\v2\x11.proto.RefreshRunR\acurrent\x12%\n" +
+	"\x04last\x18\x02 \x01(\v2\x11.proto.RefreshRunR\x04last\"\x16\n" +
+	"\x14ListSuppliersRequest\"F\n" +
+	"\x15ListSuppliersResponse\x12-\n" +
+	"\tsuppliers\x18\x01 \x03(\v2\x0f.proto.SupplierR\tsuppliers\"\x8b\x01\n" +
+	"\bSupplier\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x1a\n" +
+	"\bpriority\x18\x03 \x01(\x05R\bpriority\x12=\n" +
+	"\vlast_status\x18\x04 \x01(\v2\x1c.proto.SupplierRefreshStatusR\n" +
+	"lastStatus\"\x19\n" +
+	"\x17GetQualityReportRequest\"H\n" +
+	"\x18GetQualityReportResponse\x12,\n" +
+	"\x06report\x18\x01 \x01(\v2\x14.proto.QualityReportR\x06report\"\xcb\x02\n" +
+	"\rQualityReport\x12\x15\n" +
+	"\x06run_id\x18\x01 \x01(\x03R\x05runId\x12=\n" +
+	"\fgenerated_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\vgeneratedAt\x12+\n" +
+	"\x06issues\x18\x03 \x03(\v2\x13.proto.QualityIssueR\x06issues\x12-\n" +
+	"\x12unmapped_amenities\x18\x04 \x03(\tR\x11unmappedAmenities\x12H\n" +
+	"\fissue_counts\x18\x05 \x03(\v2%.proto.QualityReport.IssueCountsEntryR\vissueCounts\x1a>\n" +
+	"\x10IssueCountsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"U\n" +
+	"\fQualityIssue\x12\x19\n" +
+	"\bhotel_id\x18\x01 \x01(\tR\ahotelId\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x16\n" +
+	"\x06detail\x18\x03 \x01(\tR\x06detail\"\x83\x03\n" +
+	"\n" +
+	"RefreshRun\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x18\n" +
+	"\atrigger\x18\x02 \x01(\tR\atrigger\x12)\n" +
+	"\x05state\x18\x03 \x01(\x0e2\x13.proto.RefreshStateR\x05state\x129\n" +
+	"\n" +
+	"started_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12;\n" +
+	"\vfinished_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"finishedAt\x125\n" +
+	"\bduration\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\bduration\x12:\n" +
+	"\tsuppliers\x18\a \x03(\v2\x1c.proto.SupplierRefreshStatusR\tsuppliers\x12\x1f\n" +
+	"\vhotel_count\x18\b \x01(\x05R\n" +
+	"hotelCount\x12\x14\n" +
+	"\x05error\x18\t \x01(\tR\x05error\"\x9e\x02\n" +
+	"\x15SupplierRefreshStatus\x12\x1a\n" +
+	"\bsupplier\x18\x01 \x01(\tR\bsupplier\x12*\n" +
+	"\x05state\x18\x02 \x01(\x0e2\x14.proto.SupplierStateR\x05state\x12!\n" +
+	"\frecord_count\x18\x03 \x01(\x05R\vrecordCount\x12@\n" +
+	"\x0efetch_duration\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\rfetchDuration\x12B\n" +
+	"\x0flast_success_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\rlastSuccessAt\x12\x14\n" +
+	"\x05error\x18\x06 \x01(\tR\x05error*\xa2\x01\n" +
+	"\fRefreshState\x12\x19\n" +
+	"\x15REFRESH_STATE_UNKNOWN\x10\x00\x12\x19\n" +
+	"\x15REFRESH_STATE_RUNNING\x10\x01\x12\x1b\n" +
+	"\x17REFRESH_STATE_SUCCEEDED\x10\x02\x12%\n" +
+	"!REFRESH_STATE_PARTIALLY_SUCCEEDED\x10\x03\x12\x18\n" +
+	"\x14REFRESH_STATE_FAILED\x10\x04*y\n" +
+	"\rSupplierState\x12\x1a\n" +
+	"\x16SUPPLIER_STATE_UNKNOWN\x10\x00\x12\x15\n" +
+	"\x11SUPPLIER_STATE_OK\x10\x01\x12\x19\n" +
+	"\x15SUPPLIER_STATE_FAILED\x10\x02\x12\x1a\n" +
+	"\x16SUPPLIER_STATE_SKIPPED\x10\x032\xe9\x02\n" +
+	"\x13HotelDataMergeAdmin\x12M\n" +
+	"\x0eTriggerRefresh\x12\x1c.proto.TriggerRefreshRequest\x1a\x1d.proto.TriggerRefreshResponse\x12X\n" +
+	"\x10GetRefreshStatus\x12\x1e.proto.GetRefreshStatusRequest\x1a\x1f.proto.GetRefreshStatusResponse\"\x03\x90\x02\x01\x12O\n" +
+	"\rListSuppliers\x12\x1b.proto.ListSuppliersRequest\x1a\x1c.proto.ListSuppliersResponse\"\x03\x90\x02\x01\x12X\n" +
+	"\x10GetQualityReport\x12\x1e.proto.GetQualityReportRequest\x1a\x1f.proto.GetQualityReportResponse\"\x03\x90\x02\x01B\x17Z\x15hotelsDataMerge/protob\x06proto3"
+
+var (
+	file_proto_admin_proto_rawDescOnce sync.Once
+	file_proto_admin_proto_rawDescData []byte
+)
+
+func file_proto_admin_proto_rawDescGZIP() []byte {
+	file_proto_admin_proto_rawDescOnce.Do(func() {
+		file_proto_admin_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_admin_proto_rawDesc), len(file_proto_admin_proto_rawDesc)))
+	})
+	return file_proto_admin_proto_rawDescData
+}
+
+var file_proto_admin_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_proto_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_proto_admin_proto_goTypes = []any{
+	(RefreshState)(0),                // 0: proto.RefreshState
+	(SupplierState)(0),               // 1: proto.SupplierState
+	(*TriggerRefreshRequest)(nil),    // 2: proto.TriggerRefreshRequest
+	(*TriggerRefreshResponse)(nil),   // 3: proto.TriggerRefreshResponse
+	(*GetRefreshStatusRequest)(nil),  // 4: proto.GetRefreshStatusRequest
+	(*GetRefreshStatusResponse)(nil), // 5: proto.GetRefreshStatusResponse
+	(*ListSuppliersRequest)(nil),     // 6: proto.ListSuppliersRequest
+	(*ListSuppliersResponse)(nil),    // 7: proto.ListSuppliersResponse
+	(*Supplier)(nil),                 // 8: proto.Supplier
+	(*GetQualityReportRequest)(nil),  // 9: proto.GetQualityReportRequest
+	(*GetQualityReportResponse)(nil), // 10: proto.GetQualityReportResponse
+	(*QualityReport)(nil),            // 11: proto.QualityReport
+	(*QualityIssue)(nil),             // 12: proto.QualityIssue
+	(*RefreshRun)(nil),               // 13: proto.RefreshRun
+	(*SupplierRefreshStatus)(nil),    // 14: proto.SupplierRefreshStatus
+	nil,                              // 15: proto.QualityReport.IssueCountsEntry
+	(*timestamppb.Timestamp)(nil),    // 16: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),      // 17: google.protobuf.Duration
+}
+var file_proto_admin_proto_depIdxs = []int32{
+	13, // 0: proto.TriggerRefreshResponse.run:type_name -> proto.RefreshRun
+	13, // 1: proto.GetRefreshStatusResponse.current:type_name -> proto.RefreshRun
+	13, // 2: proto.GetRefreshStatusResponse.last:type_name -> proto.RefreshRun
+	8,  // 3: proto.ListSuppliersResponse.suppliers:type_name -> proto.Supplier
+	14, // 4: proto.Supplier.last_status:type_name -> proto.SupplierRefreshStatus
+	11, // 5: proto.GetQualityReportResponse.report:type_name -> proto.QualityReport
+	16, // 6: proto.QualityReport.generated_at:type_name -> google.protobuf.Timestamp
+	12, // 7: proto.QualityReport.issues:type_name -> proto.QualityIssue
+	15, // 8: proto.QualityReport.issue_counts:type_name -> proto.QualityReport.IssueCountsEntry
+	0,  // 9: proto.RefreshRun.state:type_name -> proto.RefreshState
+	16, // 10: proto.RefreshRun.started_at:type_name -> google.protobuf.Timestamp
+	16, // 11: proto.RefreshRun.finished_at:type_name -> google.protobuf.Timestamp
+	17, // 12: proto.RefreshRun.duration:type_name -> google.protobuf.Duration
+	14, // 13: proto.RefreshRun.suppliers:type_name -> proto.SupplierRefreshStatus
+	1,  // 14: proto.SupplierRefreshStatus.state:type_name -> proto.SupplierState
+	17, // 15: proto.SupplierRefreshStatus.fetch_duration:type_name -> google.protobuf.Duration
+	16, // 16: proto.SupplierRefreshStatus.last_success_at:type_name -> google.protobuf.Timestamp
+	2,  // 17: proto.HotelDataMergeAdmin.TriggerRefresh:input_type -> proto.TriggerRefreshRequest
+	4,  // 18: proto.HotelDataMergeAdmin.GetRefreshStatus:input_type -> proto.GetRefreshStatusRequest
+	6,  // 19: proto.HotelDataMergeAdmin.ListSuppliers:input_type -> proto.ListSuppliersRequest
+	9,  // 20: proto.HotelDataMergeAdmin.GetQualityReport:input_type -> proto.GetQualityReportRequest
+	3,  // 21: proto.HotelDataMergeAdmin.TriggerRefresh:output_type -> proto.TriggerRefreshResponse
+	5,  // 22: proto.HotelDataMergeAdmin.GetRefreshStatus:output_type -> proto.GetRefreshStatusResponse
+	7,  // 23: proto.HotelDataMergeAdmin.ListSuppliers:output_type -> proto.ListSuppliersResponse
+	10, // 24: proto.HotelDataMergeAdmin.GetQualityReport:output_type -> proto.GetQualityReportResponse
+	21, // [21:25] is the sub-list for method output_type
+	17, // [17:21] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
+}
+
+func init() { file_proto_admin_proto_init() }
+func file_proto_admin_proto_init() {
+	if File_proto_admin_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_admin_proto_rawDesc), len(file_proto_admin_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   14,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_admin_proto_goTypes,
+		DependencyIndexes: file_proto_admin_proto_depIdxs,
+		EnumInfos:         file_proto_admin_proto_enumTypes,
+		MessageInfos:      file_proto_admin_proto_msgTypes,
+	}.Build()
+	File_proto_admin_proto = out.File
+	file_proto_admin_proto_goTypes = nil
+	file_proto_admin_proto_depIdxs = nil
+}
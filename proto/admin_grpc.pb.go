@@ -0,0 +1,243 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.32.0
+// source: proto/admin.proto
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	HotelDataMergeAdmin_TriggerRefresh_FullMethodName   = "/proto.HotelDataMergeAdmin/TriggerRefresh"
+	HotelDataMergeAdmin_GetRefreshStatus_FullMethodName = "/proto.HotelDataMergeAdmin/GetRefreshStatus"
+	HotelDataMergeAdmin_ListSuppliers_FullMethodName    = "/proto.HotelDataMergeAdmin/ListSuppliers"
+	HotelDataMergeAdmin_GetQualityReport_FullMethodName = "/proto.HotelDataMergeAdmin/GetQualityReport"
+)
+
+// HotelDataMergeAdminClient is the client API for HotelDataMergeAdmin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// HotelDataMergeAdmin is served on its own listener and is not exposed through the gateway
+type HotelDataMergeAdminClient interface {
+	// TriggerRefresh fetches, parses and merges the given suppliers, or all suppliers when none are given
+	TriggerRefresh(ctx context.Context, in *TriggerRefreshRequest, opts ...grpc.CallOption) (*TriggerRefreshResponse, error)
+	GetRefreshStatus(ctx context.Context, in *GetRefreshStatusRequest, opts ...grpc.CallOption) (*GetRefreshStatusResponse, error)
+	ListSuppliers(ctx context.Context, in *ListSuppliersRequest, opts ...grpc.CallOption) (*ListSuppliersResponse, error)
+	// GetQualityReport returns the data quality report of the last refresh that merged the suppliers data
+	GetQualityReport(ctx context.Context, in *GetQualityReportRequest, opts ...grpc.CallOption) (*GetQualityReportResponse, error)
+}
+
+type hotelDataMergeAdminClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewHotelDataMergeAdminClient(cc grpc.ClientConnInterface) HotelDataMergeAdminClient {
+	return &hotelDataMergeAdminClient{cc}
+}
+
+func (c *hotelDataMergeAdminClient) TriggerRefresh(ctx context.Context, in *TriggerRefreshRequest, opts ...grpc.CallOption) (*TriggerRefreshResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TriggerRefreshResponse)
+	err := c.cc.Invoke(ctx, HotelDataMergeAdmin_TriggerRefresh_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hotelDataMergeAdminClient) GetRefreshStatus(ctx context.Context, in *GetRefreshStatusRequest, opts ...grpc.CallOption) (*GetRefreshStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRefreshStatusResponse)
+	err := c.cc.Invoke(ctx, HotelDataMergeAdmin_GetRefreshStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hotelDataMergeAdminClient) ListSuppliers(ctx context.Context, in *ListSuppliersRequest, opts ...grpc.CallOption) (*ListSuppliersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSuppliersResponse)
+	err := c.cc.Invoke(ctx, HotelDataMergeAdmin_ListSuppliers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hotelDataMergeAdminClient) GetQualityReport(ctx context.Context, in *GetQualityReportRequest, opts ...grpc.CallOption) (*GetQualityReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetQualityReportResponse)
+	err := c.cc.Invoke(ctx, HotelDataMergeAdmin_GetQualityReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// HotelDataMergeAdminServer is the server API for HotelDataMergeAdmin service.
+// All implementations must embed UnimplementedHotelDataMergeAdminServer
+// for forward compatibility.
+//
+// HotelDataMergeAdmin is served on its own listener and is not exposed through the gateway
+type HotelDataMergeAdminServer interface {
+	// TriggerRefresh fetches, parses and merges the given suppliers, or all suppliers when none are given
+	TriggerRefresh(context.Context, *TriggerRefreshRequest) (*TriggerRefreshResponse, error)
+	GetRefreshStatus(context.Context, *GetRefreshStatusRequest) (*GetRefreshStatusResponse, error)
+	ListSuppliers(context.Context, *ListSuppliersRequest) (*ListSuppliersResponse, error)
+	// GetQualityReport returns the data quality report of the last refresh that merged the suppliers data
+	GetQualityReport(context.Context, *GetQualityReportRequest) (*GetQualityReportResponse, error)
+	mustEmbedUnimplementedHotelDataMergeAdminServer()
+}
+
+// UnimplementedHotelDataMergeAdminServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedHotelDataMergeAdminServer struct{}
+
+func (UnimplementedHotelDataMergeAdminServer) TriggerRefresh(context.Context, *TriggerRefreshRequest) (*TriggerRefreshResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TriggerRefresh not implemented")
+}
+func (UnimplementedHotelDataMergeAdminServer) GetRefreshStatus(context.Context, *GetRefreshStatusRequest) (*GetRefreshStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRefreshStatus not implemented")
+}
+func (UnimplementedHotelDataMergeAdminServer) ListSuppliers(context.Context, *ListSuppliersRequest) (*ListSuppliersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSuppliers not implemented")
+}
+func (UnimplementedHotelDataMergeAdminServer) GetQualityReport(context.Context, *GetQualityReportRequest) (*GetQualityReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQualityReport not implemented")
+}
+func (UnimplementedHotelDataMergeAdminServer) mustEmbedUnimplementedHotelDataMergeAdminServer() {}
+func (UnimplementedHotelDataMergeAdminServer) testEmbeddedByValue()                             {}
+
+// UnsafeHotelDataMergeAdminServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to HotelDataMergeAdminServer will
+// result in compilation errors.
+type UnsafeHotelDataMergeAdminServer interface {
+	mustEmbedUnimplementedHotelDataMergeAdminServer()
+}
+
+func RegisterHotelDataMergeAdminServer(s grpc.ServiceRegistrar, srv HotelDataMergeAdminServer) {
+	// If the following call pancis, it indicates UnimplementedHotelDataMergeAdminServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&HotelDataMergeAdmin_ServiceDesc, srv)
+}
+
+func _HotelDataMergeAdmin_TriggerRefresh_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TriggerRefreshRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HotelDataMergeAdminServer).TriggerRefresh(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HotelDataMergeAdmin_TriggerRefresh_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HotelDataMergeAdminServer).TriggerRefresh(ctx, req.(*TriggerRefreshRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HotelDataMergeAdmin_GetRefreshStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRefreshStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HotelDataMergeAdminServer).GetRefreshStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HotelDataMergeAdmin_GetRefreshStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HotelDataMergeAdminServer).GetRefreshStatus(ctx, req.(*GetRefreshStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HotelDataMergeAdmin_ListSuppliers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSuppliersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HotelDataMergeAdminServer).ListSuppliers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HotelDataMergeAdmin_ListSuppliers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HotelDataMergeAdminServer).ListSuppliers(ctx, req.(*ListSuppliersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HotelDataMergeAdmin_GetQualityReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQualityReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HotelDataMergeAdminServer).GetQualityReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HotelDataMergeAdmin_GetQualityReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HotelDataMergeAdminServer).GetQualityReport(ctx, req.(*GetQualityReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// HotelDataMergeAdmin_ServiceDesc is the grpc.ServiceDesc for HotelDataMergeAdmin service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var HotelDataMergeAdmin_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.HotelDataMergeAdmin",
+	HandlerType: (*HotelDataMergeAdminServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "TriggerRefresh",
+			Handler:    _HotelDataMergeAdmin_TriggerRefresh_Handler,
+		},
+		{
+			MethodName: "GetRefreshStatus",
+			Handler:    _HotelDataMergeAdmin_GetRefreshStatus_Handler,
+		},
+		{
+			MethodName: "ListSuppliers",
+			Handler:    _HotelDataMergeAdmin_ListSuppliers_Handler,
+		},
+		{
+			MethodName: "GetQualityReport",
+			Handler:    _HotelDataMergeAdmin_GetQualityReport_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/admin.proto",
+}
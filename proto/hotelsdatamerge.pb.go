@@ -10,6 +10,7 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -22,29 +23,577 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type CountryFormat int32
+
+const (
+	CountryFormat_COUNTRY_FORMAT_CODE CountryFormat = 0
+	CountryFormat_COUNTRY_FORMAT_NAME CountryFormat = 1
+)
+
+// Enum value maps for CountryFormat.
+var (
+	CountryFormat_name = map[int32]string{
+		0: "COUNTRY_FORMAT_CODE",
+		1: "COUNTRY_FORMAT_NAME",
+	}
+	CountryFormat_value = map[string]int32{
+		"COUNTRY_FORMAT_CODE": 0,
+		"COUNTRY_FORMAT_NAME": 1,
+	}
+)
+
+func (x CountryFormat) Enum() *CountryFormat {
+	p := new(CountryFormat)
+	*p = x
+	return p
+}
+
+func (x CountryFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CountryFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_hotelsdatamerge_proto_enumTypes[0].Descriptor()
+}
+
+func (CountryFormat) Type() protoreflect.EnumType {
+	return &file_proto_hotelsdatamerge_proto_enumTypes[0]
+}
+
+func (x CountryFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CountryFormat.Descriptor instead.
+func (CountryFormat) EnumDescriptor() ([]byte, []int) {
+	return file_proto_hotelsdatamerge_proto_rawDescGZIP(), []int{0}
+}
+
+type DestinationSortBy int32
+
+const (
+	DestinationSortBy_DESTINATION_SORT_BY_DESTINATION_ID DestinationSortBy = 0
+	DestinationSortBy_DESTINATION_SORT_BY_HOTEL_COUNT    DestinationSortBy = 1
+	DestinationSortBy_DESTINATION_SORT_BY_CITY           DestinationSortBy = 2
+	DestinationSortBy_DESTINATION_SORT_BY_COUNTRY        DestinationSortBy = 3
+)
+
+// Enum value maps for DestinationSortBy.
+var (
+	DestinationSortBy_name = map[int32]string{
+		0: "DESTINATION_SORT_BY_DESTINATION_ID",
+		1: "DESTINATION_SORT_BY_HOTEL_COUNT",
+		2: "DESTINATION_SORT_BY_CITY",
+		3: "DESTINATION_SORT_BY_COUNTRY",
+	}
+	DestinationSortBy_value = map[string]int32{
+		"DESTINATION_SORT_BY_DESTINATION_ID": 0,
+		"DESTINATION_SORT_BY_HOTEL_COUNT":    1,
+		"DESTINATION_SORT_BY_CITY":           2,
+		"DESTINATION_SORT_BY_COUNTRY":        3,
+	}
+)
+
+func (x DestinationSortBy) Enum() *DestinationSortBy {
+	p := new(DestinationSortBy)
+	*p = x
+	return p
+}
+
+func (x DestinationSortBy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DestinationSortBy) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_hotelsdatamerge_proto_enumTypes[1].Descriptor()
+}
+
+func (DestinationSortBy) Type() protoreflect.EnumType {
+	return &file_proto_hotelsdatamerge_proto_enumTypes[1]
+}
+
+func (x DestinationSortBy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DestinationSortBy.Descriptor instead.
+func (DestinationSortBy) EnumDescriptor() ([]byte, []int) {
+	return file_proto_hotelsdatamerge_proto_rawDescGZIP(), []int{1}
+}
+
+type PetPolicy int32
+
+const (
+	PetPolicy_PETS_UNKNOWN     PetPolicy = 0
+	PetPolicy_PETS_ALLOWED     PetPolicy = 1
+	PetPolicy_PETS_NOT_ALLOWED PetPolicy = 2
+	PetPolicy_PETS_ON_REQUEST  PetPolicy = 3
+)
+
+// Enum value maps for PetPolicy.
+var (
+	PetPolicy_name = map[int32]string{
+		0: "PETS_UNKNOWN",
+		1: "PETS_ALLOWED",
+		2: "PETS_NOT_ALLOWED",
+		3: "PETS_ON_REQUEST",
+	}
+	PetPolicy_value = map[string]int32{
+		"PETS_UNKNOWN":     0,
+		"PETS_ALLOWED":     1,
+		"PETS_NOT_ALLOWED": 2,
+		"PETS_ON_REQUEST":  3,
+	}
+)
+
+func (x PetPolicy) Enum() *PetPolicy {
+	p := new(PetPolicy)
+	*p = x
+	return p
+}
+
+func (x PetPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PetPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_hotelsdatamerge_proto_enumTypes[2].Descriptor()
+}
+
+func (PetPolicy) Type() protoreflect.EnumType {
+	return &file_proto_hotelsdatamerge_proto_enumTypes[2]
+}
+
+func (x PetPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PetPolicy.Descriptor instead.
+func (PetPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_proto_hotelsdatamerge_proto_rawDescGZIP(), []int{2}
+}
+
 type GetHotelsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	HotelIDs      []string               `protobuf:"bytes,1,rep,name=hotelIDs,proto3" json:"hotelIDs,omitempty"`
-	DestinationId uint64                 `protobuf:"varint,2,opt,name=destinationId,proto3" json:"destinationId,omitempty"`
+	HotelIDs      []string               `protobuf:"bytes,1,rep,name=hotelIDs,proto3" json:"hotelIDs,omitempty"`
+	DestinationId uint64                 `protobuf:"varint,2,opt,name=destinationId,proto3" json:"destinationId,omitempty"`
+	CountryFormat CountryFormat          `protobuf:"varint,3,opt,name=countryFormat,proto3,enum=proto.CountryFormat" json:"countryFormat,omitempty"`
+	// read_mask lists the Hotel fields to return, e.g. "id,name,images.rooms". All fields are returned when empty.
+	ReadMask      *fieldmaskpb.FieldMask `protobuf:"bytes,4,opt,name=read_mask,json=readMask,proto3" json:"read_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHotelsRequest) Reset() {
+	*x = GetHotelsRequest{}
+	mi := &file_proto_hotelsdatamerge_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHotelsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHotelsRequest) ProtoMessage() {}
+
+func (x *GetHotelsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_hotelsdatamerge_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHotelsRequest.ProtoReflect.Descriptor instead.
+func (*GetHotelsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_hotelsdatamerge_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetHotelsRequest) GetHotelIDs() []string {
+	if x != nil {
+		return x.HotelIDs
+	}
+	return nil
+}
+
+func (x *GetHotelsRequest) GetDestinationId() uint64 {
+	if x != nil {
+		return x.DestinationId
+	}
+	return 0
+}
+
+func (x *GetHotelsRequest) GetCountryFormat() CountryFormat {
+	if x != nil {
+		return x.CountryFormat
+	}
+	return CountryFormat_COUNTRY_FORMAT_CODE
+}
+
+func (x *GetHotelsRequest) GetReadMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.ReadMask
+	}
+	return nil
+}
+
+type GetHotelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CountryFormat CountryFormat          `protobuf:"varint,2,opt,name=countryFormat,proto3,enum=proto.CountryFormat" json:"countryFormat,omitempty"`
+	// read_mask lists the Hotel fields to return. All fields are returned when empty.
+	ReadMask      *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=read_mask,json=readMask,proto3" json:"read_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHotelRequest) Reset() {
+	*x = GetHotelRequest{}
+	mi := &file_proto_hotelsdatamerge_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHotelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHotelRequest) ProtoMessage() {}
+
+func (x *GetHotelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_hotelsdatamerge_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHotelRequest.ProtoReflect.Descriptor instead.
+func (*GetHotelRequest) Descriptor() ([]byte, []int) {
+	return file_proto_hotelsdatamerge_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetHotelRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetHotelRequest) GetCountryFormat() CountryFormat {
+	if x != nil {
+		return x.CountryFormat
+	}
+	return CountryFormat_COUNTRY_FORMAT_CODE
+}
+
+func (x *GetHotelRequest) GetReadMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.ReadMask
+	}
+	return nil
+}
+
+type ListDestinationsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// page_size is the maximum number of destinations to return: 50 when 0, at most 1000
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// page_token is the next_page_token of the previous page
+	PageToken     string            `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	SortBy        DestinationSortBy `protobuf:"varint,3,opt,name=sort_by,json=sortBy,proto3,enum=proto.DestinationSortBy" json:"sort_by,omitempty"`
+	Descending    bool              `protobuf:"varint,4,opt,name=descending,proto3" json:"descending,omitempty"`
+	CountryFormat CountryFormat     `protobuf:"varint,5,opt,name=countryFormat,proto3,enum=proto.CountryFormat" json:"countryFormat,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDestinationsRequest) Reset() {
+	*x = ListDestinationsRequest{}
+	mi := &file_proto_hotelsdatamerge_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDestinationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDestinationsRequest) ProtoMessage() {}
+
+func (x *ListDestinationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_hotelsdatamerge_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDestinationsRequest.ProtoReflect.Descriptor instead.
+func (*ListDestinationsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_hotelsdatamerge_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListDestinationsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListDestinationsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListDestinationsRequest) GetSortBy() DestinationSortBy {
+	if x != nil {
+		return x.SortBy
+	}
+	return DestinationSortBy_DESTINATION_SORT_BY_DESTINATION_ID
+}
+
+func (x *ListDestinationsRequest) GetDescending() bool {
+	if x != nil {
+		return x.Descending
+	}
+	return false
+}
+
+func (x *ListDestinationsRequest) GetCountryFormat() CountryFormat {
+	if x != nil {
+		return x.CountryFormat
+	}
+	return CountryFormat_COUNTRY_FORMAT_CODE
+}
+
+type ExportHotelsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CountryFormat CountryFormat          `protobuf:"varint,1,opt,name=countryFormat,proto3,enum=proto.CountryFormat" json:"countryFormat,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportHotelsRequest) Reset() {
+	*x = ExportHotelsRequest{}
+	mi := &file_proto_hotelsdatamerge_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportHotelsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportHotelsRequest) ProtoMessage() {}
+
+func (x *ExportHotelsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_hotelsdatamerge_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportHotelsRequest.ProtoReflect.Descriptor instead.
+func (*ExportHotelsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_hotelsdatamerge_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ExportHotelsRequest) GetCountryFormat() CountryFormat {
+	if x != nil {
+		return x.CountryFormat
+	}
+	return CountryFormat_COUNTRY_FORMAT_CODE
+}
+
+type ListDestinationsResponse struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Destinations []*Destination         `protobuf:"bytes,1,rep,name=destinations,proto3" json:"destinations,omitempty"`
+	// next_page_token is empty on the last page
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalSize     int32  `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDestinationsResponse) Reset() {
+	*x = ListDestinationsResponse{}
+	mi := &file_proto_hotelsdatamerge_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDestinationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDestinationsResponse) ProtoMessage() {}
+
+func (x *ListDestinationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_hotelsdatamerge_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDestinationsResponse.ProtoReflect.Descriptor instead.
+func (*ListDestinationsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_hotelsdatamerge_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListDestinationsResponse) GetDestinations() []*Destination {
+	if x != nil {
+		return x.Destinations
+	}
+	return nil
+}
+
+func (x *ListDestinationsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListDestinationsResponse) GetTotalSize() int32 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+type Destination struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	HotelCount    int32                  `protobuf:"varint,2,opt,name=hotel_count,json=hotelCount,proto3" json:"hotel_count,omitempty"`
+	City          string                 `protobuf:"bytes,3,opt,name=city,proto3" json:"city,omitempty"`
+	Country       string                 `protobuf:"bytes,4,opt,name=country,proto3" json:"country,omitempty"`
+	BoundingBox   *BoundingBox           `protobuf:"bytes,5,opt,name=bounding_box,json=boundingBox,proto3" json:"bounding_box,omitempty"`
+	Centroid      *LatLng                `protobuf:"bytes,6,opt,name=centroid,proto3" json:"centroid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Destination) Reset() {
+	*x = Destination{}
+	mi := &file_proto_hotelsdatamerge_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Destination) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Destination) ProtoMessage() {}
+
+func (x *Destination) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_hotelsdatamerge_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Destination.ProtoReflect.Descriptor instead.
+func (*Destination) Descriptor() ([]byte, []int) {
+	return file_proto_hotelsdatamerge_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Destination) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Destination) GetHotelCount() int32 {
+	if x != nil {
+		return x.HotelCount
+	}
+	return 0
+}
+
+func (x *Destination) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *Destination) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *Destination) GetBoundingBox() *BoundingBox {
+	if x != nil {
+		return x.BoundingBox
+	}
+	return nil
+}
+
+func (x *Destination) GetCentroid() *LatLng {
+	if x != nil {
+		return x.Centroid
+	}
+	return nil
+}
+
+// south_west.lng is greater than north_east.lng when the box crosses the antimeridian
+type BoundingBox struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SouthWest     *LatLng                `protobuf:"bytes,1,opt,name=south_west,json=southWest,proto3" json:"south_west,omitempty"`
+	NorthEast     *LatLng                `protobuf:"bytes,2,opt,name=north_east,json=northEast,proto3" json:"north_east,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetHotelsRequest) Reset() {
-	*x = GetHotelsRequest{}
-	mi := &file_proto_hotelsdatamerge_proto_msgTypes[0]
+func (x *BoundingBox) Reset() {
+	*x = BoundingBox{}
+	mi := &file_proto_hotelsdatamerge_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetHotelsRequest) String() string {
+func (x *BoundingBox) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetHotelsRequest) ProtoMessage() {}
+func (*BoundingBox) ProtoMessage() {}
 
-func (x *GetHotelsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_hotelsdatamerge_proto_msgTypes[0]
+func (x *BoundingBox) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_hotelsdatamerge_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -55,21 +604,73 @@ func (x *GetHotelsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetHotelsRequest.ProtoReflect.Descriptor instead.
-func (*GetHotelsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_hotelsdatamerge_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use BoundingBox.ProtoReflect.Descriptor instead.
+func (*BoundingBox) Descriptor() ([]byte, []int) {
+	return file_proto_hotelsdatamerge_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *GetHotelsRequest) GetHotelIDs() []string {
+func (x *BoundingBox) GetSouthWest() *LatLng {
 	if x != nil {
-		return x.HotelIDs
+		return x.SouthWest
 	}
 	return nil
 }
 
-func (x *GetHotelsRequest) GetDestinationId() uint64 {
+func (x *BoundingBox) GetNorthEast() *LatLng {
 	if x != nil {
-		return x.DestinationId
+		return x.NorthEast
+	}
+	return nil
+}
+
+type LatLng struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lat           float64                `protobuf:"fixed64,1,opt,name=lat,proto3" json:"lat,omitempty"`
+	Lng           float64                `protobuf:"fixed64,2,opt,name=lng,proto3" json:"lng,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LatLng) Reset() {
+	*x = LatLng{}
+	mi := &file_proto_hotelsdatamerge_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LatLng) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LatLng) ProtoMessage() {}
+
+func (x *LatLng) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_hotelsdatamerge_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LatLng.ProtoReflect.Descriptor instead.
+func (*LatLng) Descriptor() ([]byte, []int) {
+	return file_proto_hotelsdatamerge_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *LatLng) GetLat() float64 {
+	if x != nil {
+		return x.Lat
+	}
+	return 0
+}
+
+func (x *LatLng) GetLng() float64 {
+	if x != nil {
+		return x.Lng
 	}
 	return 0
 }
@@ -83,7 +684,7 @@ type GetHotelsResponse struct {
 
 func (x *GetHotelsResponse) Reset() {
 	*x = GetHotelsResponse{}
-	mi := &file_proto_hotelsdatamerge_proto_msgTypes[1]
+	mi := &file_proto_hotelsdatamerge_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -95,7 +696,7 @@ func (x *GetHotelsResponse) String() string {
 func (*GetHotelsResponse) ProtoMessage() {}
 
 func (x *GetHotelsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_hotelsdatamerge_proto_msgTypes[1]
+	mi := &file_proto_hotelsdatamerge_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -108,7 +709,7 @@ func (x *GetHotelsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetHotelsResponse.ProtoReflect.Descriptor instead.
 func (*GetHotelsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_hotelsdatamerge_proto_rawDescGZIP(), []int{1}
+	return file_proto_hotelsdatamerge_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GetHotelsResponse) GetHotels() []*Hotel {
@@ -128,13 +729,14 @@ type Hotel struct {
 	Amenities         *HotelAmenities        `protobuf:"bytes,6,opt,name=amenities,proto3" json:"amenities,omitempty"`
 	Images            *Image                 `protobuf:"bytes,7,opt,name=images,proto3" json:"images,omitempty"`
 	BookingConditions []string               `protobuf:"bytes,8,rep,name=booking_conditions,json=bookingConditions,proto3" json:"booking_conditions,omitempty"`
+	BookingPolicy     *BookingPolicy         `protobuf:"bytes,9,opt,name=booking_policy,json=bookingPolicy,proto3" json:"booking_policy,omitempty"`
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
 
 func (x *Hotel) Reset() {
 	*x = Hotel{}
-	mi := &file_proto_hotelsdatamerge_proto_msgTypes[2]
+	mi := &file_proto_hotelsdatamerge_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -146,7 +748,7 @@ func (x *Hotel) String() string {
 func (*Hotel) ProtoMessage() {}
 
 func (x *Hotel) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_hotelsdatamerge_proto_msgTypes[2]
+	mi := &file_proto_hotelsdatamerge_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -159,7 +761,7 @@ func (x *Hotel) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Hotel.ProtoReflect.Descriptor instead.
 func (*Hotel) Descriptor() ([]byte, []int) {
-	return file_proto_hotelsdatamerge_proto_rawDescGZIP(), []int{2}
+	return file_proto_hotelsdatamerge_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *Hotel) GetId() string {
@@ -218,6 +820,13 @@ func (x *Hotel) GetBookingConditions() []string {
 	return nil
 }
 
+func (x *Hotel) GetBookingPolicy() *BookingPolicy {
+	if x != nil {
+		return x.BookingPolicy
+	}
+	return nil
+}
+
 type Location struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Lat           float64                `protobuf:"fixed64,1,opt,name=lat,proto3" json:"lat,omitempty"`
@@ -231,7 +840,7 @@ type Location struct {
 
 func (x *Location) Reset() {
 	*x = Location{}
-	mi := &file_proto_hotelsdatamerge_proto_msgTypes[3]
+	mi := &file_proto_hotelsdatamerge_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -243,7 +852,7 @@ func (x *Location) String() string {
 func (*Location) ProtoMessage() {}
 
 func (x *Location) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_hotelsdatamerge_proto_msgTypes[3]
+	mi := &file_proto_hotelsdatamerge_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -256,7 +865,7 @@ func (x *Location) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Location.ProtoReflect.Descriptor instead.
 func (*Location) Descriptor() ([]byte, []int) {
-	return file_proto_hotelsdatamerge_proto_rawDescGZIP(), []int{3}
+	return file_proto_hotelsdatamerge_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *Location) GetLat() float64 {
@@ -304,7 +913,7 @@ type HotelAmenities struct {
 
 func (x *HotelAmenities) Reset() {
 	*x = HotelAmenities{}
-	mi := &file_proto_hotelsdatamerge_proto_msgTypes[4]
+	mi := &file_proto_hotelsdatamerge_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -316,7 +925,7 @@ func (x *HotelAmenities) String() string {
 func (*HotelAmenities) ProtoMessage() {}
 
 func (x *HotelAmenities) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_hotelsdatamerge_proto_msgTypes[4]
+	mi := &file_proto_hotelsdatamerge_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -329,7 +938,7 @@ func (x *HotelAmenities) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HotelAmenities.ProtoReflect.Descriptor instead.
 func (*HotelAmenities) Descriptor() ([]byte, []int) {
-	return file_proto_hotelsdatamerge_proto_rawDescGZIP(), []int{4}
+	return file_proto_hotelsdatamerge_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *HotelAmenities) GetGeneral() []string {
@@ -357,7 +966,7 @@ type Image struct {
 
 func (x *Image) Reset() {
 	*x = Image{}
-	mi := &file_proto_hotelsdatamerge_proto_msgTypes[5]
+	mi := &file_proto_hotelsdatamerge_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -369,7 +978,7 @@ func (x *Image) String() string {
 func (*Image) ProtoMessage() {}
 
 func (x *Image) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_hotelsdatamerge_proto_msgTypes[5]
+	mi := &file_proto_hotelsdatamerge_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -382,7 +991,7 @@ func (x *Image) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Image.ProtoReflect.Descriptor instead.
 func (*Image) Descriptor() ([]byte, []int) {
-	return file_proto_hotelsdatamerge_proto_rawDescGZIP(), []int{5}
+	return file_proto_hotelsdatamerge_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *Image) GetRooms() []*Room {
@@ -416,7 +1025,7 @@ type Room struct {
 
 func (x *Room) Reset() {
 	*x = Room{}
-	mi := &file_proto_hotelsdatamerge_proto_msgTypes[6]
+	mi := &file_proto_hotelsdatamerge_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -428,7 +1037,7 @@ func (x *Room) String() string {
 func (*Room) ProtoMessage() {}
 
 func (x *Room) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_hotelsdatamerge_proto_msgTypes[6]
+	mi := &file_proto_hotelsdatamerge_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -441,7 +1050,7 @@ func (x *Room) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Room.ProtoReflect.Descriptor instead.
 func (*Room) Descriptor() ([]byte, []int) {
-	return file_proto_hotelsdatamerge_proto_rawDescGZIP(), []int{6}
+	return file_proto_hotelsdatamerge_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *Room) GetLink() string {
@@ -468,7 +1077,7 @@ type Site struct {
 
 func (x *Site) Reset() {
 	*x = Site{}
-	mi := &file_proto_hotelsdatamerge_proto_msgTypes[7]
+	mi := &file_proto_hotelsdatamerge_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -480,7 +1089,7 @@ func (x *Site) String() string {
 func (*Site) ProtoMessage() {}
 
 func (x *Site) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_hotelsdatamerge_proto_msgTypes[7]
+	mi := &file_proto_hotelsdatamerge_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -493,7 +1102,7 @@ func (x *Site) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Site.ProtoReflect.Descriptor instead.
 func (*Site) Descriptor() ([]byte, []int) {
-	return file_proto_hotelsdatamerge_proto_rawDescGZIP(), []int{7}
+	return file_proto_hotelsdatamerge_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *Site) GetLink() string {
@@ -520,7 +1129,7 @@ type ImageAmenity struct {
 
 func (x *ImageAmenity) Reset() {
 	*x = ImageAmenity{}
-	mi := &file_proto_hotelsdatamerge_proto_msgTypes[8]
+	mi := &file_proto_hotelsdatamerge_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -532,7 +1141,7 @@ func (x *ImageAmenity) String() string {
 func (*ImageAmenity) ProtoMessage() {}
 
 func (x *ImageAmenity) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_hotelsdatamerge_proto_msgTypes[8]
+	mi := &file_proto_hotelsdatamerge_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -545,7 +1154,7 @@ func (x *ImageAmenity) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImageAmenity.ProtoReflect.Descriptor instead.
 func (*ImageAmenity) Descriptor() ([]byte, []int) {
-	return file_proto_hotelsdatamerge_proto_rawDescGZIP(), []int{8}
+	return file_proto_hotelsdatamerge_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ImageAmenity) GetLink() string {
@@ -562,16 +1171,307 @@ func (x *ImageAmenity) GetDescription() string {
 	return ""
 }
 
+type BookingPolicy struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	CheckInFrom           string                 `protobuf:"bytes,1,opt,name=check_in_from,json=checkInFrom,proto3" json:"check_in_from,omitempty"`
+	CheckOutUntil         string                 `protobuf:"bytes,2,opt,name=check_out_until,json=checkOutUntil,proto3" json:"check_out_until,omitempty"`
+	Pets                  PetPolicy              `protobuf:"varint,3,opt,name=pets,proto3,enum=proto.PetPolicy" json:"pets,omitempty"`
+	Cancellation          *CancellationPolicy    `protobuf:"bytes,4,opt,name=cancellation,proto3" json:"cancellation,omitempty"`
+	DepositRequired       bool                   `protobuf:"varint,5,opt,name=deposit_required,json=depositRequired,proto3" json:"deposit_required,omitempty"`
+	PrepaymentRequired    bool                   `protobuf:"varint,6,opt,name=prepayment_required,json=prepaymentRequired,proto3" json:"prepayment_required,omitempty"`
+	PhotoIdRequired       bool                   `protobuf:"varint,7,opt,name=photo_id_required,json=photoIdRequired,proto3" json:"photo_id_required,omitempty"`
+	CreditCardRequired    bool                   `protobuf:"varint,8,opt,name=credit_card_required,json=creditCardRequired,proto3" json:"credit_card_required,omitempty"`
+	Children              *ChildPolicy           `protobuf:"bytes,9,opt,name=children,proto3" json:"children,omitempty"`
+	UnrecognizedSentences []string               `protobuf:"bytes,10,rep,name=unrecognized_sentences,json=unrecognizedSentences,proto3" json:"unrecognized_sentences,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *BookingPolicy) Reset() {
+	*x = BookingPolicy{}
+	mi := &file_proto_hotelsdatamerge_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookingPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookingPolicy) ProtoMessage() {}
+
+func (x *BookingPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_hotelsdatamerge_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookingPolicy.ProtoReflect.Descriptor instead.
+func (*BookingPolicy) Descriptor() ([]byte, []int) {
+	return file_proto_hotelsdatamerge_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *BookingPolicy) GetCheckInFrom() string {
+	if x != nil {
+		return x.CheckInFrom
+	}
+	return ""
+}
+
+func (x *BookingPolicy) GetCheckOutUntil() string {
+	if x != nil {
+		return x.CheckOutUntil
+	}
+	return ""
+}
+
+func (x *BookingPolicy) GetPets() PetPolicy {
+	if x != nil {
+		return x.Pets
+	}
+	return PetPolicy_PETS_UNKNOWN
+}
+
+func (x *BookingPolicy) GetCancellation() *CancellationPolicy {
+	if x != nil {
+		return x.Cancellation
+	}
+	return nil
+}
+
+func (x *BookingPolicy) GetDepositRequired() bool {
+	if x != nil {
+		return x.DepositRequired
+	}
+	return false
+}
+
+func (x *BookingPolicy) GetPrepaymentRequired() bool {
+	if x != nil {
+		return x.PrepaymentRequired
+	}
+	return false
+}
+
+func (x *BookingPolicy) GetPhotoIdRequired() bool {
+	if x != nil {
+		return x.PhotoIdRequired
+	}
+	return false
+}
+
+func (x *BookingPolicy) GetCreditCardRequired() bool {
+	if x != nil {
+		return x.CreditCardRequired
+	}
+	return false
+}
+
+func (x *BookingPolicy) GetChildren() *ChildPolicy {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+func (x *BookingPolicy) GetUnrecognizedSentences() []string {
+	if x != nil {
+		return x.UnrecognizedSentences
+	}
+	return nil
+}
+
+type CancellationPolicy struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	FreeCancellation      bool                   `protobuf:"varint,1,opt,name=free_cancellation,json=freeCancellation,proto3" json:"free_cancellation,omitempty"`
+	FreeCancellationHours int32                  `protobuf:"varint,2,opt,name=free_cancellation_hours,json=freeCancellationHours,proto3" json:"free_cancellation_hours,omitempty"`
+	NonRefundable         bool                   `protobuf:"varint,3,opt,name=non_refundable,json=nonRefundable,proto3" json:"non_refundable,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *CancellationPolicy) Reset() {
+	*x = CancellationPolicy{}
+	mi := &file_proto_hotelsdatamerge_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancellationPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancellationPolicy) ProtoMessage() {}
+
+func (x *CancellationPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_hotelsdatamerge_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancellationPolicy.ProtoReflect.Descriptor instead.
+func (*CancellationPolicy) Descriptor() ([]byte, []int) {
+	return file_proto_hotelsdatamerge_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CancellationPolicy) GetFreeCancellation() bool {
+	if x != nil {
+		return x.FreeCancellation
+	}
+	return false
+}
+
+func (x *CancellationPolicy) GetFreeCancellationHours() int32 {
+	if x != nil {
+		return x.FreeCancellationHours
+	}
+	return 0
+}
+
+func (x *CancellationPolicy) GetNonRefundable() bool {
+	if x != nil {
+		return x.NonRefundable
+	}
+	return false
+}
+
+type ChildPolicy struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unset when no booking condition says whether children are allowed
+	ChildrenAllowed    *bool  `protobuf:"varint,1,opt,name=children_allowed,json=childrenAllowed,proto3,oneof" json:"children_allowed,omitempty"`
+	FreeStayUnderAge   int32  `protobuf:"varint,2,opt,name=free_stay_under_age,json=freeStayUnderAge,proto3" json:"free_stay_under_age,omitempty"`
+	MaxCribs           int32  `protobuf:"varint,3,opt,name=max_cribs,json=maxCribs,proto3" json:"max_cribs,omitempty"`
+	ExtraBedsAvailable bool   `protobuf:"varint,4,opt,name=extra_beds_available,json=extraBedsAvailable,proto3" json:"extra_beds_available,omitempty"`
+	ExtraBedCharge     string `protobuf:"bytes,5,opt,name=extra_bed_charge,json=extraBedCharge,proto3" json:"extra_bed_charge,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ChildPolicy) Reset() {
+	*x = ChildPolicy{}
+	mi := &file_proto_hotelsdatamerge_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChildPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChildPolicy) ProtoMessage() {}
+
+func (x *ChildPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_hotelsdatamerge_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChildPolicy.ProtoReflect.Descriptor instead.
+func (*ChildPolicy) Descriptor() ([]byte, []int) {
+	return file_proto_hotelsdatamerge_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ChildPolicy) GetChildrenAllowed() bool {
+	if x != nil && x.ChildrenAllowed != nil {
+		return *x.ChildrenAllowed
+	}
+	return false
+}
+
+func (x *ChildPolicy) GetFreeStayUnderAge() int32 {
+	if x != nil {
+		return x.FreeStayUnderAge
+	}
+	return 0
+}
+
+func (x *ChildPolicy) GetMaxCribs() int32 {
+	if x != nil {
+		return x.MaxCribs
+	}
+	return 0
+}
+
+func (x *ChildPolicy) GetExtraBedsAvailable() bool {
+	if x != nil {
+		return x.ExtraBedsAvailable
+	}
+	return false
+}
+
+func (x *ChildPolicy) GetExtraBedCharge() string {
+	if x != nil {
+		return x.ExtraBedCharge
+	}
+	return ""
+}
+
 var File_proto_hotelsdatamerge_proto protoreflect.FileDescriptor
 
 const file_proto_hotelsdatamerge_proto_rawDesc = "" +
 	"\n" +
-	"\x1bproto/hotelsdatamerge.proto\x12\x05proto\x1a\"proto/google/api/annotations.proto\"T\n" +
+	"\x1bproto/hotelsdatamerge.proto\x12\x05proto\x1a\"proto/google/api/annotations.proto\x1a google/protobuf/field_mask.proto\"\xc9\x01\n" +
 	"\x10GetHotelsRequest\x12\x1a\n" +
 	"\bhotelIDs\x18\x01 \x03(\tR\bhotelIDs\x12$\n" +
-	"\rdestinationId\x18\x02 \x01(\x04R\rdestinationId\"9\n" +
+	"\rdestinationId\x18\x02 \x01(\x04R\rdestinationId\x12:\n" +
+	"\rcountryFormat\x18\x03 \x01(\x0e2\x14.proto.CountryFormatR\rcountryFormat\x127\n" +
+	"\tread_mask\x18\x04 \x01(\v2\x1a.google.protobuf.FieldMaskR\breadMask\"\x96\x01\n" +
+	"\x0fGetHotelRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12:\n" +
+	"\rcountryFormat\x18\x02 \x01(\x0e2\x14.proto.CountryFormatR\rcountryFormat\x127\n" +
+	"\tread_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskR\breadMask\"\xe4\x01\n" +
+	"\x17ListDestinationsRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x121\n" +
+	"\asort_by\x18\x03 \x01(\x0e2\x18.proto.DestinationSortByR\x06sortBy\x12\x1e\n" +
+	"\n" +
+	"descending\x18\x04 \x01(\bR\n" +
+	"descending\x12:\n" +
+	"\rcountryFormat\x18\x05 \x01(\x0e2\x14.proto.CountryFormatR\rcountryFormat\"Q\n" +
+	"\x13ExportHotelsRequest\x12:\n" +
+	"\rcountryFormat\x18\x01 \x01(\x0e2\x14.proto.CountryFormatR\rcountryFormat\"\x99\x01\n" +
+	"\x18ListDestinationsResponse\x126\n" +
+	"\fdestinations\x18\x01 \x03(\v2\x12.proto.DestinationR\fdestinations\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1d\n" +
+	"\n" +
+	"total_size\x18\x03 \x01(\x05R\ttotalSize\"\xce\x01\n" +
+	"\vDestination\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x1f\n" +
+	"\vhotel_count\x18\x02 \x01(\x05R\n" +
+	"hotelCount\x12\x12\n" +
+	"\x04city\x18\x03 \x01(\tR\x04city\x12\x18\n" +
+	"\acountry\x18\x04 \x01(\tR\acountry\x125\n" +
+	"\fbounding_box\x18\x05 \x01(\v2\x12.proto.BoundingBoxR\vboundingBox\x12)\n" +
+	"\bcentroid\x18\x06 \x01(\v2\r.proto.LatLngR\bcentroid\"i\n" +
+	"\vBoundingBox\x12,\n" +
+	"\n" +
+	"south_west\x18\x01 \x01(\v2\r.proto.LatLngR\tsouthWest\x12,\n" +
+	"\n" +
+	"north_east\x18\x02 \x01(\v2\r.proto.LatLngR\tnorthEast\",\n" +
+	"\x06LatLng\x12\x10\n" +
+	"\x03lat\x18\x01 \x01(\x01R\x03lat\x12\x10\n" +
+	"\x03lng\x18\x02 \x01(\x01R\x03lng\"9\n" +
 	"\x11GetHotelsResponse\x12$\n" +
-	"\x06hotels\x18\x01 \x03(\v2\f.proto.HotelR\x06hotels\"\xaa\x02\n" +
+	"\x06hotels\x18\x01 \x03(\v2\f.proto.HotelR\x06hotels\"\xe7\x02\n" +
 	"\x05Hotel\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12$\n" +
 	"\rdestinationId\x18\x02 \x01(\x03R\rdestinationId\x12\x12\n" +
@@ -580,7 +1480,8 @@ const file_proto_hotelsdatamerge_proto_rawDesc = "" +
 	"\vdescription\x18\x05 \x01(\tR\vdescription\x123\n" +
 	"\tamenities\x18\x06 \x01(\v2\x15.proto.HotelAmenitiesR\tamenities\x12$\n" +
 	"\x06images\x18\a \x01(\v2\f.proto.ImageR\x06images\x12-\n" +
-	"\x12booking_conditions\x18\b \x03(\tR\x11bookingConditions\"v\n" +
+	"\x12booking_conditions\x18\b \x03(\tR\x11bookingConditions\x12;\n" +
+	"\x0ebooking_policy\x18\t \x01(\v2\x14.proto.BookingPolicyR\rbookingPolicy\"v\n" +
 	"\bLocation\x12\x10\n" +
 	"\x03lat\x18\x01 \x01(\x01R\x03lat\x12\x10\n" +
 	"\x03lng\x18\x02 \x01(\x01R\x03lng\x12\x18\n" +
@@ -602,10 +1503,49 @@ const file_proto_hotelsdatamerge_proto_rawDesc = "" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\"D\n" +
 	"\fImageAmenity\x12\x12\n" +
 	"\x04link\x18\x01 \x01(\tR\x04link\x12 \n" +
-	"\vdescription\x18\x02 \x01(\tR\vdescription2g\n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\"\xe1\x03\n" +
+	"\rBookingPolicy\x12\"\n" +
+	"\rcheck_in_from\x18\x01 \x01(\tR\vcheckInFrom\x12&\n" +
+	"\x0fcheck_out_until\x18\x02 \x01(\tR\rcheckOutUntil\x12$\n" +
+	"\x04pets\x18\x03 \x01(\x0e2\x10.proto.PetPolicyR\x04pets\x12=\n" +
+	"\fcancellation\x18\x04 \x01(\v2\x19.proto.CancellationPolicyR\fcancellation\x12)\n" +
+	"\x10deposit_required\x18\x05 \x01(\bR\x0fdepositRequired\x12/\n" +
+	"\x13prepayment_required\x18\x06 \x01(\bR\x12prepaymentRequired\x12*\n" +
+	"\x11photo_id_required\x18\a \x01(\bR\x0fphotoIdRequired\x120\n" +
+	"\x14credit_card_required\x18\b \x01(\bR\x12creditCardRequired\x12.\n" +
+	"\bchildren\x18\t \x01(\v2\x12.proto.ChildPolicyR\bchildren\x125\n" +
+	"\x16unrecognized_sentences\x18\n" +
+	" \x03(\tR\x15unrecognizedSentences\"\xa0\x01\n" +
+	"\x12CancellationPolicy\x12+\n" +
+	"\x11free_cancellation\x18\x01 \x01(\bR\x10freeCancellation\x126\n" +
+	"\x17free_cancellation_hours\x18\x02 \x01(\x05R\x15freeCancellationHours\x12%\n" +
+	"\x0enon_refundable\x18\x03 \x01(\bR\rnonRefundable\"\xfa\x01\n" +
+	"\vChildPolicy\x12.\n" +
+	"\x10children_allowed\x18\x01 \x01(\bH\x00R\x0fchildrenAllowed\x88\x01\x01\x12-\n" +
+	"\x13free_stay_under_age\x18\x02 \x01(\x05R\x10freeStayUnderAge\x12\x1b\n" +
+	"\tmax_cribs\x18\x03 \x01(\x05R\bmaxCribs\x120\n" +
+	"\x14extra_beds_available\x18\x04 \x01(\bR\x12extraBedsAvailable\x12(\n" +
+	"\x10extra_bed_charge\x18\x05 \x01(\tR\x0eextraBedChargeB\x13\n" +
+	"\x11_children_allowed*A\n" +
+	"\rCountryFormat\x12\x17\n" +
+	"\x13COUNTRY_FORMAT_CODE\x10\x00\x12\x17\n" +
+	"\x13COUNTRY_FORMAT_NAME\x10\x01*\x9f\x01\n" +
+	"\x11DestinationSortBy\x12&\n" +
+	"\"DESTINATION_SORT_BY_DESTINATION_ID\x10\x00\x12#\n" +
+	"\x1fDESTINATION_SORT_BY_HOTEL_COUNT\x10\x01\x12\x1c\n" +
+	"\x18DESTINATION_SORT_BY_CITY\x10\x02\x12\x1f\n" +
+	"\x1bDESTINATION_SORT_BY_COUNTRY\x10\x03*Z\n" +
+	"\tPetPolicy\x12\x10\n" +
+	"\fPETS_UNKNOWN\x10\x00\x12\x10\n" +
+	"\fPETS_ALLOWED\x10\x01\x12\x14\n" +
+	"\x10PETS_NOT_ALLOWED\x10\x02\x12\x13\n" +
+	"\x0fPETS_ON_REQUEST\x10\x032\xe8\x02\n" +
 	"\x0eHotelDataMerge\x12U\n" +
 	"\tGetHotels\x12\x17.proto.GetHotelsRequest\x1a\x18.proto.GetHotelsResponse\"\x15\x82\xd3\xe4\x93\x02\f\x12\n" +
-	"/v1/hotels\x90\x02\x01B\x17Z\x15hotelsDataMerge/protob\x06proto3"
+	"/v1/hotels\x90\x02\x01\x12L\n" +
+	"\bGetHotel\x12\x16.proto.GetHotelRequest\x1a\f.proto.Hotel\"\x1a\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/hotels/{id}\x90\x02\x01\x12p\n" +
+	"\x10ListDestinations\x12\x1e.proto.ListDestinationsRequest\x1a\x1f.proto.ListDestinationsResponse\"\x1b\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/destinations\x90\x02\x01\x12?\n" +
+	"\fExportHotels\x12\x1a.proto.ExportHotelsRequest\x1a\f.proto.Hotel\"\x03\x90\x02\x010\x01B\x17Z\x15hotelsDataMerge/protob\x06proto3"
 
 var (
 	file_proto_hotelsdatamerge_proto_rawDescOnce sync.Once
@@ -619,33 +1559,70 @@ func file_proto_hotelsdatamerge_proto_rawDescGZIP() []byte {
 	return file_proto_hotelsdatamerge_proto_rawDescData
 }
 
-var file_proto_hotelsdatamerge_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_proto_hotelsdatamerge_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_proto_hotelsdatamerge_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
 var file_proto_hotelsdatamerge_proto_goTypes = []any{
-	(*GetHotelsRequest)(nil),  // 0: proto.GetHotelsRequest
-	(*GetHotelsResponse)(nil), // 1: proto.GetHotelsResponse
-	(*Hotel)(nil),             // 2: proto.Hotel
-	(*Location)(nil),          // 3: proto.Location
-	(*HotelAmenities)(nil),    // 4: proto.HotelAmenities
-	(*Image)(nil),             // 5: proto.Image
-	(*Room)(nil),              // 6: proto.Room
-	(*Site)(nil),              // 7: proto.Site
-	(*ImageAmenity)(nil),      // 8: proto.ImageAmenity
+	(CountryFormat)(0),               // 0: proto.CountryFormat
+	(DestinationSortBy)(0),           // 1: proto.DestinationSortBy
+	(PetPolicy)(0),                   // 2: proto.PetPolicy
+	(*GetHotelsRequest)(nil),         // 3: proto.GetHotelsRequest
+	(*GetHotelRequest)(nil),          // 4: proto.GetHotelRequest
+	(*ListDestinationsRequest)(nil),  // 5: proto.ListDestinationsRequest
+	(*ExportHotelsRequest)(nil),      // 6: proto.ExportHotelsRequest
+	(*ListDestinationsResponse)(nil), // 7: proto.ListDestinationsResponse
+	(*Destination)(nil),              // 8: proto.Destination
+	(*BoundingBox)(nil),              // 9: proto.BoundingBox
+	(*LatLng)(nil),                   // 10: proto.LatLng
+	(*GetHotelsResponse)(nil),        // 11: proto.GetHotelsResponse
+	(*Hotel)(nil),                    // 12: proto.Hotel
+	(*Location)(nil),                 // 13: proto.Location
+	(*HotelAmenities)(nil),           // 14: proto.HotelAmenities
+	(*Image)(nil),                    // 15: proto.Image
+	(*Room)(nil),                     // 16: proto.Room
+	(*Site)(nil),                     // 17: proto.Site
+	(*ImageAmenity)(nil),             // 18: proto.ImageAmenity
+	(*BookingPolicy)(nil),            // 19: proto.BookingPolicy
+	(*CancellationPolicy)(nil),       // 20: proto.CancellationPolicy
+	(*ChildPolicy)(nil),              // 21: proto.ChildPolicy
+	(*fieldmaskpb.FieldMask)(nil),    // 22: google.protobuf.FieldMask
 }
 var file_proto_hotelsdatamerge_proto_depIdxs = []int32{
-	2, // 0: proto.GetHotelsResponse.hotels:type_name -> proto.Hotel
-	3, // 1: proto.Hotel.location:type_name -> proto.Location
-	4, // 2: proto.Hotel.amenities:type_name -> proto.HotelAmenities
-	5, // 3: proto.Hotel.images:type_name -> proto.Image
-	6, // 4: proto.Image.rooms:type_name -> proto.Room
-	7, // 5: proto.Image.site:type_name -> proto.Site
-	8, // 6: proto.Image.amenities:type_name -> proto.ImageAmenity
-	0, // 7: proto.HotelDataMerge.GetHotels:input_type -> proto.GetHotelsRequest
-	1, // 8: proto.HotelDataMerge.GetHotels:output_type -> proto.GetHotelsResponse
-	8, // [8:9] is the sub-list for method output_type
-	7, // [7:8] is the sub-list for method input_type
-	7, // [7:7] is the sub-list for extension type_name
-	7, // [7:7] is the sub-list for extension extendee
-	0, // [0:7] is the sub-list for field type_name
+	0,  // 0: proto.GetHotelsRequest.countryFormat:type_name -> proto.CountryFormat
+	22, // 1: proto.GetHotelsRequest.read_mask:type_name -> google.protobuf.FieldMask
+	0,  // 2: proto.GetHotelRequest.countryFormat:type_name -> proto.CountryFormat
+	22, // 3: proto.GetHotelRequest.read_mask:type_name -> google.protobuf.FieldMask
+	1,  // 4: proto.ListDestinationsRequest.sort_by:type_name -> proto.DestinationSortBy
+	0,  // 5: proto.ListDestinationsRequest.countryFormat:type_name -> proto.CountryFormat
+	0,  // 6: proto.ExportHotelsRequest.countryFormat:type_name -> proto.CountryFormat
+	8,  // 7: proto.ListDestinationsResponse.destinations:type_name -> proto.Destination
+	9,  // 8: proto.Destination.bounding_box:type_name -> proto.BoundingBox
+	10, // 9: proto.Destination.centroid:type_name -> proto.LatLng
+	10, // 10: proto.BoundingBox.south_west:type_name -> proto.LatLng
+	10, // 11: proto.BoundingBox.north_east:type_name -> proto.LatLng
+	12, // 12: proto.GetHotelsResponse.hotels:type_name -> proto.Hotel
+	13, // 13: proto.Hotel.location:type_name -> proto.Location
+	14, // 14: proto.Hotel.amenities:type_name -> proto.HotelAmenities
+	15, // 15: proto.Hotel.images:type_name -> proto.Image
+	19, // 16: proto.Hotel.booking_policy:type_name -> proto.BookingPolicy
+	16, // 17: proto.Image.rooms:type_name -> proto.Room
+	17, // 18: proto.Image.site:type_name -> proto.Site
+	18, // 19: proto.Image.amenities:type_name -> proto.ImageAmenity
+	2,  // 20: proto.BookingPolicy.pets:type_name -> proto.PetPolicy
+	20, // 21: proto.BookingPolicy.cancellation:type_name -> proto.CancellationPolicy
+	21, // 22: proto.BookingPolicy.children:type_name -> proto.ChildPolicy
+	3,  // 23: proto.HotelDataMerge.GetHotels:input_type -> proto.GetHotelsRequest
+	4,  // 24: proto.HotelDataMerge.GetHotel:input_type -> proto.GetHotelRequest
+	5,  // 25: proto.HotelDataMerge.ListDestinations:input_type -> proto.ListDestinationsRequest
+	6,  // 26: proto.HotelDataMerge.ExportHotels:input_type -> proto.ExportHotelsRequest
+	11, // 27: proto.HotelDataMerge.GetHotels:output_type -> proto.GetHotelsResponse
+	12, // 28: proto.HotelDataMerge.GetHotel:output_type -> proto.Hotel
+	7,  // 29: proto.HotelDataMerge.ListDestinations:output_type -> proto.ListDestinationsResponse
+	12, // 30: proto.HotelDataMerge.ExportHotels:output_type -> proto.Hotel
+	27, // [27:31] is the sub-list for method output_type
+	23, // [23:27] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
 }
 
 func init() { file_proto_hotelsdatamerge_proto_init() }
@@ -653,18 +1630,20 @@ func file_proto_hotelsdatamerge_proto_init() {
 	if File_proto_hotelsdatamerge_proto != nil {
 		return
 	}
+	file_proto_hotelsdatamerge_proto_msgTypes[18].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_hotelsdatamerge_proto_rawDesc), len(file_proto_hotelsdatamerge_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   9,
+			NumEnums:      3,
+			NumMessages:   19,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_proto_hotelsdatamerge_proto_goTypes,
 		DependencyIndexes: file_proto_hotelsdatamerge_proto_depIdxs,
+		EnumInfos:         file_proto_hotelsdatamerge_proto_enumTypes,
 		MessageInfos:      file_proto_hotelsdatamerge_proto_msgTypes,
 	}.Build()
 	File_proto_hotelsdatamerge_proto = out.File
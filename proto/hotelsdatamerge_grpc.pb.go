@@ -19,7 +19,10 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	HotelDataMerge_GetHotels_FullMethodName = "/proto.HotelDataMerge/GetHotels"
+	HotelDataMerge_GetHotels_FullMethodName        = "/proto.HotelDataMerge/GetHotels"
+	HotelDataMerge_GetHotel_FullMethodName         = "/proto.HotelDataMerge/GetHotel"
+	HotelDataMerge_ListDestinations_FullMethodName = "/proto.HotelDataMerge/ListDestinations"
+	HotelDataMerge_ExportHotels_FullMethodName     = "/proto.HotelDataMerge/ExportHotels"
 )
 
 // HotelDataMergeClient is the client API for HotelDataMerge service.
@@ -27,6 +30,11 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type HotelDataMergeClient interface {
 	GetHotels(ctx context.Context, in *GetHotelsRequest, opts ...grpc.CallOption) (*GetHotelsResponse, error)
+	GetHotel(ctx context.Context, in *GetHotelRequest, opts ...grpc.CallOption) (*Hotel, error)
+	ListDestinations(ctx context.Context, in *ListDestinationsRequest, opts ...grpc.CallOption) (*ListDestinationsResponse, error)
+	// ExportHotels streams every hotel of the snapshot being served, ordered by ID. The snapshot version is
+	// sent in the x-snapshot-version header. It is served over REST by GET /v1/exports/hotels instead of the gateway.
+	ExportHotels(ctx context.Context, in *ExportHotelsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Hotel], error)
 }
 
 type hotelDataMergeClient struct {
@@ -47,11 +55,55 @@ func (c *hotelDataMergeClient) GetHotels(ctx context.Context, in *GetHotelsReque
 	return out, nil
 }
 
+func (c *hotelDataMergeClient) GetHotel(ctx context.Context, in *GetHotelRequest, opts ...grpc.CallOption) (*Hotel, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Hotel)
+	err := c.cc.Invoke(ctx, HotelDataMerge_GetHotel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hotelDataMergeClient) ListDestinations(ctx context.Context, in *ListDestinationsRequest, opts ...grpc.CallOption) (*ListDestinationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDestinationsResponse)
+	err := c.cc.Invoke(ctx, HotelDataMerge_ListDestinations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hotelDataMergeClient) ExportHotels(ctx context.Context, in *ExportHotelsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Hotel], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &HotelDataMerge_ServiceDesc.Streams[0], HotelDataMerge_ExportHotels_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportHotelsRequest, Hotel]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type HotelDataMerge_ExportHotelsClient = grpc.ServerStreamingClient[Hotel]
+
 // HotelDataMergeServer is the server API for HotelDataMerge service.
 // All implementations must embed UnimplementedHotelDataMergeServer
 // for forward compatibility.
 type HotelDataMergeServer interface {
 	GetHotels(context.Context, *GetHotelsRequest) (*GetHotelsResponse, error)
+	GetHotel(context.Context, *GetHotelRequest) (*Hotel, error)
+	ListDestinations(context.Context, *ListDestinationsRequest) (*ListDestinationsResponse, error)
+	// ExportHotels streams every hotel of the snapshot being served, ordered by ID. The snapshot version is
+	// sent in the x-snapshot-version header. It is served over REST by GET /v1/exports/hotels instead of the gateway.
+	ExportHotels(*ExportHotelsRequest, grpc.ServerStreamingServer[Hotel]) error
 	mustEmbedUnimplementedHotelDataMergeServer()
 }
 
@@ -65,6 +117,15 @@ type UnimplementedHotelDataMergeServer struct{}
 func (UnimplementedHotelDataMergeServer) GetHotels(context.Context, *GetHotelsRequest) (*GetHotelsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetHotels not implemented")
 }
+func (UnimplementedHotelDataMergeServer) GetHotel(context.Context, *GetHotelRequest) (*Hotel, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHotel not implemented")
+}
+func (UnimplementedHotelDataMergeServer) ListDestinations(context.Context, *ListDestinationsRequest) (*ListDestinationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDestinations not implemented")
+}
+func (UnimplementedHotelDataMergeServer) ExportHotels(*ExportHotelsRequest, grpc.ServerStreamingServer[Hotel]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportHotels not implemented")
+}
 func (UnimplementedHotelDataMergeServer) mustEmbedUnimplementedHotelDataMergeServer() {}
 func (UnimplementedHotelDataMergeServer) testEmbeddedByValue()                        {}
 
@@ -104,6 +165,53 @@ func _HotelDataMerge_GetHotels_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HotelDataMerge_GetHotel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHotelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HotelDataMergeServer).GetHotel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HotelDataMerge_GetHotel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HotelDataMergeServer).GetHotel(ctx, req.(*GetHotelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HotelDataMerge_ListDestinations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDestinationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HotelDataMergeServer).ListDestinations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HotelDataMerge_ListDestinations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HotelDataMergeServer).ListDestinations(ctx, req.(*ListDestinationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HotelDataMerge_ExportHotels_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportHotelsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(HotelDataMergeServer).ExportHotels(m, &grpc.GenericServerStream[ExportHotelsRequest, Hotel]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type HotelDataMerge_ExportHotelsServer = grpc.ServerStreamingServer[Hotel]
+
 // HotelDataMerge_ServiceDesc is the grpc.ServiceDesc for HotelDataMerge service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -115,7 +223,21 @@ var HotelDataMerge_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetHotels",
 			Handler:    _HotelDataMerge_GetHotels_Handler,
 		},
+		{
+			MethodName: "GetHotel",
+			Handler:    _HotelDataMerge_GetHotel_Handler,
+		},
+		{
+			MethodName: "ListDestinations",
+			Handler:    _HotelDataMerge_ListDestinations_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportHotels",
+			Handler:       _HotelDataMerge_ExportHotels_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/hotelsdatamerge.proto",
 }
@@ -0,0 +1,91 @@
+package server
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// AccessLogOptions configures the access log written for every gRPC call
+type AccessLogOptions struct {
+	// DefaultLevel is the level calls are logged at, unless overridden in MethodLevels
+	DefaultLevel slog.Level
+	// MethodLevels sets the level per full method name, e.g. "/proto.HotelDataMerge/GetHotels".
+	// Calls failing with a server-side error are always logged at Error.
+	MethodLevels map[string]slog.Level
+	// MaxPayloadBytes bounds the request summary; longer summaries are truncated
+	MaxPayloadBytes int
+	// RedactFields lists the request field names, at any depth, whose values are not logged
+	RedactFields []string
+}
+
+// serverErrorCodes are logged at Error whatever the method's level
+var serverErrorCodes = map[codes.Code]bool{
+	codes.Unknown:  true,
+	codes.Internal: true,
+	codes.DataLoss: true,
+}
+
+// AccessLogUnaryInterceptor writes one structured log line per call with its method, status code,
+// duration, a size-bounded and redacted summary of the request and the size of the response
+func AccessLogUnaryInterceptor(logger *slog.Logger, options AccessLogOptions) grpc.UnaryServerInterceptor {
+	redact := redactSet(options.RedactFields)
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		startedAt := time.Now()
+		resp, err := handler(ctx, req)
+
+		code := status.Code(err)
+		level := options.level(info.FullMethod, code)
+		if !logger.Enabled(ctx, level) {
+			return resp, err
+		}
+		attrs := []slog.Attr{
+			slog.String("method", info.FullMethod),
+			slog.String("code", code.String()),
+			slog.Duration("duration", time.Since(startedAt)),
+			slog.String("request", summarizePayload(req, redact, options.MaxPayloadBytes)),
+		}
+		if err != nil {
+			attrs = append(attrs, slog.String("error", status.Convert(err).Message()))
+		} else if message, ok := resp.(protobuf.Message); ok {
+			attrs = append(attrs, slog.Int("responseBytes", protobuf.Size(message)))
+		}
+		logger.LogAttrs(ctx, level, "[gRPC] Request served", attrs...)
+		return resp, err
+	}
+}
+
+// AccessLogStreamInterceptor is the streaming counterpart of AccessLogUnaryInterceptor, without payloads
+func AccessLogStreamInterceptor(logger *slog.Logger, options AccessLogOptions) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		startedAt := time.Now()
+		err := handler(srv, ss)
+
+		code := status.Code(err)
+		attrs := []slog.Attr{
+			slog.String("method", info.FullMethod),
+			slog.String("code", code.String()),
+			slog.Duration("duration", time.Since(startedAt)),
+		}
+		if err != nil {
+			attrs = append(attrs, slog.String("error", status.Convert(err).Message()))
+		}
+		logger.LogAttrs(ss.Context(), options.level(info.FullMethod, code), "[gRPC] Stream served", attrs...)
+		return err
+	}
+}
+
+func (o AccessLogOptions) level(fullMethod string, code codes.Code) slog.Level {
+	if serverErrorCodes[code] {
+		return slog.LevelError
+	}
+	if level, ok := o.MethodLevels[fullMethod]; ok {
+		return level
+	}
+	return o.DefaultLevel
+}
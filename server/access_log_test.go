@@ -0,0 +1,124 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"testing"
+
+	"hotelsDataMerge/proto"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestAccessLogUnaryInterceptor(t *testing.T) {
+	const getHotel = "/proto.HotelDataMerge/GetHotel"
+	tests := []struct {
+		name         string
+		options      AccessLogOptions
+		resp         any
+		err          error
+		wantLogged   bool
+		wantLevel    string
+		wantCode     string
+		wantRequest  string
+		wantRespSize bool
+	}{
+		{
+			name:         "Success - Log successful call",
+			options:      AccessLogOptions{DefaultLevel: slog.LevelInfo, MaxPayloadBytes: 512},
+			resp:         &proto.Hotel{Id: "iJhz", Name: "Beach Villas"},
+			wantLogged:   true,
+			wantLevel:    "INFO",
+			wantCode:     "OK",
+			wantRequest:  `{"id":"iJhz"}`,
+			wantRespSize: true,
+		},
+		{
+			name:        "Success - Log client error at method level",
+			options:     AccessLogOptions{DefaultLevel: slog.LevelInfo, MaxPayloadBytes: 512},
+			err:         status.Error(codes.NotFound, "hotel 'iJhz' does not exist"),
+			wantLogged:  true,
+			wantLevel:   "INFO",
+			wantCode:    "NotFound",
+			wantRequest: `{"id":"iJhz"}`,
+		},
+		{
+			name: "Success - Skip call below per-method level",
+			options: AccessLogOptions{
+				DefaultLevel: slog.LevelInfo,
+				MethodLevels: map[string]slog.Level{getHotel: slog.LevelDebug},
+			},
+			resp:       &proto.Hotel{Id: "iJhz"},
+			wantLogged: false,
+		},
+		{
+			name: "Success - Log server error at Error whatever the method level",
+			options: AccessLogOptions{
+				DefaultLevel: slog.LevelInfo,
+				MethodLevels: map[string]slog.Level{getHotel: slog.LevelDebug},
+			},
+			err:         status.Error(codes.Internal, "internal error"),
+			wantLogged:  true,
+			wantLevel:   "ERROR",
+			wantCode:    "Internal",
+			wantRequest: `{"id":"iJhz"}`,
+		},
+		{
+			name: "Success - Redact request fields",
+			options: AccessLogOptions{
+				DefaultLevel: slog.LevelInfo,
+				RedactFields: []string{"id"},
+			},
+			resp:         &proto.Hotel{},
+			wantLogged:   true,
+			wantLevel:    "INFO",
+			wantCode:     "OK",
+			wantRequest:  `{"id":"[REDACTED]"}`,
+			wantRespSize: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			logger := slog.New(slog.NewJSONHandler(&buf, nil))
+			handler := func(ctx context.Context, req any) (any, error) { return tt.resp, tt.err }
+
+			_, err := AccessLogUnaryInterceptor(logger, tt.options)(context.Background(), &proto.GetHotelRequest{Id: "iJhz"}, &grpc.UnaryServerInfo{FullMethod: getHotel}, handler)
+			if err != tt.err {
+				t.Fatalf("AccessLogUnaryInterceptor() error = %v, want %v", err, tt.err)
+			}
+			if !tt.wantLogged {
+				if buf.Len() > 0 {
+					t.Errorf("AccessLogUnaryInterceptor() logged %q, want nothing", buf.String())
+				}
+				return
+			}
+
+			var line map[string]any
+			if err := json.Unmarshal(buf.Bytes(), &line); err != nil {
+				t.Fatalf("log line %q is not JSON: %v", buf.String(), err)
+			}
+			if line["level"] != tt.wantLevel || line["code"] != tt.wantCode || line["method"] != getHotel {
+				t.Errorf("log line = %v, want level %s, code %s and method %s", line, tt.wantLevel, tt.wantCode, getHotel)
+			}
+			if request, _ := line["request"].(string); compactJSON(request) != tt.wantRequest {
+				t.Errorf("log line request = %q, want %q", request, tt.wantRequest)
+			}
+			if _, ok := line["responseBytes"]; ok != tt.wantRespSize {
+				t.Errorf("log line responseBytes present = %v, want %v", ok, tt.wantRespSize)
+			}
+		})
+	}
+}
+
+func compactJSON(s string) string {
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, []byte(s)); err != nil {
+		return s
+	}
+	return buf.String()
+}
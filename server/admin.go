@@ -0,0 +1,168 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"hotelsDataMerge/internal/pipeline"
+	"hotelsDataMerge/internal/suppliers/utils"
+	"hotelsDataMerge/proto"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	refreshStates = map[pipeline.RunState]proto.RefreshState{
+		pipeline.RunRunning:            proto.RefreshState_REFRESH_STATE_RUNNING,
+		pipeline.RunSucceeded:          proto.RefreshState_REFRESH_STATE_SUCCEEDED,
+		pipeline.RunPartiallySucceeded: proto.RefreshState_REFRESH_STATE_PARTIALLY_SUCCEEDED,
+		pipeline.RunFailed:             proto.RefreshState_REFRESH_STATE_FAILED,
+	}
+	supplierStates = map[pipeline.SupplierState]proto.SupplierState{
+		pipeline.SupplierOK:      proto.SupplierState_SUPPLIER_STATE_OK,
+		pipeline.SupplierFailed:  proto.SupplierState_SUPPLIER_STATE_FAILED,
+		pipeline.SupplierSkipped: proto.SupplierState_SUPPLIER_STATE_SKIPPED,
+	}
+)
+
+type adminService struct {
+	logger          *slog.Logger
+	pipeline        pipeline.IntPipeline
+	suppliersURLMap map[utils.Suppliers]string
+	proto.UnimplementedHotelDataMergeAdminServer
+}
+
+// NewAdminService returns the admin API; suppliersURLMap holds the URLs the suppliers are fetched from
+func NewAdminService(logger *slog.Logger, intPipeline pipeline.IntPipeline, suppliersURLMap map[utils.Suppliers]string) proto.HotelDataMergeAdminServer {
+	return &adminService{
+		logger:          logger,
+		pipeline:        intPipeline,
+		suppliersURLMap: suppliersURLMap,
+	}
+}
+
+func (a *adminService) TriggerRefresh(ctx context.Context, req *proto.TriggerRefreshRequest) (*proto.TriggerRefreshResponse, error) {
+	supplierNames := make([]utils.Suppliers, 0, len(req.GetSuppliers()))
+	for _, supplierName := range req.GetSuppliers() {
+		supplierNames = append(supplierNames, utils.Suppliers(supplierName))
+	}
+
+	run, err := a.pipeline.TriggerRefresh(ctx, pipeline.TriggerAdmin, supplierNames, !req.GetAsync())
+	switch {
+	case errors.Is(err, pipeline.ErrUnknownSupplier):
+		return nil, newInvalidArgumentError("invalid suppliers", &errdetails.BadRequest_FieldViolation{
+			Field:       "suppliers",
+			Description: err.Error(),
+		})
+	case errors.Is(err, pipeline.ErrRefreshInProgress):
+		return nil, status.Error(codes.Aborted, err.Error())
+	case errors.Is(err, pipeline.ErrShuttingDown):
+		return nil, status.Error(codes.Unavailable, err.Error())
+	case err != nil:
+		a.logger.Error("[Admin] Failed to trigger refresh", "error", err)
+		return nil, status.Error(codes.Internal, "failed to trigger refresh")
+	}
+
+	a.logger.Info("[Admin] Refresh triggered", "run", run.ID, "suppliers", req.GetSuppliers(), "async", req.GetAsync())
+	return &proto.TriggerRefreshResponse{Run: constructRefreshRun(&run)}, nil
+}
+
+func (a *adminService) GetRefreshStatus(ctx context.Context, req *proto.GetRefreshStatusRequest) (*proto.GetRefreshStatusResponse, error) {
+	current, last := a.pipeline.GetStatus()
+	return &proto.GetRefreshStatusResponse{
+		Current: constructRefreshRun(current),
+		Last:    constructRefreshRun(last),
+	}, nil
+}
+
+func (a *adminService) ListSuppliers(ctx context.Context, req *proto.ListSuppliersRequest) (*proto.ListSuppliersResponse, error) {
+	statuses := a.pipeline.ListSuppliers()
+
+	resp := &proto.ListSuppliersResponse{Suppliers: make([]*proto.Supplier, 0, len(statuses))}
+	for i, supplierStatus := range statuses {
+		resp.Suppliers = append(resp.Suppliers, &proto.Supplier{
+			Name:       string(supplierStatus.Supplier),
+			Url:        a.suppliersURLMap[supplierStatus.Supplier],
+			Priority:   int32(i + 1),
+			LastStatus: constructSupplierRefreshStatus(supplierStatus),
+		})
+	}
+	return resp, nil
+}
+
+func (a *adminService) GetQualityReport(ctx context.Context, req *proto.GetQualityReportRequest) (*proto.GetQualityReportResponse, error) {
+	qualityReport, ok := a.pipeline.GetQualityReport()
+	if !ok {
+		return &proto.GetQualityReportResponse{}, nil
+	}
+	return &proto.GetQualityReportResponse{Report: constructQualityReport(qualityReport)}, nil
+}
+
+func constructRefreshRun(run *pipeline.Run) *proto.RefreshRun {
+	if run == nil {
+		return nil
+	}
+	refreshRun := &proto.RefreshRun{
+		Id:         run.ID,
+		Trigger:    string(run.Trigger),
+		State:      refreshStates[run.State],
+		StartedAt:  timestamppb.New(run.StartedAt),
+		FinishedAt: constructTimestamp(run.FinishedAt),
+		Duration:   durationpb.New(run.Duration()),
+		HotelCount: int32(run.HotelCount),
+		Error:      run.Error,
+	}
+	for _, supplierStatus := range run.Suppliers {
+		refreshRun.Suppliers = append(refreshRun.Suppliers, constructSupplierRefreshStatus(supplierStatus))
+	}
+	return refreshRun
+}
+
+func constructSupplierRefreshStatus(supplierStatus pipeline.SupplierStatus) *proto.SupplierRefreshStatus {
+	refreshStatus := &proto.SupplierRefreshStatus{
+		Supplier:      string(supplierStatus.Supplier),
+		State:         supplierStates[supplierStatus.State],
+		RecordCount:   int32(supplierStatus.RecordCount),
+		LastSuccessAt: constructTimestamp(supplierStatus.LastSuccessAt),
+		Error:         supplierStatus.Error,
+	}
+	if supplierStatus.State == pipeline.SupplierOK || supplierStatus.State == pipeline.SupplierFailed {
+		refreshStatus.FetchDuration = durationpb.New(supplierStatus.FetchDuration)
+	}
+	return refreshStatus
+}
+
+func constructQualityReport(qualityReport pipeline.QualityReport) *proto.QualityReport {
+	report := &proto.QualityReport{
+		RunId:             qualityReport.Run,
+		GeneratedAt:       constructTimestamp(qualityReport.GeneratedAt),
+		Issues:            make([]*proto.QualityIssue, 0, len(qualityReport.Issues)),
+		UnmappedAmenities: qualityReport.UnmappedAmenities,
+		IssueCounts:       make(map[string]int32),
+	}
+	for _, issue := range qualityReport.Issues {
+		report.Issues = append(report.Issues, &proto.QualityIssue{
+			HotelId: issue.HotelID,
+			Kind:    string(issue.Kind),
+			Detail:  issue.Detail,
+		})
+	}
+	for kind, count := range qualityReport.CountByKind() {
+		report.IssueCounts[string(kind)] = int32(count)
+	}
+	return report
+}
+
+// constructTimestamp leaves unset times unset instead of sending year 1
+func constructTimestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
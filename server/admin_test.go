@@ -0,0 +1,264 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"testing"
+	"time"
+
+	"hotelsDataMerge/external"
+	"hotelsDataMerge/internal/pipeline"
+	"hotelsDataMerge/internal/suppliers/quality"
+	"hotelsDataMerge/internal/suppliers/utils"
+	"hotelsDataMerge/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	protobuf "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	testRunStartedAt  = time.Date(2026, 10, 19, 8, 0, 0, 0, time.UTC)
+	testRunFinishedAt = testRunStartedAt.Add(3 * time.Second)
+	testRun           = pipeline.Run{
+		ID:         7,
+		Trigger:    pipeline.TriggerAdmin,
+		State:      pipeline.RunPartiallySucceeded,
+		StartedAt:  testRunStartedAt,
+		FinishedAt: testRunFinishedAt,
+		Suppliers: []pipeline.SupplierStatus{
+			{Supplier: utils.Paperflies, State: pipeline.SupplierOK, RecordCount: 2, FetchDuration: time.Second, LastSuccessAt: testRunFinishedAt},
+			{Supplier: utils.Patagonia, State: pipeline.SupplierSkipped, RecordCount: 1},
+			{Supplier: utils.Acme, State: pipeline.SupplierFailed, FetchDuration: 2 * time.Second, Error: "timeout"},
+		},
+		HotelCount: 3,
+	}
+	testRefreshRun = &proto.RefreshRun{
+		Id:         7,
+		Trigger:    "admin",
+		State:      proto.RefreshState_REFRESH_STATE_PARTIALLY_SUCCEEDED,
+		StartedAt:  timestamppb.New(testRunStartedAt),
+		FinishedAt: timestamppb.New(testRunFinishedAt),
+		Duration:   durationpb.New(3 * time.Second),
+		Suppliers: []*proto.SupplierRefreshStatus{
+			{Supplier: "paperflies", State: proto.SupplierState_SUPPLIER_STATE_OK, RecordCount: 2, FetchDuration: durationpb.New(time.Second), LastSuccessAt: timestamppb.New(testRunFinishedAt)},
+			{Supplier: "patagonia", State: proto.SupplierState_SUPPLIER_STATE_SKIPPED, RecordCount: 1},
+			{Supplier: "acme", State: proto.SupplierState_SUPPLIER_STATE_FAILED, FetchDuration: durationpb.New(2 * time.Second), Error: "timeout"},
+		},
+		HotelCount: 3,
+	}
+)
+
+type mockPipeline struct {
+	run       pipeline.Run
+	err       error
+	current   *pipeline.Run
+	last      *pipeline.Run
+	suppliers []pipeline.SupplierStatus
+	snapshot  *pipeline.Snapshot
+	quality   *pipeline.QualityReport
+
+	gotSupplierNames []utils.Suppliers
+	gotWait          bool
+}
+
+func (m *mockPipeline) TriggerRefresh(ctx context.Context, trigger pipeline.Trigger, supplierNames []utils.Suppliers, wait bool) (pipeline.Run, error) {
+	m.gotSupplierNames, m.gotWait = supplierNames, wait
+	return m.run, m.err
+}
+
+func (m *mockPipeline) GetStatus() (*pipeline.Run, *pipeline.Run) {
+	return m.current, m.last
+}
+
+func (m *mockPipeline) ListSuppliers() []pipeline.SupplierStatus {
+	return m.suppliers
+}
+
+func (m *mockPipeline) GetSnapshot() (pipeline.Snapshot, bool) {
+	if m.snapshot == nil {
+		return pipeline.Snapshot{}, false
+	}
+	return *m.snapshot, true
+}
+
+func (m *mockPipeline) GetQualityReport() (pipeline.QualityReport, bool) {
+	if m.quality == nil {
+		return pipeline.QualityReport{}, false
+	}
+	return *m.quality, true
+}
+
+func (m *mockPipeline) RunScheduler(ctx context.Context, interval time.Duration) {}
+
+func (m *mockPipeline) Shutdown(ctx context.Context) error {
+	return nil
+}
+
+func Test_adminService_TriggerRefresh(t *testing.T) {
+	tests := []struct {
+		name     string
+		req      *proto.TriggerRefreshRequest
+		pipeline *mockPipeline
+		want     *proto.TriggerRefreshResponse
+		wantWait bool
+		wantCode codes.Code
+	}{
+		{
+			name:     "Success - Wait for refresh",
+			req:      &proto.TriggerRefreshRequest{Suppliers: []string{"acme"}},
+			pipeline: &mockPipeline{run: testRun},
+			want:     &proto.TriggerRefreshResponse{Run: testRefreshRun},
+			wantWait: true,
+			wantCode: codes.OK,
+		},
+		{
+			name:     "Success - Async refresh",
+			req:      &proto.TriggerRefreshRequest{Async: true},
+			pipeline: &mockPipeline{run: testRun},
+			want:     &proto.TriggerRefreshResponse{Run: testRefreshRun},
+			wantWait: false,
+			wantCode: codes.OK,
+		},
+		{
+			name:     "Error - Unknown supplier",
+			req:      &proto.TriggerRefreshRequest{Suppliers: []string{"unknown"}},
+			pipeline: &mockPipeline{err: fmt.Errorf("%w %q", pipeline.ErrUnknownSupplier, "unknown")},
+			want:     nil,
+			wantWait: true,
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "Error - Refresh in progress",
+			req:      &proto.TriggerRefreshRequest{},
+			pipeline: &mockPipeline{err: pipeline.ErrRefreshInProgress},
+			want:     nil,
+			wantWait: true,
+			wantCode: codes.Aborted,
+		},
+		{
+			name:     "Error - Shutting down",
+			req:      &proto.TriggerRefreshRequest{},
+			pipeline: &mockPipeline{err: pipeline.ErrShuttingDown},
+			want:     nil,
+			wantWait: true,
+			wantCode: codes.Unavailable,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := &adminService{logger: slog.Default(), pipeline: tt.pipeline}
+			got, err := a.TriggerRefresh(context.Background(), tt.req)
+			if code := status.Code(err); code != tt.wantCode {
+				t.Fatalf("TriggerRefresh() code = %v, wantCode %v (%v)", code, tt.wantCode, err)
+			}
+			if !protobuf.Equal(got, tt.want) {
+				t.Errorf("TriggerRefresh() = %v, want %v", got, tt.want)
+			}
+			if tt.pipeline.gotWait != tt.wantWait {
+				t.Errorf("TriggerRefresh() wait = %v, want %v", tt.pipeline.gotWait, tt.wantWait)
+			}
+		})
+	}
+}
+
+func Test_adminService_GetRefreshStatus(t *testing.T) {
+	a := &adminService{logger: slog.Default(), pipeline: &mockPipeline{last: &testRun}}
+	got, err := a.GetRefreshStatus(context.Background(), &proto.GetRefreshStatusRequest{})
+	if err != nil {
+		t.Fatalf("GetRefreshStatus() error = %v", err)
+	}
+	want := &proto.GetRefreshStatusResponse{Last: testRefreshRun}
+	if !protobuf.Equal(got, want) {
+		t.Errorf("GetRefreshStatus() = %v, want %v", got, want)
+	}
+}
+
+func Test_adminService_ListSuppliers(t *testing.T) {
+	a := &adminService{logger: slog.Default(), pipeline: &mockPipeline{suppliers: []pipeline.SupplierStatus{
+		{Supplier: utils.Paperflies, State: pipeline.SupplierOK, RecordCount: 2, FetchDuration: time.Second, LastSuccessAt: testRunFinishedAt},
+		{Supplier: utils.Patagonia},
+	}}, suppliersURLMap: external.SuppliersURLMap(map[utils.Suppliers]string{utils.Patagonia: "http://localhost:9000/patagonia"})}
+	got, err := a.ListSuppliers(context.Background(), &proto.ListSuppliersRequest{})
+	if err != nil {
+		t.Fatalf("ListSuppliers() error = %v", err)
+	}
+	want := &proto.ListSuppliersResponse{Suppliers: []*proto.Supplier{
+		{
+			Name:     "paperflies",
+			Url:      "https://5f2be0b4ffc88500167b85a0.mockapi.io/suppliers/paperflies",
+			Priority: 1,
+			LastStatus: &proto.SupplierRefreshStatus{
+				Supplier:      "paperflies",
+				State:         proto.SupplierState_SUPPLIER_STATE_OK,
+				RecordCount:   2,
+				FetchDuration: durationpb.New(time.Second),
+				LastSuccessAt: timestamppb.New(testRunFinishedAt),
+			},
+		},
+		{
+			Name:       "patagonia",
+			Url:        "http://localhost:9000/patagonia",
+			Priority:   2,
+			LastStatus: &proto.SupplierRefreshStatus{Supplier: "patagonia"},
+		},
+	}}
+	if !protobuf.Equal(got, want) {
+		t.Errorf("ListSuppliers() = %v, want %v", got, want)
+	}
+}
+
+func Test_adminService_GetQualityReport(t *testing.T) {
+	tests := []struct {
+		name     string
+		pipeline *mockPipeline
+		want     *proto.GetQualityReportResponse
+	}{
+		{
+			name: "Success - Report of the last merge",
+			pipeline: &mockPipeline{quality: &pipeline.QualityReport{
+				Run: 7,
+				Report: quality.Report{
+					GeneratedAt: testRunFinishedAt,
+					Issues: []quality.Issue{
+						{HotelID: "iJhz", Kind: quality.IssueNullIsland, Detail: "dropped coordinates (0, 0)"},
+						{HotelID: "iJhz", Kind: quality.IssueUnmappedAmenity, Detail: `amenity "rooftop garden" is not in taxonomy 2026.10.1`},
+						{HotelID: "SjyX", Kind: quality.IssueUnmappedAmenity, Detail: `amenity "heated floor" is not in taxonomy 2026.10.1`},
+					},
+					UnmappedAmenities: []string{"heated floor", "rooftop garden"},
+				},
+			}},
+			want: &proto.GetQualityReportResponse{Report: &proto.QualityReport{
+				RunId:       7,
+				GeneratedAt: timestamppb.New(testRunFinishedAt),
+				Issues: []*proto.QualityIssue{
+					{HotelId: "iJhz", Kind: "null_island", Detail: "dropped coordinates (0, 0)"},
+					{HotelId: "iJhz", Kind: "unmapped_amenity", Detail: `amenity "rooftop garden" is not in taxonomy 2026.10.1`},
+					{HotelId: "SjyX", Kind: "unmapped_amenity", Detail: `amenity "heated floor" is not in taxonomy 2026.10.1`},
+				},
+				UnmappedAmenities: []string{"heated floor", "rooftop garden"},
+				IssueCounts:       map[string]int32{"null_island": 1, "unmapped_amenity": 2},
+			}},
+		},
+		{
+			name:     "Success - No merge yet",
+			pipeline: &mockPipeline{},
+			want:     &proto.GetQualityReportResponse{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := &adminService{logger: slog.Default(), pipeline: tt.pipeline}
+			got, err := a.GetQualityReport(context.Background(), &proto.GetQualityReportRequest{})
+			if err != nil {
+				t.Fatalf("GetQualityReport() error = %v", err)
+			}
+			if !protobuf.Equal(got, tt.want) {
+				t.Errorf("GetQualityReport() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,128 @@
+package server
+
+import (
+	_ "embed"
+	"encoding/json"
+	"net/http"
+	"slices"
+
+	"hotelsDataMerge/internal/openapi"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	errorSchemaName  = "Error"
+	apiKeySchemeName = "apiKey"
+	bearerSchemeName = "bearerAuth"
+	// explorerPolicy only lets the explorer run its own inline script and call this origin
+	explorerPolicy = "default-src 'none'; script-src 'unsafe-inline'; style-src 'unsafe-inline'; connect-src 'self'"
+)
+
+//go:embed static/explorer.html
+var explorerPage []byte
+
+// OpenAPIHandler serves the OpenAPI document of the REST gateway, generated once from the
+// google.api.http annotations of the services
+func OpenAPIHandler(info openapi.Info, services ...protoreflect.ServiceDescriptor) (http.Handler, error) {
+	document, err := openapi.Generate(info, services...)
+	if err != nil {
+		return nil, err
+	}
+	describeGateway(document)
+	body, err := json.Marshal(document)
+	if err != nil {
+		return nil, err
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(body)
+	}), nil
+}
+
+// ExplorerHandler serves the API explorer, a single page that reads /openapi.json and sends requests
+// to the gateway; it is embedded in the binary and loads nothing from the network
+func ExplorerHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Header().Set("Content-Security-Policy", explorerPolicy)
+		_, _ = w.Write(explorerPage)
+	})
+}
+
+// describeGateway adds what the gateway handles outside the proto files: the export endpoint, the error
+// body written by HTTPErrorHandler, the "fields" alias of read_mask and the accepted credentials
+func describeGateway(document *openapi.Document) {
+	document.Paths["/v1/exports/hotels"] = &openapi.PathItem{Get: exportOperation()}
+	document.Components.Schemas[errorSchemaName] = &openapi.Schema{
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"error": {
+				Type: "object",
+				Properties: map[string]*openapi.Schema{
+					"code":    {Type: "integer", Format: "int32", Description: "HTTP status code"},
+					"status":  {Type: "string", Description: "gRPC status code name, e.g. NOT_FOUND"},
+					"message": {Type: "string"},
+					"details": {Type: "array", Items: &openapi.Schema{Type: "object"}},
+				},
+			},
+		},
+	}
+	document.Components.SecuritySchemes = map[string]*openapi.SecurityScheme{
+		apiKeySchemeName: {Type: "apiKey", Name: "X-Api-Key", In: "header"},
+		bearerSchemeName: {Type: "http", Scheme: "bearer", BearerFormat: "JWT"},
+	}
+	document.Security = []openapi.SecurityRequirement{
+		{apiKeySchemeName: {}},
+		{bearerSchemeName: {}},
+	}
+
+	for _, operation := range document.Operations() {
+		operation.Responses["default"] = &openapi.Response{
+			Description: "An error, with the gRPC status of the call.",
+			Content: map[string]openapi.MediaType{
+				"application/json": {Schema: &openapi.Schema{Ref: "#/components/schemas/" + errorSchemaName}},
+			},
+		}
+		for _, parameter := range operation.Parameters {
+			if parameter.In == "query" && parameter.Name == "readMask" {
+				operation.Parameters = append(operation.Parameters, openapi.Parameter{
+					Name:        fieldsQueryName,
+					In:          "query",
+					Description: "Alias of readMask, e.g. fields=id,name",
+					Schema:      &openapi.Schema{Type: "string"},
+				})
+				break
+			}
+		}
+	}
+}
+
+// exportOperation describes ExportHandler, which streams the hotels in one of the exportFormats
+func exportOperation() *openapi.Operation {
+	formats := make([]string, 0, len(exportFormats))
+	content := make(map[string]openapi.MediaType, len(exportFormats))
+	for name, format := range exportFormats {
+		formats = append(formats, name)
+		content[format.contentType] = openapi.MediaType{Schema: &openapi.Schema{Type: "string", Format: "binary"}}
+	}
+	slices.Sort(formats)
+	return &openapi.Operation{
+		OperationID: "HotelDataMerge_ExportHotels",
+		Summary:     "ExportHotels",
+		Tags:        []string{"HotelDataMerge"},
+		Parameters: []openapi.Parameter{
+			{Name: exportFormatQueryName, In: "query", Description: "Export format, " + defaultExportFormat + " by default", Schema: &openapi.Schema{Type: "string", Enum: formats}},
+			{Name: countryFormatQuery, In: "query", Schema: &openapi.Schema{Ref: "#/components/schemas/proto.CountryFormat"}},
+		},
+		Responses: map[string]*openapi.Response{
+			"200": {
+				Description: "Every hotel of the snapshot being served, ordered by ID.",
+				Headers: map[string]*openapi.Header{
+					"X-Snapshot-Version": {Description: "Version of the exported snapshot", Schema: &openapi.Schema{Type: "string"}},
+				},
+				Content: content,
+			},
+		},
+	}
+}
@@ -0,0 +1,89 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"hotelsDataMerge/internal/openapi"
+	"hotelsDataMerge/proto"
+)
+
+func TestOpenAPIHandler(t *testing.T) {
+	handler, err := OpenAPIHandler(openapi.Info{Title: "Hotels", Version: "v1"}, proto.File_proto_hotelsdatamerge_proto.Services().Get(0))
+	if err != nil {
+		t.Fatalf("OpenAPIHandler() error = %v", err)
+	}
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/openapi.json", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("OpenAPIHandler() status = %v, want %v", w.Code, http.StatusOK)
+	}
+	if contentType := w.Header().Get("Content-Type"); contentType != "application/json" {
+		t.Errorf("OpenAPIHandler() Content-Type = %q, want application/json", contentType)
+	}
+
+	var document openapi.Document
+	if err := json.Unmarshal(w.Body.Bytes(), &document); err != nil {
+		t.Fatalf("OpenAPIHandler() body is not a document: %v", err)
+	}
+
+	tests := []struct {
+		name          string
+		path          string
+		wantFields    bool
+		wantParameter string
+	}{
+		{name: "Success - Hotels with fields alias", path: "/v1/hotels", wantFields: true, wantParameter: "destinationId"},
+		{name: "Success - Hotel with fields alias", path: "/v1/hotels/{id}", wantFields: true, wantParameter: "id"},
+		{name: "Success - Destinations without fields alias", path: "/v1/destinations", wantFields: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pathItem, ok := document.Paths[tt.path]
+			if !ok || pathItem.Get == nil {
+				t.Fatalf("OpenAPIHandler() has no GET %s", tt.path)
+			}
+			operation := pathItem.Get
+			if errorResponse := operation.Responses["default"]; errorResponse == nil ||
+				errorResponse.Content["application/json"].Schema.Ref != "#/components/schemas/"+errorSchemaName {
+				t.Errorf("OpenAPIHandler() GET %s default response = %+v, want the %s schema", tt.path, errorResponse, errorSchemaName)
+			}
+			hasParameter := func(name string) bool {
+				return slices.ContainsFunc(operation.Parameters, func(p openapi.Parameter) bool { return p.Name == name })
+			}
+			if got := hasParameter(fieldsQueryName); got != tt.wantFields {
+				t.Errorf("OpenAPIHandler() GET %s has fields = %v, want %v", tt.path, got, tt.wantFields)
+			}
+			if len(tt.wantParameter) > 0 && !hasParameter(tt.wantParameter) {
+				t.Errorf("OpenAPIHandler() GET %s has no %s parameter", tt.path, tt.wantParameter)
+			}
+		})
+	}
+
+	if _, ok := document.Components.SecuritySchemes[apiKeySchemeName]; !ok {
+		t.Errorf("OpenAPIHandler() has no %s security scheme", apiKeySchemeName)
+	}
+	if len(document.Security) != 2 {
+		t.Errorf("OpenAPIHandler() security = %+v, want the API key or a bearer token", document.Security)
+	}
+}
+
+func TestExplorerHandler(t *testing.T) {
+	w := httptest.NewRecorder()
+	ExplorerHandler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/explorer", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("ExplorerHandler() status = %v, want %v", w.Code, http.StatusOK)
+	}
+	if policy := w.Header().Get("Content-Security-Policy"); policy != explorerPolicy {
+		t.Errorf("ExplorerHandler() Content-Security-Policy = %q, want %q", policy, explorerPolicy)
+	}
+	// The page must work offline, so it only loads what is served by the gateway
+	body := w.Body.String()
+	if !strings.Contains(body, `fetch("/openapi.json")`) || strings.Contains(body, "http://") || strings.Contains(body, "https://") {
+		t.Errorf("ExplorerHandler() body does not load /openapi.json only")
+	}
+}
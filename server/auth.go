@@ -0,0 +1,135 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"hotelsDataMerge/internal/auth"
+	"hotelsDataMerge/proto"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	reflectionpb "google.golang.org/grpc/reflection/grpc_reflection_v1"
+	reflectionpbalpha "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	apiKeyHeader        = "x-api-key"
+	authorizationHeader = "authorization"
+	// wwwAuthenticateHeader carries the authentication challenges of UNAUTHENTICATED errors, as in HTTP
+	wwwAuthenticateHeader = "www-authenticate"
+	// authRealm is the realm of the authentication challenges
+	authRealm = "hotelsDataMerge"
+)
+
+// methodScopes is the scope each RPC requires. Methods that are not listed are denied.
+var methodScopes = map[string]string{
+	proto.HotelDataMerge_GetHotels_FullMethodName:             auth.ScopeHotelsRead,
+	proto.HotelDataMerge_GetHotel_FullMethodName:              auth.ScopeHotelsRead,
+	proto.HotelDataMerge_ListDestinations_FullMethodName:      auth.ScopeHotelsRead,
+	proto.HotelDataMerge_ExportHotels_FullMethodName:          auth.ScopeHotelsRead,
+	proto.HotelDataMergeAdmin_TriggerRefresh_FullMethodName:   auth.ScopeAdminRefresh,
+	proto.HotelDataMergeAdmin_GetRefreshStatus_FullMethodName: auth.ScopeAdminRead,
+	proto.HotelDataMergeAdmin_ListSuppliers_FullMethodName:    auth.ScopeAdminRead,
+	proto.HotelDataMergeAdmin_GetQualityReport_FullMethodName: auth.ScopeAdminRead,
+}
+
+// publicMethods are served without credentials or rate limits, so that orchestrators can probe the service
+// and tools such as grpcurl can describe it when server reflection is enabled
+var publicMethods = map[string]bool{
+	healthpb.Health_Check_FullMethodName:                                   true,
+	healthpb.Health_Watch_FullMethodName:                                   true,
+	reflectionpb.ServerReflection_ServerReflectionInfo_FullMethodName:      true,
+	reflectionpbalpha.ServerReflection_ServerReflectionInfo_FullMethodName: true,
+}
+
+// AuthUnaryInterceptor authenticates the x-api-key or "authorization: Bearer <JWT>" credentials of every call,
+// checks the client has the scope the method requires and stores the client in the context.
+// A nil authenticator disables authentication, which is only meant for local development.
+func AuthUnaryInterceptor(logger *slog.Logger, authenticator auth.IntAuthenticator) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, err := authorize(ctx, logger, authenticator, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// AuthStreamInterceptor is the streaming counterpart of AuthUnaryInterceptor
+func AuthStreamInterceptor(logger *slog.Logger, authenticator auth.IntAuthenticator) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := authorize(ss.Context(), logger, authenticator, info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &contextServerStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+func authorize(ctx context.Context, logger *slog.Logger, authenticator auth.IntAuthenticator, fullMethod string) (context.Context, error) {
+	if authenticator == nil || publicMethods[fullMethod] {
+		return ctx, nil
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	credentials := credentialsFromMetadata(md)
+	principal, err := authenticator.Authenticate(credentials)
+	switch {
+	case errors.Is(err, auth.ErrNoCredentials):
+		setAuthChallenges(ctx, authenticator.Methods(), false)
+		return ctx, status.Error(codes.Unauthenticated, "missing credentials: send an x-api-key or an authorization bearer token")
+	case err != nil:
+		logger.WarnContext(ctx, "[Auth] Authentication failed", "method", fullMethod, "error", err)
+		setAuthChallenges(ctx, authenticator.Methods(), len(credentials.BearerToken) > 0)
+		return ctx, status.Error(codes.Unauthenticated, "invalid credentials")
+	}
+
+	scope, ok := methodScopes[fullMethod]
+	if !ok || !principal.HasScope(scope) {
+		logger.WarnContext(ctx, "[Auth] Permission denied", "method", fullMethod, "clientId", principal.ClientID, "scope", scope)
+		return ctx, status.Errorf(codes.PermissionDenied, "client %q is missing scope %q", principal.ClientID, scope)
+	}
+	return auth.NewContext(ctx, principal), nil
+}
+
+// setAuthChallenges sends one RFC 7235 challenge per configured authentication method. As in RFC 6750,
+// the Bearer challenge carries an invalid_token error only when a rejected bearer token was sent.
+func setAuthChallenges(ctx context.Context, methods []auth.Method, invalidToken bool) {
+	challenges := make([]string, 0, len(methods))
+	for _, method := range methods {
+		switch method {
+		case auth.MethodAPIKey:
+			challenges = append(challenges, fmt.Sprintf("ApiKey realm=%q", authRealm))
+		case auth.MethodJWT:
+			challenge := fmt.Sprintf("Bearer realm=%q", authRealm)
+			if invalidToken {
+				challenge += `, error="invalid_token"`
+			}
+			challenges = append(challenges, challenge)
+		}
+	}
+	if len(challenges) > 0 {
+		_ = grpc.SetHeader(ctx, metadata.Pairs(wwwAuthenticateHeader, strings.Join(challenges, ", ")))
+	}
+}
+
+func credentialsFromMetadata(md metadata.MD) auth.Credentials {
+	var credentials auth.Credentials
+	if apiKeys := md.Get(apiKeyHeader); len(apiKeys) > 0 {
+		credentials.APIKey = apiKeys[0]
+	}
+	for _, value := range md.Get(authorizationHeader) {
+		if token, ok := strings.CutPrefix(value, "Bearer "); ok {
+			credentials.BearerToken = strings.TrimSpace(token)
+			break
+		}
+	}
+	return credentials
+}
@@ -0,0 +1,208 @@
+package server
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"reflect"
+	"testing"
+
+	"hotelsDataMerge/internal/auth"
+	"hotelsDataMerge/proto"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	reflectionpb "google.golang.org/grpc/reflection/grpc_reflection_v1"
+	"google.golang.org/grpc/status"
+)
+
+type mockAuthenticator struct {
+	principals map[string]auth.Principal
+}
+
+func (m *mockAuthenticator) Authenticate(credentials auth.Credentials) (auth.Principal, error) {
+	if len(credentials.APIKey) == 0 && len(credentials.BearerToken) == 0 {
+		return auth.Principal{}, auth.ErrNoCredentials
+	}
+	principal, ok := m.principals[credentials.APIKey+credentials.BearerToken]
+	if !ok {
+		return auth.Principal{}, auth.ErrInvalidCredentials
+	}
+	return principal, nil
+}
+
+func (m *mockAuthenticator) Methods() []auth.Method {
+	return []auth.Method{auth.MethodAPIKey, auth.MethodJWT}
+}
+
+func TestAuthUnaryInterceptor(t *testing.T) {
+	authenticator := &mockAuthenticator{principals: map[string]auth.Principal{
+		"reader-key": {ClientID: "reader", Method: auth.MethodAPIKey, Scopes: []string{auth.ScopeHotelsRead}},
+		"admin-jwt":  {ClientID: "admin", Method: auth.MethodJWT, Scopes: []string{auth.ScopeAdminRead, auth.ScopeAdminRefresh}},
+	}}
+
+	tests := []struct {
+		name          string
+		authenticator auth.IntAuthenticator
+		md            metadata.MD
+		method        string
+		wantCode      codes.Code
+		wantClientID  string
+		wantChallenge string
+	}{
+		{
+			name:          "Success - API key with required scope",
+			authenticator: authenticator,
+			md:            metadata.Pairs(apiKeyHeader, "reader-key"),
+			method:        proto.HotelDataMerge_GetHotels_FullMethodName,
+			wantCode:      codes.OK,
+			wantClientID:  "reader",
+		},
+		{
+			name:          "Success - Bearer token with required scope",
+			authenticator: authenticator,
+			md:            metadata.Pairs(authorizationHeader, "Bearer admin-jwt"),
+			method:        proto.HotelDataMergeAdmin_TriggerRefresh_FullMethodName,
+			wantCode:      codes.OK,
+			wantClientID:  "admin",
+		},
+		{
+			name:          "Success - Authentication disabled",
+			authenticator: nil,
+			md:            metadata.MD{},
+			method:        proto.HotelDataMergeAdmin_TriggerRefresh_FullMethodName,
+			wantCode:      codes.OK,
+		},
+		{
+			name:          "Success - Health check without credentials",
+			authenticator: authenticator,
+			md:            metadata.MD{},
+			method:        healthpb.Health_Check_FullMethodName,
+			wantCode:      codes.OK,
+		},
+		{
+			name:          "Success - Server reflection without credentials",
+			authenticator: authenticator,
+			md:            metadata.MD{},
+			method:        reflectionpb.ServerReflection_ServerReflectionInfo_FullMethodName,
+			wantCode:      codes.OK,
+		},
+		{
+			name:          "Error - Missing credentials",
+			authenticator: authenticator,
+			md:            metadata.MD{},
+			method:        proto.HotelDataMerge_GetHotels_FullMethodName,
+			wantCode:      codes.Unauthenticated,
+			wantChallenge: `ApiKey realm="hotelsDataMerge", Bearer realm="hotelsDataMerge"`,
+		},
+		{
+			name:          "Error - Invalid credentials",
+			authenticator: authenticator,
+			md:            metadata.Pairs(apiKeyHeader, "guess"),
+			method:        proto.HotelDataMerge_GetHotels_FullMethodName,
+			wantCode:      codes.Unauthenticated,
+			wantChallenge: `ApiKey realm="hotelsDataMerge", Bearer realm="hotelsDataMerge"`,
+		},
+		{
+			name:          "Error - Invalid bearer token",
+			authenticator: authenticator,
+			md:            metadata.Pairs(authorizationHeader, "Bearer expired"),
+			method:        proto.HotelDataMerge_GetHotels_FullMethodName,
+			wantCode:      codes.Unauthenticated,
+			wantChallenge: `ApiKey realm="hotelsDataMerge", Bearer realm="hotelsDataMerge", error="invalid_token"`,
+		},
+		{
+			name:          "Error - Missing scope",
+			authenticator: authenticator,
+			md:            metadata.Pairs(apiKeyHeader, "reader-key"),
+			method:        proto.HotelDataMergeAdmin_TriggerRefresh_FullMethodName,
+			wantCode:      codes.PermissionDenied,
+		},
+		{
+			name:          "Error - Unmapped method",
+			authenticator: authenticator,
+			md:            metadata.Pairs(authorizationHeader, "Bearer admin-jwt"),
+			method:        "/proto.HotelDataMerge/Unknown",
+			wantCode:      codes.PermissionDenied,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+			stream := &mockServerTransportStream{}
+			ctx := grpc.NewContextWithServerTransportStream(context.Background(), stream)
+			ctx = metadata.NewIncomingContext(ctx, tt.md)
+
+			var gotClientID string
+			handler := func(ctx context.Context, req any) (any, error) {
+				principal, _ := auth.FromContext(ctx)
+				gotClientID = principal.ClientID
+				return "ok", nil
+			}
+			_, err := AuthUnaryInterceptor(logger, tt.authenticator)(ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+			if code := status.Code(err); code != tt.wantCode {
+				t.Fatalf("AuthUnaryInterceptor() code = %v, wantCode %v", code, tt.wantCode)
+			}
+			if gotClientID != tt.wantClientID {
+				t.Errorf("AuthUnaryInterceptor() clientId = %q, want %q", gotClientID, tt.wantClientID)
+			}
+			var gotChallenge string
+			if values := stream.header.Get(wwwAuthenticateHeader); len(values) > 0 {
+				gotChallenge = values[0]
+			}
+			if gotChallenge != tt.wantChallenge {
+				t.Errorf("AuthUnaryInterceptor() WWW-Authenticate = %q, want %q", gotChallenge, tt.wantChallenge)
+			}
+		})
+	}
+}
+
+func Test_credentialsFromMetadata(t *testing.T) {
+	tests := []struct {
+		name string
+		md   metadata.MD
+		want auth.Credentials
+	}{
+		{
+			name: "Success - API key",
+			md:   metadata.Pairs(apiKeyHeader, "key"),
+			want: auth.Credentials{APIKey: "key"},
+		},
+		{
+			name: "Success - Bearer token",
+			md:   metadata.Pairs(authorizationHeader, "Bearer token "),
+			want: auth.Credentials{BearerToken: "token"},
+		},
+		{
+			name: "Success - Non-bearer authorization is ignored",
+			md:   metadata.Pairs(authorizationHeader, "Basic dXNlcjpwYXNz"),
+			want: auth.Credentials{},
+		},
+		{
+			name: "Success - No metadata",
+			md:   nil,
+			want: auth.Credentials{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := credentialsFromMetadata(tt.md); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("credentialsFromMetadata() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_methodScopes(t *testing.T) {
+	services := []grpc.ServiceDesc{proto.HotelDataMerge_ServiceDesc, proto.HotelDataMergeAdmin_ServiceDesc}
+	for _, service := range services {
+		for _, method := range service.Methods {
+			fullMethod := "/" + service.ServiceName + "/" + method.MethodName
+			if _, ok := methodScopes[fullMethod]; !ok {
+				t.Errorf("methodScopes is missing %s", fullMethod)
+			}
+		}
+	}
+}
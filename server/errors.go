@@ -0,0 +1,211 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/genproto/googleapis/rpc/code"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+const (
+	resourceTypeHotel       = "hotel"
+	resourceTypeDestination = "destination"
+)
+
+// errDataUpdateInProgress is returned while the suppliers data is being refreshed
+var errDataUpdateInProgress = status.Error(codes.Unavailable, "service temporarily unavailable - data update in progress")
+
+// errNoSnapshot is returned by exports until the suppliers data has been loaded once
+var errNoSnapshot = status.Error(codes.Unavailable, "service temporarily unavailable - no data loaded yet")
+
+// notModifiedViolation is the PreconditionFailure violation type of newNotModifiedError
+const notModifiedViolation = "NOT_MODIFIED"
+
+// newNotModifiedError is returned when one of the If-None-Match values matches the ETag of the resource,
+// so that gRPC clients cannot mistake the response for an empty resource. The gateway answers it with 304.
+func newNotModifiedError(etag string) error {
+	return withDetails(status.New(codes.FailedPrecondition, "not modified"), &errdetails.PreconditionFailure{
+		Violations: []*errdetails.PreconditionFailure_Violation{{
+			Type:        notModifiedViolation,
+			Subject:     ifNoneMatchHeader,
+			Description: fmt.Sprintf("the resource still matches ETag %s", etag),
+		}},
+	})
+}
+
+// isNotModified reports whether st was returned by newNotModifiedError
+func isNotModified(st *status.Status) bool {
+	if st.Code() != codes.FailedPrecondition {
+		return false
+	}
+	for _, detail := range st.Details() {
+		if failure, ok := detail.(*errdetails.PreconditionFailure); ok {
+			for _, violation := range failure.GetViolations() {
+				if violation.GetType() == notModifiedViolation {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// newInvalidArgumentError returns an InvalidArgument error listing every invalid request field
+func newInvalidArgumentError(message string, violations ...*errdetails.BadRequest_FieldViolation) error {
+	return withDetails(status.New(codes.InvalidArgument, message), &errdetails.BadRequest{
+		FieldViolations: violations,
+	})
+}
+
+// newNotFoundError returns a NotFound error with one ResourceInfo per missing resource
+func newNotFoundError(resourceType string, resourceNames ...string) error {
+	message := fmt.Sprintf("%s '%s' does not exist", resourceType, resourceNames[0])
+	if len(resourceNames) > 1 {
+		message = fmt.Sprintf("%d %ss do not exist", len(resourceNames), resourceType)
+	}
+
+	details := make([]protoadapt.MessageV1, 0, len(resourceNames))
+	for _, resourceName := range resourceNames {
+		details = append(details, &errdetails.ResourceInfo{
+			ResourceType: resourceType,
+			ResourceName: resourceName,
+			Description:  fmt.Sprintf("%s '%s' does not exist", resourceType, resourceName),
+		})
+	}
+	return withDetails(status.New(codes.NotFound, message), details...)
+}
+
+func withDetails(st *status.Status, details ...protoadapt.MessageV1) error {
+	detailed, err := st.WithDetails(details...)
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// httpError is the JSON body written by HTTPErrorHandler
+type httpError struct {
+	Error httpErrorBody `json:"error"`
+}
+
+type httpErrorBody struct {
+	Code    int               `json:"code"`
+	Status  string            `json:"status"`
+	Message string            `json:"message"`
+	Details []json.RawMessage `json:"details,omitempty"`
+}
+
+// HTTPErrorHandler writes gRPC errors returned through the gateway as
+// {"error": {"code": <HTTP status>, "status": "<gRPC code>", "message": ..., "details": [...]}},
+// except not modified errors, answered with 304, the ETag and no body. Routing errors, such as an
+// unknown path or method, keep the HTTP status they were raised with.
+func HTTPErrorHandler(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+	var routingErr *runtime.HTTPStatusError
+	if errors.As(err, &routingErr) {
+		err = routingErr.Err
+	}
+	st := status.Convert(err)
+	if isNotModified(st) {
+		writeNotModified(ctx, w)
+		return
+	}
+	httpStatus := runtime.HTTPStatusFromCode(st.Code())
+	if routingErr != nil {
+		httpStatus = routingErr.HTTPStatus
+	}
+
+	body := httpError{
+		Error: httpErrorBody{
+			Code:    httpStatus,
+			Status:  code.Code_name[int32(st.Code())],
+			Message: st.Message(),
+		},
+	}
+	for _, detail := range st.Proto().GetDetails() {
+		detailJSON, err := protojson.Marshal(detail)
+		if err != nil {
+			continue
+		}
+		body.Error.Details = append(body.Error.Details, detailJSON)
+	}
+
+	md, ok := runtime.ServerMetadataFromContext(ctx)
+	// Trailers are only sent to clients that accept them, as the default handler does
+	forwardTrailers := ok && acceptsTrailers(r)
+	if ok {
+		if requestIDs := md.HeaderMD.Get(requestIDHeader); len(requestIDs) > 0 {
+			w.Header().Set("X-Request-Id", requestIDs[0])
+		}
+		if retryAfter := md.HeaderMD.Get(retryAfterHeader); len(retryAfter) > 0 {
+			w.Header().Set("Retry-After", retryAfter[0])
+		}
+		if challenges := md.HeaderMD.Get(wwwAuthenticateHeader); len(challenges) > 0 && st.Code() == codes.Unauthenticated {
+			w.Header().Set("WWW-Authenticate", challenges[0])
+		}
+	}
+	if forwardTrailers {
+		for key := range md.TrailerMD {
+			w.Header().Add("Trailer", runtime.MetadataTrailerPrefix+key)
+		}
+		w.Header().Set("Transfer-Encoding", "chunked")
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(httpStatus)
+	_ = json.NewEncoder(w).Encode(body)
+
+	if forwardTrailers {
+		for key, values := range md.TrailerMD {
+			for _, value := range values {
+				w.Header().Add(runtime.MetadataTrailerPrefix+key, value)
+			}
+		}
+	}
+}
+
+// acceptsTrailers reports whether the request's TE header accepts trailers
+func acceptsTrailers(r *http.Request) bool {
+	te := r.Header.Get("TE")
+	return strings.Contains(strings.ToLower(te), "trailers")
+}
+
+// HTTPRoutingErrorHandler passes unknown paths and methods to HTTPErrorHandler with their HTTP status,
+// so that an unsupported method is answered with 405 rather than the 501 of its gRPC code
+func HTTPRoutingErrorHandler(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, r *http.Request, httpStatus int) {
+	code := codes.Internal
+	switch httpStatus {
+	case http.StatusBadRequest:
+		code = codes.InvalidArgument
+	case http.StatusMethodNotAllowed:
+		code = codes.Unimplemented
+	case http.StatusNotFound:
+		code = codes.NotFound
+	}
+	HTTPErrorHandler(ctx, mux, marshaler, w, r, &runtime.HTTPStatusError{
+		HTTPStatus: httpStatus,
+		Err:        status.Error(code, http.StatusText(httpStatus)),
+	})
+}
+
+// writeNotModified answers a not modified error with its ETag and request ID, which the gRPC server
+// sent as headers
+func writeNotModified(ctx context.Context, w http.ResponseWriter) {
+	if md, ok := runtime.ServerMetadataFromContext(ctx); ok {
+		if etags := md.HeaderMD.Get(etagHeader); len(etags) > 0 {
+			w.Header().Set("ETag", etags[0])
+		}
+		if requestIDs := md.HeaderMD.Get(requestIDHeader); len(requestIDs) > 0 {
+			w.Header().Set("X-Request-Id", requestIDs[0])
+		}
+	}
+	w.WriteHeader(http.StatusNotModified)
+}
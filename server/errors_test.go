@@ -0,0 +1,255 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"hotelsDataMerge/internal/auth"
+	"hotelsDataMerge/proto"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func Test_newNotFoundError(t *testing.T) {
+	tests := []struct {
+		name          string
+		resourceType  string
+		resourceNames []string
+		wantMessage   string
+		wantResources []string
+	}{
+		{
+			name:          "Success - Single missing hotel",
+			resourceType:  resourceTypeHotel,
+			resourceNames: []string{"InvalidID"},
+			wantMessage:   "hotel 'InvalidID' does not exist",
+			wantResources: []string{"InvalidID"},
+		},
+		{
+			name:          "Success - Several missing hotels",
+			resourceType:  resourceTypeHotel,
+			resourceNames: []string{"InvalidID", "OtherID"},
+			wantMessage:   "2 hotels do not exist",
+			wantResources: []string{"InvalidID", "OtherID"},
+		},
+		{
+			name:          "Success - Missing destination",
+			resourceType:  resourceTypeDestination,
+			resourceNames: []string{"999"},
+			wantMessage:   "destination '999' does not exist",
+			wantResources: []string{"999"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st := status.Convert(newNotFoundError(tt.resourceType, tt.resourceNames...))
+			if st.Code() != codes.NotFound {
+				t.Errorf("newNotFoundError() code = %v, want %v", st.Code(), codes.NotFound)
+			}
+			if st.Message() != tt.wantMessage {
+				t.Errorf("newNotFoundError() message = %v, want %v", st.Message(), tt.wantMessage)
+			}
+			var gotResources []string
+			for _, detail := range st.Details() {
+				resourceInfo, ok := detail.(*errdetails.ResourceInfo)
+				if !ok || resourceInfo.ResourceType != tt.resourceType {
+					t.Errorf("newNotFoundError() detail = %v, want ResourceInfo of type %s", detail, tt.resourceType)
+					continue
+				}
+				gotResources = append(gotResources, resourceInfo.ResourceName)
+			}
+			if !reflect.DeepEqual(gotResources, tt.wantResources) {
+				t.Errorf("newNotFoundError() resources = %v, want %v", gotResources, tt.wantResources)
+			}
+		})
+	}
+}
+
+func Test_newInvalidArgumentError(t *testing.T) {
+	err := newInvalidArgumentError("invalid request", &errdetails.BadRequest_FieldViolation{
+		Field:       "hotelIDs",
+		Description: "must not be empty",
+	})
+
+	st := status.Convert(err)
+	if st.Code() != codes.InvalidArgument {
+		t.Errorf("newInvalidArgumentError() code = %v, want %v", st.Code(), codes.InvalidArgument)
+	}
+	if len(st.Details()) != 1 {
+		t.Fatalf("newInvalidArgumentError() details = %v, want 1 BadRequest", st.Details())
+	}
+	badRequest, ok := st.Details()[0].(*errdetails.BadRequest)
+	if !ok || len(badRequest.FieldViolations) != 1 || badRequest.FieldViolations[0].Field != "hotelIDs" {
+		t.Errorf("newInvalidArgumentError() detail = %v, want BadRequest on hotelIDs", st.Details()[0])
+	}
+}
+
+func TestHTTPErrorHandler(t *testing.T) {
+	tests := []struct {
+		name           string
+		err            error
+		wantStatusCode int
+		wantStatus     string
+		wantMessage    string
+		wantDetails    int
+		headerMD       metadata.MD
+		wantRetryAfter string
+		wantChallenge  string
+	}{
+		{
+			name:           "Success - Not found with resource info",
+			err:            newNotFoundError(resourceTypeHotel, "InvalidID"),
+			wantStatusCode: http.StatusNotFound,
+			wantStatus:     "NOT_FOUND",
+			wantMessage:    "hotel 'InvalidID' does not exist",
+			wantDetails:    1,
+		},
+		{
+			name: "Success - Invalid argument with field violations",
+			err: newInvalidArgumentError("no request parameters were specified",
+				&errdetails.BadRequest_FieldViolation{Field: "hotelIDs"},
+				&errdetails.BadRequest_FieldViolation{Field: "destinationId"},
+			),
+			wantStatusCode: http.StatusBadRequest,
+			wantStatus:     "INVALID_ARGUMENT",
+			wantMessage:    "no request parameters were specified",
+			wantDetails:    1,
+		},
+		{
+			name:           "Success - Unavailable",
+			err:            errDataUpdateInProgress,
+			wantStatusCode: http.StatusServiceUnavailable,
+			wantStatus:     "UNAVAILABLE",
+			wantMessage:    "service temporarily unavailable - data update in progress",
+			wantDetails:    0,
+		},
+		{
+			name: "Success - Rate limited with Retry-After",
+			err: withDetails(status.New(codes.ResourceExhausted, "rate limit exceeded, retry in 2 seconds"),
+				&errdetails.RetryInfo{RetryDelay: durationpb.New(1500 * time.Millisecond)},
+			),
+			wantStatusCode: http.StatusTooManyRequests,
+			wantStatus:     "RESOURCE_EXHAUSTED",
+			wantMessage:    "rate limit exceeded, retry in 2 seconds",
+			wantDetails:    1,
+			headerMD:       metadata.Pairs(retryAfterHeader, "2"),
+			wantRetryAfter: "2",
+		},
+		{
+			name:           "Success - Unauthenticated with WWW-Authenticate",
+			err:            status.Error(codes.Unauthenticated, "invalid credentials"),
+			wantStatusCode: http.StatusUnauthorized,
+			wantStatus:     "UNAUTHENTICATED",
+			wantMessage:    "invalid credentials",
+			wantDetails:    0,
+			headerMD:       metadata.Pairs(wwwAuthenticateHeader, `ApiKey realm="hotelsDataMerge"`),
+			wantChallenge:  `ApiKey realm="hotelsDataMerge"`,
+		},
+		{
+			name:           "Success - Non-gRPC error",
+			err:            errors.New("connection reset"),
+			wantStatusCode: http.StatusInternalServerError,
+			wantStatus:     "UNKNOWN",
+			wantMessage:    "connection reset",
+			wantDetails:    0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			request := httptest.NewRequest(http.MethodGet, "/v1/hotels", nil)
+			ctx := runtime.NewServerMetadataContext(context.Background(), runtime.ServerMetadata{HeaderMD: tt.headerMD})
+			HTTPErrorHandler(ctx, runtime.NewServeMux(), &runtime.JSONPb{}, recorder, request, tt.err)
+
+			if recorder.Code != tt.wantStatusCode {
+				t.Errorf("HTTPErrorHandler() status code = %v, want %v", recorder.Code, tt.wantStatusCode)
+			}
+			var got httpError
+			if err := json.Unmarshal(recorder.Body.Bytes(), &got); err != nil {
+				t.Fatalf("HTTPErrorHandler() body = %s, not JSON: %v", recorder.Body.String(), err)
+			}
+			if got.Error.Code != tt.wantStatusCode || got.Error.Status != tt.wantStatus || got.Error.Message != tt.wantMessage {
+				t.Errorf("HTTPErrorHandler() error = %+v, want code %v, status %v, message %v", got.Error, tt.wantStatusCode, tt.wantStatus, tt.wantMessage)
+			}
+			if len(got.Error.Details) != tt.wantDetails {
+				t.Errorf("HTTPErrorHandler() details = %s, want %d", got.Error.Details, tt.wantDetails)
+			}
+			if retryAfter := recorder.Header().Get("Retry-After"); retryAfter != tt.wantRetryAfter {
+				t.Errorf("HTTPErrorHandler() Retry-After = %q, want %q", retryAfter, tt.wantRetryAfter)
+			}
+			if challenge := recorder.Header().Get("WWW-Authenticate"); challenge != tt.wantChallenge {
+				t.Errorf("HTTPErrorHandler() WWW-Authenticate = %q, want %q", challenge, tt.wantChallenge)
+			}
+		})
+	}
+}
+
+// unauthenticatedService rejects every request like the auth interceptor, with a trailer
+type unauthenticatedService struct {
+	proto.UnimplementedHotelDataMergeServer
+}
+
+func (unauthenticatedService) GetHotel(ctx context.Context, req *proto.GetHotelRequest) (*proto.Hotel, error) {
+	setAuthChallenges(ctx, []auth.Method{auth.MethodJWT}, true)
+	_ = grpc.SetTrailer(ctx, metadata.Pairs("x-auth-reason", "expired"))
+	return nil, status.Error(codes.Unauthenticated, "invalid credentials")
+}
+
+func TestGateway_HTTPErrorHandler(t *testing.T) {
+	gateway := newTestGateway(t, unauthenticatedService{})
+	tests := []struct {
+		name          string
+		method        string
+		path          string
+		te            string
+		wantCode      int
+		wantStatus    string
+		wantChallenge string
+		wantTrailer   string
+	}{
+		{name: "Success - Unknown route", method: http.MethodGet, path: "/v1/unknown", wantCode: http.StatusNotFound, wantStatus: "NOT_FOUND"},
+		{name: "Success - Unsupported method", method: http.MethodDelete, path: "/v1/hotels/SjyX", wantCode: http.StatusMethodNotAllowed, wantStatus: "UNIMPLEMENTED"},
+		{name: "Success - Unauthenticated", method: http.MethodGet, path: "/v1/hotels/SjyX", wantCode: http.StatusUnauthorized, wantStatus: "UNAUTHENTICATED", wantChallenge: `Bearer realm="hotelsDataMerge", error="invalid_token"`},
+		{name: "Success - Unauthenticated with trailers", method: http.MethodGet, path: "/v1/hotels/SjyX", te: "trailers", wantCode: http.StatusUnauthorized, wantStatus: "UNAUTHENTICATED", wantChallenge: `Bearer realm="hotelsDataMerge", error="invalid_token"`, wantTrailer: "expired"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := httptest.NewRequest(tt.method, tt.path, nil)
+			if len(tt.te) > 0 {
+				request.Header.Set("TE", tt.te)
+			}
+			recorder := httptest.NewRecorder()
+			gateway.ServeHTTP(recorder, request)
+			result := recorder.Result()
+
+			if result.StatusCode != tt.wantCode {
+				t.Errorf("%s %s code = %v, want %v: %s", tt.method, tt.path, result.StatusCode, tt.wantCode, recorder.Body)
+			}
+			var body httpError
+			if err := json.Unmarshal(recorder.Body.Bytes(), &body); err != nil {
+				t.Fatalf("%s %s body = %s, error = %v", tt.method, tt.path, recorder.Body, err)
+			}
+			if body.Error.Code != tt.wantCode || body.Error.Status != tt.wantStatus {
+				t.Errorf("%s %s body error = %d %s, want %d %s", tt.method, tt.path, body.Error.Code, body.Error.Status, tt.wantCode, tt.wantStatus)
+			}
+			if challenge := result.Header.Get("WWW-Authenticate"); challenge != tt.wantChallenge {
+				t.Errorf("%s %s WWW-Authenticate = %q, want %q", tt.method, tt.path, challenge, tt.wantChallenge)
+			}
+			if trailer := result.Trailer.Get(runtime.MetadataTrailerPrefix + "x-auth-reason"); trailer != tt.wantTrailer {
+				t.Errorf("%s %s trailer = %q, want %q", tt.method, tt.path, trailer, tt.wantTrailer)
+			}
+		})
+	}
+}
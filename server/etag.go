@@ -0,0 +1,45 @@
+package server
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+const (
+	etagHeader        = "etag"
+	ifNoneMatchHeader = "if-none-match"
+)
+
+// computeETag returns a strong ETag from the hash of the deterministic encoding of the message
+func computeETag(message protobuf.Message) (string, error) {
+	data, err := protobuf.MarshalOptions{Deterministic: true}.Marshal(message)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return fmt.Sprintf("%q", hex.EncodeToString(sum[:16])), nil
+}
+
+// notModified reports whether one of the If-None-Match values sent by the client matches the ETag.
+// As required for If-None-Match, weak validators match their strong counterpart.
+func notModified(ctx context.Context, etag string) bool {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return false
+	}
+	for _, header := range md.Get(ifNoneMatchHeader) {
+		for _, candidate := range strings.Split(header, ",") {
+			candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+			if candidate == "*" || candidate == etag {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,141 @@
+package server
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"hotelsDataMerge/internal/hotels"
+	"hotelsDataMerge/proto"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// newTestGateway serves svc over an in-memory listener behind a gateway configured like main.go
+func newTestGateway(t *testing.T, svc proto.HotelDataMergeServer) http.Handler {
+	t.Helper()
+	svr := grpc.NewServer(grpc.ChainUnaryInterceptor(RequestIDUnaryInterceptor()))
+	proto.RegisterHotelDataMergeServer(svr, svc)
+	lis := bufconn.Listen(1 << 20)
+	go func() { _ = svr.Serve(lis) }()
+	t.Cleanup(svr.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+	mux := runtime.NewServeMux(
+		runtime.WithErrorHandler(HTTPErrorHandler),
+		runtime.WithRoutingErrorHandler(HTTPRoutingErrorHandler),
+		runtime.WithIncomingHeaderMatcher(HTTPIncomingHeaderMatcher),
+		runtime.WithOutgoingHeaderMatcher(HTTPOutgoingHeaderMatcher),
+	)
+	if err := proto.RegisterHotelDataMergeHandler(context.Background(), mux, conn); err != nil {
+		t.Fatalf("RegisterHotelDataMergeHandler() error = %v", err)
+	}
+	return mux
+}
+
+func Test_computeETag(t *testing.T) {
+	hotel := &proto.Hotel{Id: "iJhz", Name: "Beach Villas Singapore", BookingConditions: []string{"Pets are not allowed."}}
+	same := &proto.Hotel{Id: "iJhz", Name: "Beach Villas Singapore", BookingConditions: []string{"Pets are not allowed."}}
+	changed := &proto.Hotel{Id: "iJhz", Name: "Beach Villas Sentosa", BookingConditions: []string{"Pets are not allowed."}}
+
+	etag, err := computeETag(hotel)
+	if err != nil {
+		t.Fatalf("computeETag() error = %v", err)
+	}
+	if len(etag) != 34 || etag[0] != '"' || etag[len(etag)-1] != '"' {
+		t.Errorf("computeETag() = %v, want a quoted 32 character hash", etag)
+	}
+	if sameETag, _ := computeETag(same); sameETag != etag {
+		t.Errorf("computeETag() = %v for equal content, want %v", sameETag, etag)
+	}
+	if changedETag, _ := computeETag(changed); changedETag == etag {
+		t.Errorf("computeETag() = %v for changed content, want a different ETag", changedETag)
+	}
+}
+
+func Test_notModified(t *testing.T) {
+	const etag = `"abc"`
+	tests := []struct {
+		name        string
+		ifNoneMatch []string
+		want        bool
+	}{
+		{name: "Success - No header", ifNoneMatch: nil, want: false},
+		{name: "Success - Matching ETag", ifNoneMatch: []string{`"abc"`}, want: true},
+		{name: "Success - Matching weak ETag", ifNoneMatch: []string{`W/"abc"`}, want: true},
+		{name: "Success - Matching ETag in list", ifNoneMatch: []string{`"xyz", "abc"`}, want: true},
+		{name: "Success - Wildcard", ifNoneMatch: []string{"*"}, want: true},
+		{name: "Success - Different ETag", ifNoneMatch: []string{`"xyz"`}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.ifNoneMatch != nil {
+				md := metadata.MD{}
+				md.Append(ifNoneMatchHeader, tt.ifNoneMatch...)
+				ctx = metadata.NewIncomingContext(ctx, md)
+			}
+			if got := notModified(ctx, etag); got != tt.want {
+				t.Errorf("notModified() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGateway_GetHotel_ETag(t *testing.T) {
+	svc := &hotelsDataMergeService{
+		logger: slog.New(slog.NewTextHandler(io.Discard, nil)),
+		hotels: &mockHotels{hotels: []hotels.Hotel{testHotel}},
+	}
+	gateway := newTestGateway(t, svc)
+	etag, err := computeETag(svc.constructHotel(testHotel, proto.CountryFormat_COUNTRY_FORMAT_CODE))
+	if err != nil {
+		t.Fatalf("computeETag() error = %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		ifNoneMatch string
+		wantCode    int
+		wantBody    bool
+	}{
+		{name: "Success - Hotel with its ETag", wantCode: http.StatusOK, wantBody: true},
+		{name: "Success - Stale ETag", ifNoneMatch: `"stale"`, wantCode: http.StatusOK, wantBody: true},
+		{name: "Success - Not modified", ifNoneMatch: `"other", W/` + etag, wantCode: http.StatusNotModified},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := httptest.NewRequest(http.MethodGet, "/v1/hotels/SjyX", nil)
+			if len(tt.ifNoneMatch) > 0 {
+				request.Header.Set("If-None-Match", tt.ifNoneMatch)
+			}
+			recorder := httptest.NewRecorder()
+			gateway.ServeHTTP(recorder, request)
+
+			if recorder.Code != tt.wantCode {
+				t.Errorf("GET /v1/hotels/SjyX code = %v, want %v: %s", recorder.Code, tt.wantCode, recorder.Body)
+			}
+			if got := recorder.Header().Get("ETag"); got != etag {
+				t.Errorf("GET /v1/hotels/SjyX ETag = %q, want %q", got, etag)
+			}
+			if gotBody := recorder.Body.Len() > 0; gotBody != tt.wantBody {
+				t.Errorf("GET /v1/hotels/SjyX body = %q, want body %v", recorder.Body, tt.wantBody)
+			}
+		})
+	}
+}
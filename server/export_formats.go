@@ -0,0 +1,325 @@
+package server
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"strings"
+
+	"hotelsDataMerge/proto"
+
+	"github.com/parquet-go/parquet-go"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	defaultExportFormat = "ndjson"
+	// csvListSeparator joins the values of a list in one CSV cell
+	csvListSeparator = "|"
+	// parquetRowGroupSize bounds the rows buffered before a row group is written out
+	parquetRowGroupSize = 1000
+)
+
+// exportEncoder writes hotels one at a time; Close writes what the format needs at the end
+type exportEncoder interface {
+	Encode(hotel *proto.Hotel) error
+	Close() error
+}
+
+type exportFormat struct {
+	contentType string
+	newEncoder  func(w io.Writer) (exportEncoder, error)
+}
+
+var exportFormats = map[string]exportFormat{
+	"ndjson":  {contentType: "application/x-ndjson", newEncoder: newNDJSONEncoder},
+	"csv":     {contentType: "text/csv; charset=utf-8", newEncoder: newCSVEncoder},
+	"parquet": {contentType: "application/vnd.apache.parquet", newEncoder: newParquetEncoder},
+}
+
+// ndjsonEncoder writes one hotel per line, with the JSON mapping of the gateway
+type ndjsonEncoder struct {
+	w       io.Writer
+	options protojson.MarshalOptions
+}
+
+func newNDJSONEncoder(w io.Writer) (exportEncoder, error) {
+	return &ndjsonEncoder{w: w, options: protojson.MarshalOptions{EmitUnpopulated: true}}, nil
+}
+
+func (e *ndjsonEncoder) Encode(hotel *proto.Hotel) error {
+	line, err := e.options.Marshal(hotel)
+	if err != nil {
+		return err
+	}
+	_, err = e.w.Write(append(line, '\n'))
+	return err
+}
+
+func (e *ndjsonEncoder) Close() error {
+	return nil
+}
+
+// csvColumn is one column of the CSV export. Nested messages are flattened into one column per
+// field, named after its dotted path, and lists are joined with csvListSeparator.
+type csvColumn struct {
+	name  string
+	value func(hotel *proto.Hotel) string
+}
+
+var csvColumns = []csvColumn{
+	{"id", func(h *proto.Hotel) string { return h.GetId() }},
+	{"destination_id", func(h *proto.Hotel) string { return strconv.FormatInt(h.GetDestinationId(), 10) }},
+	{"name", func(h *proto.Hotel) string { return h.GetName() }},
+	{"description", func(h *proto.Hotel) string { return h.GetDescription() }},
+	{"location.lat", func(h *proto.Hotel) string { return formatCoordinate(h.GetLocation().GetLat()) }},
+	{"location.lng", func(h *proto.Hotel) string { return formatCoordinate(h.GetLocation().GetLng()) }},
+	{"location.address", func(h *proto.Hotel) string { return h.GetLocation().GetAddress() }},
+	{"location.city", func(h *proto.Hotel) string { return h.GetLocation().GetCity() }},
+	{"location.country", func(h *proto.Hotel) string { return h.GetLocation().GetCountry() }},
+	{"amenities.general", func(h *proto.Hotel) string { return joinList(h.GetAmenities().GetGeneral()) }},
+	{"amenities.room", func(h *proto.Hotel) string { return joinList(h.GetAmenities().GetRoom()) }},
+	{"images.rooms", func(h *proto.Hotel) string { return imageLinks(h.GetImages().GetRooms()) }},
+	{"images.site", func(h *proto.Hotel) string { return imageLinks(h.GetImages().GetSite()) }},
+	{"images.amenities", func(h *proto.Hotel) string { return imageLinks(h.GetImages().GetAmenities()) }},
+	{"booking_conditions", func(h *proto.Hotel) string { return joinList(h.GetBookingConditions()) }},
+	policyColumn("booking_policy.check_in_from", func(p *proto.BookingPolicy) string { return p.GetCheckInFrom() }),
+	policyColumn("booking_policy.check_out_until", func(p *proto.BookingPolicy) string { return p.GetCheckOutUntil() }),
+	policyColumn("booking_policy.pets", func(p *proto.BookingPolicy) string { return p.GetPets().String() }),
+	policyColumn("booking_policy.cancellation.free_cancellation", func(p *proto.BookingPolicy) string {
+		return strconv.FormatBool(p.GetCancellation().GetFreeCancellation())
+	}),
+	policyColumn("booking_policy.cancellation.free_cancellation_hours", func(p *proto.BookingPolicy) string {
+		return strconv.FormatInt(int64(p.GetCancellation().GetFreeCancellationHours()), 10)
+	}),
+	policyColumn("booking_policy.cancellation.non_refundable", func(p *proto.BookingPolicy) string {
+		return strconv.FormatBool(p.GetCancellation().GetNonRefundable())
+	}),
+	policyColumn("booking_policy.deposit_required", func(p *proto.BookingPolicy) string { return strconv.FormatBool(p.GetDepositRequired()) }),
+	policyColumn("booking_policy.prepayment_required", func(p *proto.BookingPolicy) string { return strconv.FormatBool(p.GetPrepaymentRequired()) }),
+	policyColumn("booking_policy.photo_id_required", func(p *proto.BookingPolicy) string { return strconv.FormatBool(p.GetPhotoIdRequired()) }),
+	policyColumn("booking_policy.credit_card_required", func(p *proto.BookingPolicy) string { return strconv.FormatBool(p.GetCreditCardRequired()) }),
+	policyColumn("booking_policy.children.children_allowed", func(p *proto.BookingPolicy) string {
+		if p.GetChildren() == nil || p.GetChildren().ChildrenAllowed == nil {
+			return ""
+		}
+		return strconv.FormatBool(p.GetChildren().GetChildrenAllowed())
+	}),
+	policyColumn("booking_policy.children.free_stay_under_age", func(p *proto.BookingPolicy) string {
+		return strconv.FormatInt(int64(p.GetChildren().GetFreeStayUnderAge()), 10)
+	}),
+	policyColumn("booking_policy.children.max_cribs", func(p *proto.BookingPolicy) string {
+		return strconv.FormatInt(int64(p.GetChildren().GetMaxCribs()), 10)
+	}),
+	policyColumn("booking_policy.children.extra_beds_available", func(p *proto.BookingPolicy) string {
+		return strconv.FormatBool(p.GetChildren().GetExtraBedsAvailable())
+	}),
+	policyColumn("booking_policy.children.extra_bed_charge", func(p *proto.BookingPolicy) string { return p.GetChildren().GetExtraBedCharge() }),
+	policyColumn("booking_policy.unrecognized_sentences", func(p *proto.BookingPolicy) string { return joinList(p.GetUnrecognizedSentences()) }),
+}
+
+// policyColumn leaves the cell empty for hotels without a booking policy
+func policyColumn(name string, value func(policy *proto.BookingPolicy) string) csvColumn {
+	return csvColumn{name: name, value: func(h *proto.Hotel) string {
+		if h.GetBookingPolicy() == nil {
+			return ""
+		}
+		return value(h.GetBookingPolicy())
+	}}
+}
+
+func formatCoordinate(coordinate float64) string {
+	return strconv.FormatFloat(coordinate, 'f', -1, 64)
+}
+
+func joinList(values []string) string {
+	return strings.Join(values, csvListSeparator)
+}
+
+// imageLinks flattens images into their links; descriptions are only exported as NDJSON and Parquet
+func imageLinks[T interface{ GetLink() string }](images []T) string {
+	links := make([]string, 0, len(images))
+	for _, image := range images {
+		links = append(links, image.GetLink())
+	}
+	return joinList(links)
+}
+
+type csvEncoder struct {
+	w *csv.Writer
+}
+
+func newCSVEncoder(w io.Writer) (exportEncoder, error) {
+	header := make([]string, 0, len(csvColumns))
+	for _, column := range csvColumns {
+		header = append(header, column.name)
+	}
+	e := &csvEncoder{w: csv.NewWriter(w)}
+	return e, e.w.Write(header)
+}
+
+func (e *csvEncoder) Encode(hotel *proto.Hotel) error {
+	record := make([]string, 0, len(csvColumns))
+	for _, column := range csvColumns {
+		record = append(record, column.value(hotel))
+	}
+	return e.w.Write(record)
+}
+
+func (e *csvEncoder) Close() error {
+	e.w.Flush()
+	return e.w.Error()
+}
+
+// parquetHotel is the Parquet schema of the export, which keeps the nesting of the Hotel message
+type parquetHotel struct {
+	ID                string                `parquet:"id"`
+	DestinationID     int64                 `parquet:"destination_id"`
+	Name              string                `parquet:"name"`
+	Description       string                `parquet:"description"`
+	Location          parquetLocation       `parquet:"location"`
+	Amenities         parquetAmenities      `parquet:"amenities"`
+	Images            parquetImages         `parquet:"images"`
+	BookingConditions []string              `parquet:"booking_conditions,list"`
+	BookingPolicy     *parquetBookingPolicy `parquet:"booking_policy,optional"`
+}
+
+type parquetLocation struct {
+	Lat     float64 `parquet:"lat"`
+	Lng     float64 `parquet:"lng"`
+	Address string  `parquet:"address"`
+	City    string  `parquet:"city"`
+	Country string  `parquet:"country"`
+}
+
+type parquetAmenities struct {
+	General []string `parquet:"general,list"`
+	Room    []string `parquet:"room,list"`
+}
+
+type parquetImages struct {
+	Rooms     []parquetImage `parquet:"rooms,list"`
+	Site      []parquetImage `parquet:"site,list"`
+	Amenities []parquetImage `parquet:"amenities,list"`
+}
+
+type parquetImage struct {
+	Link        string `parquet:"link"`
+	Description string `parquet:"description"`
+}
+
+type parquetBookingPolicy struct {
+	CheckInFrom           string               `parquet:"check_in_from"`
+	CheckOutUntil         string               `parquet:"check_out_until"`
+	Pets                  string               `parquet:"pets"`
+	Cancellation          *parquetCancellation `parquet:"cancellation,optional"`
+	DepositRequired       bool                 `parquet:"deposit_required"`
+	PrepaymentRequired    bool                 `parquet:"prepayment_required"`
+	PhotoIDRequired       bool                 `parquet:"photo_id_required"`
+	CreditCardRequired    bool                 `parquet:"credit_card_required"`
+	Children              *parquetChildren     `parquet:"children,optional"`
+	UnrecognizedSentences []string             `parquet:"unrecognized_sentences,list"`
+}
+
+type parquetCancellation struct {
+	FreeCancellation      bool  `parquet:"free_cancellation"`
+	FreeCancellationHours int32 `parquet:"free_cancellation_hours"`
+	NonRefundable         bool  `parquet:"non_refundable"`
+}
+
+type parquetChildren struct {
+	ChildrenAllowed    *bool  `parquet:"children_allowed,optional"`
+	FreeStayUnderAge   int32  `parquet:"free_stay_under_age"`
+	MaxCribs           int32  `parquet:"max_cribs"`
+	ExtraBedsAvailable bool   `parquet:"extra_beds_available"`
+	ExtraBedCharge     string `parquet:"extra_bed_charge"`
+}
+
+// parquetEncoder buffers at most parquetRowGroupSize hotels; the file footer is written by Close
+type parquetEncoder struct {
+	w *parquet.GenericWriter[parquetHotel]
+}
+
+func newParquetEncoder(w io.Writer) (exportEncoder, error) {
+	return &parquetEncoder{
+		w: parquet.NewGenericWriter[parquetHotel](w,
+			parquet.MaxRowsPerRowGroup(parquetRowGroupSize),
+			parquet.Compression(&parquet.Snappy),
+		),
+	}, nil
+}
+
+func (e *parquetEncoder) Encode(hotel *proto.Hotel) error {
+	_, err := e.w.Write([]parquetHotel{newParquetHotel(hotel)})
+	return err
+}
+
+func (e *parquetEncoder) Close() error {
+	return e.w.Close()
+}
+
+func newParquetHotel(hotel *proto.Hotel) parquetHotel {
+	row := parquetHotel{
+		ID:            hotel.GetId(),
+		DestinationID: hotel.GetDestinationId(),
+		Name:          hotel.GetName(),
+		Description:   hotel.GetDescription(),
+		Location: parquetLocation{
+			Lat:     hotel.GetLocation().GetLat(),
+			Lng:     hotel.GetLocation().GetLng(),
+			Address: hotel.GetLocation().GetAddress(),
+			City:    hotel.GetLocation().GetCity(),
+			Country: hotel.GetLocation().GetCountry(),
+		},
+		Amenities: parquetAmenities{
+			General: hotel.GetAmenities().GetGeneral(),
+			Room:    hotel.GetAmenities().GetRoom(),
+		},
+		Images: parquetImages{
+			Rooms:     newParquetImages(hotel.GetImages().GetRooms()),
+			Site:      newParquetImages(hotel.GetImages().GetSite()),
+			Amenities: newParquetImages(hotel.GetImages().GetAmenities()),
+		},
+		BookingConditions: hotel.GetBookingConditions(),
+	}
+	if policy := hotel.GetBookingPolicy(); policy != nil {
+		row.BookingPolicy = &parquetBookingPolicy{
+			CheckInFrom:           policy.GetCheckInFrom(),
+			CheckOutUntil:         policy.GetCheckOutUntil(),
+			Pets:                  policy.GetPets().String(),
+			DepositRequired:       policy.GetDepositRequired(),
+			PrepaymentRequired:    policy.GetPrepaymentRequired(),
+			PhotoIDRequired:       policy.GetPhotoIdRequired(),
+			CreditCardRequired:    policy.GetCreditCardRequired(),
+			UnrecognizedSentences: policy.GetUnrecognizedSentences(),
+		}
+		if cancellation := policy.GetCancellation(); cancellation != nil {
+			row.BookingPolicy.Cancellation = &parquetCancellation{
+				FreeCancellation:      cancellation.GetFreeCancellation(),
+				FreeCancellationHours: cancellation.GetFreeCancellationHours(),
+				NonRefundable:         cancellation.GetNonRefundable(),
+			}
+		}
+		if children := policy.GetChildren(); children != nil {
+			row.BookingPolicy.Children = &parquetChildren{
+				ChildrenAllowed:    children.ChildrenAllowed,
+				FreeStayUnderAge:   children.GetFreeStayUnderAge(),
+				MaxCribs:           children.GetMaxCribs(),
+				ExtraBedsAvailable: children.GetExtraBedsAvailable(),
+				ExtraBedCharge:     children.GetExtraBedCharge(),
+			}
+		}
+	}
+	return row
+}
+
+func newParquetImages[T interface {
+	GetLink() string
+	GetDescription() string
+}](images []T) []parquetImage {
+	rows := make([]parquetImage, 0, len(images))
+	for _, image := range images {
+		rows = append(rows, parquetImage{Link: image.GetLink(), Description: image.GetDescription()})
+	}
+	return rows
+}
@@ -0,0 +1,159 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+
+	"hotelsDataMerge/external"
+	"hotelsDataMerge/proto"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	snapshotVersionHeader = "x-snapshot-version"
+	exportFormatQueryName = "format"
+	countryFormatQuery    = "countryFormat"
+)
+
+var exportHotelsMethodName = "[ExportHotels]"
+
+// ExportHotels streams every hotel of the snapshot being served, ordered by ID, after sending the
+// snapshot version in the x-snapshot-version header. The read lock is only held while the snapshot
+// is taken, so a refresh can swap the hotels while a long export is being streamed.
+func (h *hotelsDataMergeService) ExportHotels(req *proto.ExportHotelsRequest, stream grpc.ServerStreamingServer[proto.Hotel]) error {
+	ctx := stream.Context()
+	if !external.FetchSuppliersMutex.TryRLock() {
+		h.logger.ErrorContext(ctx, fmt.Sprintf("%s Cannot acquire read lock - suppliers data update in progress", exportHotelsMethodName))
+		return errDataUpdateInProgress
+	}
+	export := h.hotels.ExportHotels()
+	external.FetchSuppliersMutex.RUnlock()
+	if export.Version == 0 {
+		// Nothing was loaded yet, which an empty export would hide
+		return errNoSnapshot
+	}
+
+	if err := stream.SendHeader(metadata.Pairs(snapshotVersionHeader, strconv.FormatInt(export.Version, 10))); err != nil {
+		return err
+	}
+	for hotel := range export.Hotels() {
+		if err := stream.Send(h.constructHotel(hotel, req.CountryFormat)); err != nil {
+			h.logger.WarnContext(ctx, fmt.Sprintf("%s Export interrupted. %s", exportHotelsMethodName, err), "version", export.Version)
+			return err
+		}
+	}
+	h.logger.InfoContext(ctx, fmt.Sprintf("%s Export streamed", exportHotelsMethodName), "version", export.Version, "hotels", export.Len())
+	return nil
+}
+
+// ExportHandler serves GET /v1/exports/hotels?format=ndjson|csv|parquet by calling ExportHotels through
+// client, so that exports are authenticated and rate limited like every other call. Hotels are encoded
+// as they are received, and the snapshot version is returned in the X-Snapshot-Version header.
+func ExportHandler(client proto.HotelDataMergeClient) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		formatName := query.Get(exportFormatQueryName)
+		if len(formatName) == 0 {
+			formatName = defaultExportFormat
+		}
+		format, ok := exportFormats[formatName]
+		if !ok {
+			HTTPErrorHandler(r.Context(), nil, nil, w, r, newInvalidArgumentError(fmt.Sprintf("unknown export format %q", formatName),
+				&errdetails.BadRequest_FieldViolation{
+					Field:       exportFormatQueryName,
+					Description: "format must be one of ndjson, csv or parquet",
+				},
+			))
+			return
+		}
+		req := &proto.ExportHotelsRequest{}
+		if countryFormat := query.Get(countryFormatQuery); len(countryFormat) > 0 {
+			value, ok := proto.CountryFormat_value[countryFormat]
+			if !ok {
+				HTTPErrorHandler(r.Context(), nil, nil, w, r, newInvalidArgumentError(fmt.Sprintf("unknown country format %q", countryFormat),
+					&errdetails.BadRequest_FieldViolation{
+						Field:       countryFormatQuery,
+						Description: "countryFormat must be COUNTRY_FORMAT_CODE or COUNTRY_FORMAT_NAME",
+					},
+				))
+				return
+			}
+			req.CountryFormat = proto.CountryFormat(value)
+		}
+
+		ctx := metadata.NewOutgoingContext(r.Context(), exportMetadata(r))
+		stream, err := client.ExportHotels(ctx, req)
+		if err != nil {
+			HTTPErrorHandler(ctx, nil, nil, w, r, err)
+			return
+		}
+		// The first hotel is received before answering, so that errors such as a missing credential
+		// still get their HTTP status
+		hotel, err := stream.Recv()
+		header, _ := stream.Header()
+		if err != nil && !errors.Is(err, io.EOF) {
+			HTTPErrorHandler(runtime.NewServerMetadataContext(ctx, runtime.ServerMetadata{HeaderMD: header}), nil, nil, w, r, err)
+			return
+		}
+
+		version := "0"
+		if versions := header.Get(snapshotVersionHeader); len(versions) > 0 {
+			version = versions[0]
+		}
+		w.Header().Set("Content-Type", format.contentType)
+		w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="hotels-%s.%s"`, version, formatName))
+		w.Header().Set("X-Snapshot-Version", version)
+		if requestIDs := header.Get(requestIDHeader); len(requestIDs) > 0 {
+			w.Header().Set("X-Request-Id", requestIDs[0])
+		}
+		if r.Method == http.MethodHead {
+			return
+		}
+
+		encoder, err := format.newEncoder(w)
+		for err == nil && hotel != nil {
+			if err = encoder.Encode(hotel); err != nil {
+				break
+			}
+			hotel, err = stream.Recv()
+		}
+		if errors.Is(err, io.EOF) {
+			err = nil
+		}
+		if err == nil {
+			err = encoder.Close()
+		}
+		if err != nil {
+			// The status is already sent, so the response is cut short to tell the client the export is incomplete
+			panic(http.ErrAbortHandler)
+		}
+	})
+}
+
+// exportMetadata forwards the credentials and request ID of the HTTP request to the gRPC call. Like the
+// gateway, it appends the client IP to X-Forwarded-For, so that unauthenticated exports are rate limited
+// per client rather than as calls from the loopback address.
+func exportMetadata(r *http.Request) metadata.MD {
+	md := metadata.MD{}
+	for _, name := range []string{apiKeyHeader, authorizationHeader, requestIDHeader} {
+		if value := r.Header.Get(name); len(value) > 0 {
+			md.Set(name, value)
+		}
+	}
+	if remoteIP, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+		forwarded := remoteIP
+		if forwardedFor := r.Header.Get(forwardedForHeader); len(forwardedFor) > 0 {
+			forwarded = forwardedFor + ", " + remoteIP
+		}
+		md.Set(forwardedForHeader, forwarded)
+	}
+	return md
+}
@@ -0,0 +1,318 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"hotelsDataMerge/external"
+	"hotelsDataMerge/internal/auth"
+	"hotelsDataMerge/internal/hotels"
+	"hotelsDataMerge/internal/ratelimit"
+	"hotelsDataMerge/proto"
+
+	"github.com/parquet-go/parquet-go"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// newTestExport saves the hotels as snapshot version and returns their export
+func newTestExport(t *testing.T, version int64, hotelsList ...hotels.Hotel) hotels.Export {
+	t.Helper()
+	hotelsByID := make(map[string]hotels.Hotel, len(hotelsList))
+	for _, hotel := range hotelsList {
+		hotelsByID[hotel.Id] = hotel
+	}
+	hotels.SaveMaps(context.Background(), hotelsByID)
+	hotels.SetSnapshotVersion(version)
+	t.Cleanup(hotels.ClearMaps)
+	return hotels.Initialize(slog.Default()).ExportHotels()
+}
+
+// newExportClient serves the export over an in-memory listener, authenticating with the "reader-key" API key
+func newExportClient(t *testing.T, export hotels.Export) proto.HotelDataMergeClient {
+	t.Helper()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	authenticator := &mockAuthenticator{principals: map[string]auth.Principal{
+		"reader-key": {ClientID: "reader", Method: auth.MethodAPIKey, Scopes: []string{auth.ScopeHotelsRead}},
+	}}
+	svr := grpc.NewServer(grpc.ChainStreamInterceptor(RequestIDStreamInterceptor(), AuthStreamInterceptor(logger, authenticator)))
+	proto.RegisterHotelDataMergeServer(svr, &hotelsDataMergeService{logger: logger, hotels: &mockHotels{export: export}})
+	lis := bufconn.Listen(1 << 20)
+	go func() { _ = svr.Serve(lis) }()
+	t.Cleanup(svr.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+	return proto.NewHotelDataMergeClient(conn)
+}
+
+func Test_hotelsDataMergeService_ExportHotels(t *testing.T) {
+	tests := []struct {
+		name        string
+		version     int64
+		apiKey      string
+		setupMutex  func()
+		cleanup     func()
+		wantIDs     []string
+		wantVersion string
+		wantCode    codes.Code
+	}{
+		{
+			name:        "Success - Ordered by ID with the snapshot version",
+			version:     7,
+			apiKey:      "reader-key",
+			wantIDs:     []string{"NilLoc", "SjyX"},
+			wantVersion: "7",
+			wantCode:    codes.OK,
+		},
+		{
+			name:     "Error - Missing credentials",
+			version:  7,
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "Error - No snapshot loaded yet",
+			version:  0,
+			apiKey:   "reader-key",
+			wantCode: codes.Unavailable,
+		},
+		{
+			name:       "Error - Data update in progress",
+			version:    7,
+			apiKey:     "reader-key",
+			setupMutex: external.FetchSuppliersMutex.Lock,
+			cleanup:    external.FetchSuppliersMutex.Unlock,
+			wantCode:   codes.Unavailable,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newExportClient(t, newTestExport(t, tt.version, testHotelWithNilLocation, testHotel))
+			if tt.setupMutex != nil {
+				tt.setupMutex()
+				defer tt.cleanup()
+			}
+			ctx := context.Background()
+			if len(tt.apiKey) > 0 {
+				ctx = metadata.AppendToOutgoingContext(ctx, apiKeyHeader, tt.apiKey)
+			}
+			var header metadata.MD
+			stream, err := client.ExportHotels(ctx, &proto.ExportHotelsRequest{}, grpc.Header(&header))
+			if err != nil {
+				t.Fatalf("ExportHotels() error = %v", err)
+			}
+			var gotIDs []string
+			for {
+				hotel, err := stream.Recv()
+				if errors.Is(err, io.EOF) {
+					break
+				}
+				if err != nil {
+					if code := status.Code(err); code != tt.wantCode {
+						t.Fatalf("ExportHotels() code = %v, wantCode %v", code, tt.wantCode)
+					}
+					return
+				}
+				gotIDs = append(gotIDs, hotel.Id)
+			}
+			if tt.wantCode != codes.OK {
+				t.Fatalf("ExportHotels() code = %v, wantCode %v", codes.OK, tt.wantCode)
+			}
+			if !reflect.DeepEqual(gotIDs, tt.wantIDs) {
+				t.Errorf("ExportHotels() hotels = %v, want %v", gotIDs, tt.wantIDs)
+			}
+			if got := header.Get(snapshotVersionHeader); !reflect.DeepEqual(got, []string{tt.wantVersion}) {
+				t.Errorf("ExportHotels() %s = %v, want %v", snapshotVersionHeader, got, tt.wantVersion)
+			}
+		})
+	}
+}
+
+func TestExportHandler(t *testing.T) {
+	handler := ExportHandler(newExportClient(t, newTestExport(t, 7, testHotelWithNilLocation, testHotel)))
+
+	tests := []struct {
+		name            string
+		query           string
+		apiKey          string
+		wantStatus      int
+		wantContentType string
+		check           func(t *testing.T, body []byte)
+	}{
+		{
+			name:            "Success - NDJSON by default",
+			apiKey:          "reader-key",
+			wantStatus:      http.StatusOK,
+			wantContentType: "application/x-ndjson",
+			check: func(t *testing.T, body []byte) {
+				lines := strings.Split(strings.TrimSuffix(string(body), "\n"), "\n")
+				if len(lines) != 2 || !strings.Contains(lines[1], `"destinationId":"123"`) {
+					t.Errorf("ExportHandler() NDJSON = %s, want 2 hotels with lowerCamelCase fields", body)
+				}
+			},
+		},
+		{
+			name:            "Success - CSV with flattened columns",
+			query:           "format=csv&countryFormat=COUNTRY_FORMAT_CODE",
+			apiKey:          "reader-key",
+			wantStatus:      http.StatusOK,
+			wantContentType: "text/csv; charset=utf-8",
+			check: func(t *testing.T, body []byte) {
+				records, err := csv.NewReader(bytes.NewReader(body)).ReadAll()
+				if err != nil || len(records) != 3 {
+					t.Fatalf("ExportHandler() CSV = %q, %v, want a header and 2 rows", records, err)
+				}
+				row := make(map[string]string)
+				for i, column := range records[0] {
+					row[column] = records[2][i]
+				}
+				want := map[string]string{
+					"id":                           "SjyX",
+					"location.lat":                 "40.7128",
+					"location.city":                "Test City",
+					"amenities.general":            "WiFi|Pool",
+					"images.rooms":                 "http://example.com/room1.jpg",
+					"booking_conditions":           "No smoking|No pets",
+					"booking_policy.pets":          "",
+					"location.country":             "Test Country",
+					"amenities.room":               "TV|AC",
+					"images.amenities":             "http://example.com/amenity1.jpg",
+					"booking_policy.check_in_from": "",
+				}
+				for column, value := range want {
+					if row[column] != value {
+						t.Errorf("ExportHandler() CSV %s = %q, want %q", column, row[column], value)
+					}
+				}
+			},
+		},
+		{
+			name:            "Success - Parquet",
+			query:           "format=parquet",
+			apiKey:          "reader-key",
+			wantStatus:      http.StatusOK,
+			wantContentType: "application/vnd.apache.parquet",
+			check: func(t *testing.T, body []byte) {
+				rows, err := parquet.Read[parquetHotel](bytes.NewReader(body), int64(len(body)))
+				if err != nil {
+					t.Fatalf("parquet.Read() error = %v", err)
+				}
+				if len(rows) != 2 || rows[1].ID != "SjyX" || !reflect.DeepEqual(rows[1].Images.Rooms, []parquetImage{{Link: "http://example.com/room1.jpg", Description: "Room 1"}}) {
+					t.Errorf("ExportHandler() Parquet = %+v, want the 2 hotels with their images", rows)
+				}
+			},
+		},
+		{
+			name:       "Error - Unknown format",
+			query:      "format=xml",
+			apiKey:     "reader-key",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "Error - Unknown country format",
+			query:      "countryFormat=ALPHA3",
+			apiKey:     "reader-key",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "Error - Missing credentials",
+			wantStatus: http.StatusUnauthorized,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/v1/exports/hotels?"+tt.query, nil)
+			if len(tt.apiKey) > 0 {
+				r.Header.Set("X-Api-Key", tt.apiKey)
+			}
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, r)
+			if w.Code != tt.wantStatus {
+				t.Fatalf("ExportHandler() status = %v, want %v: %s", w.Code, tt.wantStatus, w.Body)
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			if contentType := w.Header().Get("Content-Type"); contentType != tt.wantContentType {
+				t.Errorf("ExportHandler() Content-Type = %q, want %q", contentType, tt.wantContentType)
+			}
+			if version := w.Header().Get("X-Snapshot-Version"); version != "7" {
+				t.Errorf("ExportHandler() X-Snapshot-Version = %q, want 7", version)
+			}
+			if requestID := w.Header().Get("X-Request-Id"); len(requestID) == 0 {
+				t.Errorf("ExportHandler() has no X-Request-Id")
+			}
+			tt.check(t, w.Body.Bytes())
+		})
+	}
+}
+
+func TestExportHandler_rateLimitedPerClient(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	// One export per client, so a second export from the same client is rejected
+	limiter, err := ratelimit.Initialize(ratelimit.Config{Default: ratelimit.Limit{RequestsPerSecond: 0.001, Burst: 1}})
+	if err != nil {
+		t.Fatalf("ratelimit.Initialize() error = %v", err)
+	}
+	svr := grpc.NewServer(grpc.StreamInterceptor(RateLimitStreamInterceptor(logger, limiter, nil)))
+	proto.RegisterHotelDataMergeServer(svr, &hotelsDataMergeService{logger: logger, hotels: &mockHotels{export: newTestExport(t, 7, testHotel)}})
+	// The gateway reaches the gRPC server over the loopback interface, which bufconn does not reproduce
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Listen() error = %v", err)
+	}
+	go func() { _ = svr.Serve(lis) }()
+	t.Cleanup(svr.Stop)
+	conn, err := grpc.NewClient(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+	handler := ExportHandler(proto.NewHotelDataMergeClient(conn))
+
+	tests := []struct {
+		name         string
+		remoteAddr   string
+		forwardedFor string
+		wantStatus   int
+	}{
+		{name: "Success - First client", remoteAddr: "203.0.113.1:40000", wantStatus: http.StatusOK},
+		{name: "Success - Second client", remoteAddr: "203.0.113.2:40000", wantStatus: http.StatusOK},
+		{name: "Error - First client again", remoteAddr: "203.0.113.1:40001", wantStatus: http.StatusTooManyRequests},
+		{name: "Error - First client again with a spoofed X-Forwarded-For", remoteAddr: "203.0.113.1:40002", forwardedFor: "198.51.100.7", wantStatus: http.StatusTooManyRequests},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/v1/exports/hotels", nil)
+			r.RemoteAddr = tt.remoteAddr
+			if len(tt.forwardedFor) > 0 {
+				r.Header.Set("X-Forwarded-For", tt.forwardedFor)
+			}
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, r)
+			if w.Code != tt.wantStatus {
+				t.Errorf("ExportHandler() status = %v, want %v: %s", w.Code, tt.wantStatus, w.Body)
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package server
+
+import (
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+)
+
+// HTTPIncomingHeaderMatcher forwards If-None-Match, X-Request-Id and X-Api-Key to the gRPC server
+// under the same keys gRPC clients use, and every other header as the gateway does by default.
+// The gateway forwards Authorization on its own.
+func HTTPIncomingHeaderMatcher(key string) (string, bool) {
+	switch strings.ToLower(key) {
+	case ifNoneMatchHeader:
+		return ifNoneMatchHeader, true
+	case requestIDHeader:
+		return requestIDHeader, true
+	case apiKeyHeader:
+		return apiKeyHeader, true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// HTTPOutgoingHeaderMatcher returns the ETag, X-Request-Id and Retry-After as standard HTTP headers
+func HTTPOutgoingHeaderMatcher(key string) (string, bool) {
+	switch strings.ToLower(key) {
+	case etagHeader:
+		return "ETag", true
+	case requestIDHeader:
+		return "X-Request-Id", true
+	case retryAfterHeader:
+		return "Retry-After", true
+	}
+	return runtime.MetadataHeaderPrefix + key, true
+}
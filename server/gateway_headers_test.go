@@ -0,0 +1,35 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+)
+
+func TestHTTPHeaderMatchers(t *testing.T) {
+	tests := []struct {
+		name    string
+		matcher func(string) (string, bool)
+		key     string
+		want    string
+		wantOk  bool
+	}{
+		{name: "Success - Incoming If-None-Match", matcher: HTTPIncomingHeaderMatcher, key: "If-None-Match", want: ifNoneMatchHeader, wantOk: true},
+		{name: "Success - Incoming permanent header", matcher: HTTPIncomingHeaderMatcher, key: "Accept", want: runtime.MetadataPrefix + "Accept", wantOk: true},
+		{name: "Success - Incoming unknown header", matcher: HTTPIncomingHeaderMatcher, key: "X-Custom", want: "", wantOk: false},
+		{name: "Success - Outgoing ETag", matcher: HTTPOutgoingHeaderMatcher, key: etagHeader, want: "ETag", wantOk: true},
+		{name: "Success - Incoming X-Api-Key", matcher: HTTPIncomingHeaderMatcher, key: "X-Api-Key", want: apiKeyHeader, wantOk: true},
+		{name: "Success - Incoming X-Request-Id", matcher: HTTPIncomingHeaderMatcher, key: "X-Request-Id", want: requestIDHeader, wantOk: true},
+		{name: "Success - Outgoing request ID", matcher: HTTPOutgoingHeaderMatcher, key: requestIDHeader, want: "X-Request-Id", wantOk: true},
+		{name: "Success - Outgoing Retry-After", matcher: HTTPOutgoingHeaderMatcher, key: retryAfterHeader, want: "Retry-After", wantOk: true},
+		{name: "Success - Outgoing other metadata", matcher: HTTPOutgoingHeaderMatcher, key: "x-custom", want: runtime.MetadataHeaderPrefix + "x-custom", wantOk: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tt.matcher(tt.key)
+			if got != tt.want || ok != tt.wantOk {
+				t.Errorf("matcher(%q) = %v, %v, want %v, %v", tt.key, got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"hotelsDataMerge/external"
+	"hotelsDataMerge/proto"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+var getHotelMethodName = "[GetHotel]"
+
+// GetHotel returns a single hotel with its ETag in the response header. When the client's
+// If-None-Match matches the ETag, a not modified error is returned instead, answered with 304 by the gateway.
+func (h *hotelsDataMergeService) GetHotel(ctx context.Context, req *proto.GetHotelRequest) (resp *proto.Hotel, err error) {
+	if !external.FetchSuppliersMutex.TryRLock() {
+		h.logger.ErrorContext(ctx, fmt.Sprintf("%s Cannot acquire read lock - suppliers data update in progress", getHotelMethodName))
+		return resp, errDataUpdateInProgress
+	}
+	defer external.FetchSuppliersMutex.RUnlock()
+
+	if len(strings.TrimSpace(req.Id)) == 0 {
+		return resp, newInvalidArgumentError("hotel ID was not specified", &errdetails.BadRequest_FieldViolation{
+			Field:       "id",
+			Description: "id must not be empty",
+		})
+	}
+	readMask, err := parseReadMask(req.ReadMask)
+	if err != nil {
+		h.logger.ErrorContext(ctx, fmt.Sprintf("%s Invalid read mask. %s", getHotelMethodName, err))
+		return resp, err
+	}
+	hotel, ok := h.hotels.GetHotel(req.Id)
+	if !ok {
+		return resp, newNotFoundError(resourceTypeHotel, req.Id)
+	}
+
+	resp = h.constructHotel(hotel, req.CountryFormat)
+	if readMask != nil {
+		readMask.prune(resp.ProtoReflect())
+	}
+	etag, err := computeETag(resp)
+	if err != nil {
+		h.logger.ErrorContext(ctx, fmt.Sprintf("%s Error computing ETag: %s", getHotelMethodName, err))
+		return nil, status.Error(codes.Internal, "failed to get hotel")
+	}
+
+	if err := grpc.SetHeader(ctx, metadata.Pairs(etagHeader, etag)); err != nil {
+		h.logger.WarnContext(ctx, fmt.Sprintf("%s Error setting response header: %s", getHotelMethodName, err))
+	}
+	if notModified(ctx, etag) {
+		return nil, newNotModifiedError(etag)
+	}
+	return resp, nil
+}
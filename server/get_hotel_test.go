@@ -0,0 +1,141 @@
+package server
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"hotelsDataMerge/external"
+	"hotelsDataMerge/internal/hotels"
+	"hotelsDataMerge/proto"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	protobuf "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// mockServerTransportStream records the headers set by a handler
+type mockServerTransportStream struct {
+	header metadata.MD
+}
+
+func (m *mockServerTransportStream) Method() string { return "/proto.HotelDataMerge/GetHotel" }
+
+func (m *mockServerTransportStream) SetHeader(md metadata.MD) error {
+	m.header = metadata.Join(m.header, md)
+	return nil
+}
+
+func (m *mockServerTransportStream) SendHeader(md metadata.MD) error { return m.SetHeader(md) }
+
+func (m *mockServerTransportStream) SetTrailer(metadata.MD) error { return nil }
+
+func Test_hotelsDataMergeService_GetHotel(t *testing.T) {
+	h := &hotelsDataMergeService{
+		logger: slog.Default(),
+		hotels: &mockHotels{hotels: []hotels.Hotel{testHotel}},
+	}
+	fullHotel := h.constructHotel(testHotel, proto.CountryFormat_COUNTRY_FORMAT_CODE)
+	fullETag, err := computeETag(fullHotel)
+	if err != nil {
+		t.Fatalf("computeETag() error = %v", err)
+	}
+
+	tests := []struct {
+		name         string
+		req          *proto.GetHotelRequest
+		ifNoneMatch  string
+		setupMutex   func()
+		cleanupMutex func()
+		want         *proto.Hotel
+		wantETag     string
+		wantCode     codes.Code
+	}{
+		{
+			name:     "Success - Get hotel with ETag",
+			req:      &proto.GetHotelRequest{Id: "SjyX"},
+			want:     fullHotel,
+			wantETag: fullETag,
+		},
+		{
+			name: "Success - Get hotel with read mask",
+			req: &proto.GetHotelRequest{
+				Id:       "SjyX",
+				ReadMask: &fieldmaskpb.FieldMask{Paths: []string{"id", "name"}},
+			},
+			want: &proto.Hotel{Id: "SjyX", Name: "Test Hotel"},
+		},
+		{
+			name:        "Success - Stale ETag",
+			req:         &proto.GetHotelRequest{Id: "SjyX"},
+			ifNoneMatch: `"stale"`,
+			want:        fullHotel,
+			wantETag:    fullETag,
+		},
+		{
+			name:        "Error - Not modified",
+			req:         &proto.GetHotelRequest{Id: "SjyX"},
+			ifNoneMatch: `"other", ` + fullETag,
+			wantETag:    fullETag,
+			wantCode:    codes.FailedPrecondition,
+		},
+		{
+			name:     "Error - Hotel does not exist",
+			req:      &proto.GetHotelRequest{Id: "InvalidID"},
+			wantCode: codes.NotFound,
+		},
+		{
+			name:     "Error - Empty hotel ID",
+			req:      &proto.GetHotelRequest{Id: " "},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "Error - Unknown read mask path",
+			req:      &proto.GetHotelRequest{Id: "SjyX", ReadMask: &fieldmaskpb.FieldMask{Paths: []string{"rating"}}},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "Error - Mutex lock failure",
+			req:  &proto.GetHotelRequest{Id: "SjyX"},
+			setupMutex: func() {
+				external.FetchSuppliersMutex.Lock()
+			},
+			cleanupMutex: func() {
+				external.FetchSuppliersMutex.Unlock()
+			},
+			wantCode: codes.Unavailable,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setupMutex != nil {
+				tt.setupMutex()
+			}
+			if tt.cleanupMutex != nil {
+				defer tt.cleanupMutex()
+			}
+
+			stream := &mockServerTransportStream{}
+			ctx := grpc.NewContextWithServerTransportStream(context.Background(), stream)
+			if len(tt.ifNoneMatch) > 0 {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(ifNoneMatchHeader, tt.ifNoneMatch))
+			}
+
+			got, err := h.GetHotel(ctx, tt.req)
+			if code := status.Code(err); code != tt.wantCode {
+				t.Fatalf("GetHotel() code = %v, wantCode %v (%v)", code, tt.wantCode, err)
+			}
+			if !protobuf.Equal(got, tt.want) {
+				t.Errorf("GetHotel() = %v, want %v", got, tt.want)
+			}
+			if len(tt.wantETag) > 0 {
+				if etag := stream.header.Get(etagHeader); len(etag) != 1 || etag[0] != tt.wantETag {
+					t.Errorf("GetHotel() etag = %v, want %v", etag, tt.wantETag)
+				}
+			}
+		})
+	}
+}
@@ -2,124 +2,205 @@ package server
 
 import (
 	"context"
-	"errors"
 	"fmt"
-	"net/http"
+	"strconv"
 
 	"hotelsDataMerge/external"
 	"hotelsDataMerge/internal/hotels"
+	"hotelsDataMerge/internal/suppliers/countries"
 	"hotelsDataMerge/proto"
 
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
 var methodName = "[GetHotels]"
 
 func (h *hotelsDataMergeService) GetHotels(ctx context.Context, req *proto.GetHotelsRequest) (resp *proto.GetHotelsResponse, err error) {
-	h.logger.InfoContext(ctx, methodName+fmt.Sprintf(" API request : %+v", req))
-
 	if !external.FetchSuppliersMutex.TryRLock() {
 		h.logger.ErrorContext(ctx, fmt.Sprintf("%s Cannot acquire read lock - suppliers data update in progress", methodName))
-		return resp, status.Error(http.StatusServiceUnavailable, "Service temporarily unavailable - data update in progress")
+		return resp, errDataUpdateInProgress
 	}
 	defer external.FetchSuppliersMutex.RUnlock()
 
 	if err = h.validateRequest(req); err != nil {
 		h.logger.ErrorContext(ctx, fmt.Sprintf("%s Invalid request. %s", methodName, err))
-		return resp, status.Error(http.StatusBadRequest, "Invalid request")
+		return resp, err
+	}
+	readMask, err := parseReadMask(req.ReadMask)
+	if err != nil {
+		h.logger.ErrorContext(ctx, fmt.Sprintf("%s Invalid read mask. %s", methodName, err))
+		return resp, err
 	}
 	hotelsList, err := h.hotels.GetHotels(req.HotelIDs, req.DestinationId)
 	if err != nil {
 		h.logger.ErrorContext(ctx, fmt.Sprintf("%s Error getting hotels: %s", methodName, err))
-		return resp, status.Error(http.StatusServiceUnavailable, err.Error())
+		return resp, status.Error(codes.Internal, "failed to get hotels")
 	}
-	resp = h.constructResponse(hotelsList)
-	h.logger.InfoContext(ctx, methodName+fmt.Sprintf(" API response : %+v", resp))
+	resp = h.constructResponse(hotelsList, req.CountryFormat)
+	applyReadMask(resp, readMask)
 	return resp, nil
 }
 
+// validateRequest returns an InvalidArgument error when no filter or too many hotel IDs are given
+// and a NotFound error listing every hotel ID or the destination ID that does not exist
 func (h *hotelsDataMergeService) validateRequest(req *proto.GetHotelsRequest) (err error) {
 	if len(req.HotelIDs) == 0 && req.DestinationId == 0 {
-		return errors.New("no request parameters were specified")
+		return newInvalidArgumentError("no request parameters were specified",
+			&errdetails.BadRequest_FieldViolation{
+				Field:       "hotelIDs",
+				Description: "at least one of hotelIDs or destinationId must be specified",
+			},
+			&errdetails.BadRequest_FieldViolation{
+				Field:       "destinationId",
+				Description: "at least one of hotelIDs or destinationId must be specified",
+			},
+		)
+	}
+	if h.maxHotelIDs > 0 && len(req.HotelIDs) > h.maxHotelIDs {
+		return newInvalidArgumentError(fmt.Sprintf("too many hotelIDs: %d", len(req.HotelIDs)),
+			&errdetails.BadRequest_FieldViolation{
+				Field:       "hotelIDs",
+				Description: fmt.Sprintf("at most %d hotelIDs can be requested at once", h.maxHotelIDs),
+			},
+		)
 	}
 	if len(req.HotelIDs) > 0 {
 		hotelIDsMap := hotels.GetHotelIDsMap()
+		var missingHotelIDs []string
 		for _, hotelID := range req.HotelIDs {
 			if !hotelIDsMap[hotelID] {
-				return fmt.Errorf("hotel ID %s does not exist", hotelID)
+				missingHotelIDs = append(missingHotelIDs, hotelID)
 			}
 		}
+		if len(missingHotelIDs) > 0 {
+			return newNotFoundError(resourceTypeHotel, missingHotelIDs...)
+		}
 	}
 	if req.DestinationId != 0 {
 		destinationIDsMap := hotels.GetDestinationIDsMap()
 		if !destinationIDsMap[req.DestinationId] {
-			return fmt.Errorf("destination ID '%d' does not exist", req.DestinationId)
+			return newNotFoundError(resourceTypeDestination, strconv.FormatUint(req.DestinationId, 10))
 		}
 	}
 	return nil
 }
 
-func (h *hotelsDataMergeService) constructResponse(hotels []hotels.Hotel) (resp *proto.GetHotelsResponse) {
+func (h *hotelsDataMergeService) constructResponse(hotels []hotels.Hotel, countryFormat proto.CountryFormat) (resp *proto.GetHotelsResponse) {
 	if len(hotels) == 0 {
 		return resp
 	}
 	hotelsResp := make([]*proto.Hotel, 0)
 	for _, hotel := range hotels {
-		hotelResp := &proto.Hotel{
-			Id:                hotel.Id,
-			DestinationId:     int64(hotel.DestinationId),
-			Name:              hotel.Name,
-			Location:          &proto.Location{},
-			Description:       hotel.Description,
-			Amenities:         &proto.HotelAmenities{},
-			Images:            &proto.Image{},
-			BookingConditions: hotel.BookingConditions,
+		hotelsResp = append(hotelsResp, h.constructHotel(hotel, countryFormat))
+	}
+	resp = &proto.GetHotelsResponse{
+		Hotels: hotelsResp,
+	}
+	return resp
+}
+
+func (h *hotelsDataMergeService) constructHotel(hotel hotels.Hotel, countryFormat proto.CountryFormat) *proto.Hotel {
+	hotelResp := &proto.Hotel{
+		Id:                hotel.Id,
+		DestinationId:     int64(hotel.DestinationId),
+		Name:              hotel.Name,
+		Location:          &proto.Location{},
+		Description:       hotel.Description,
+		Amenities:         &proto.HotelAmenities{},
+		Images:            &proto.Image{},
+		BookingConditions: hotel.BookingConditions,
+		BookingPolicy:     constructBookingPolicy(hotel.BookingPolicy),
+	}
+	if hotel.Location != nil {
+		if lat, ok := hotel.Location.Lat.(float64); ok {
+			hotelResp.Location.Lat = lat
 		}
-		if hotel.Location != nil {
-			if lat, ok := hotel.Location.Lat.(float64); ok {
-				hotelResp.Location.Lat = lat
-			}
-			if lng, ok := hotel.Location.Lng.(float64); ok {
-				hotelResp.Location.Lng = lng
-			}
-			if len(hotel.Location.Address) > 0 {
-				hotelResp.Location.Address = hotel.Location.Address
-			}
-			if len(hotel.Location.City) > 0 {
-				hotelResp.Location.City = hotel.Location.City
-			}
-			if len(hotel.Location.Country) > 0 {
-				hotelResp.Location.Country = hotel.Location.Country
-			}
+		if lng, ok := hotel.Location.Lng.(float64); ok {
+			hotelResp.Location.Lng = lng
 		}
-		if len(hotel.Description) > 0 {
-			hotelResp.Description = hotel.Description
+		if len(hotel.Location.Address) > 0 {
+			hotelResp.Location.Address = hotel.Location.Address
 		}
-		if hotel.Amenities != nil {
-			if len(hotel.Amenities.General) > 0 {
-				hotelResp.Amenities.General = hotel.Amenities.General
-			}
-			if len(hotel.Amenities.Room) > 0 {
-				hotelResp.Amenities.Room = hotel.Amenities.Room
-			}
+		if len(hotel.Location.City) > 0 {
+			hotelResp.Location.City = hotel.Location.City
 		}
-		if hotel.Images != nil {
-			if len(hotel.Images.Rooms) > 0 {
-				hotelResp.Images.Rooms = constructRoomImageDetails(hotel.Images.Rooms)
-			}
-			if len(hotel.Images.Site) > 0 {
-				hotelResp.Images.Site = constructSiteImageDetails(hotel.Images.Site)
-			}
-			if len(hotel.Images.Amenities) > 0 {
-				hotelResp.Images.Amenities = constructAmenitiesImageDetails(hotel.Images.Amenities)
-			}
+		if len(hotel.Location.Country) > 0 {
+			hotelResp.Location.Country = formatCountry(hotel.Location.Country, countryFormat)
 		}
-		hotelsResp = append(hotelsResp, hotelResp)
 	}
-	resp = &proto.GetHotelsResponse{
-		Hotels: hotelsResp,
+	if len(hotel.Description) > 0 {
+		hotelResp.Description = hotel.Description
 	}
-	return resp
+	if hotel.Amenities != nil {
+		if len(hotel.Amenities.General) > 0 {
+			hotelResp.Amenities.General = hotel.Amenities.General
+		}
+		if len(hotel.Amenities.Room) > 0 {
+			hotelResp.Amenities.Room = hotel.Amenities.Room
+		}
+	}
+	if hotel.Images != nil {
+		if len(hotel.Images.Rooms) > 0 {
+			hotelResp.Images.Rooms = constructRoomImageDetails(hotel.Images.Rooms)
+		}
+		if len(hotel.Images.Site) > 0 {
+			hotelResp.Images.Site = constructSiteImageDetails(hotel.Images.Site)
+		}
+		if len(hotel.Images.Amenities) > 0 {
+			hotelResp.Images.Amenities = constructAmenitiesImageDetails(hotel.Images.Amenities)
+		}
+	}
+	return hotelResp
+}
+
+func constructBookingPolicy(policy *hotels.BookingPolicy) *proto.BookingPolicy {
+	if policy == nil {
+		return nil
+	}
+	policyResp := &proto.BookingPolicy{
+		CheckInFrom:           policy.CheckInFrom,
+		CheckOutUntil:         policy.CheckOutUntil,
+		Pets:                  petPolicies[policy.Pets],
+		DepositRequired:       policy.DepositRequired,
+		PrepaymentRequired:    policy.PrepaymentRequired,
+		PhotoIdRequired:       policy.PhotoIDRequired,
+		CreditCardRequired:    policy.CreditCardRequired,
+		UnrecognizedSentences: policy.UnrecognizedSentences,
+	}
+	if policy.Cancellation != nil {
+		policyResp.Cancellation = &proto.CancellationPolicy{
+			FreeCancellation:      policy.Cancellation.FreeCancellation,
+			FreeCancellationHours: int32(policy.Cancellation.FreeCancellationHours),
+			NonRefundable:         policy.Cancellation.NonRefundable,
+		}
+	}
+	if policy.Children != nil {
+		policyResp.Children = &proto.ChildPolicy{
+			ChildrenAllowed:    policy.Children.ChildrenAllowed,
+			FreeStayUnderAge:   int32(policy.Children.FreeStayUnderAge),
+			MaxCribs:           int32(policy.Children.MaxCribs),
+			ExtraBedsAvailable: policy.Children.ExtraBedsAvailable,
+			ExtraBedCharge:     policy.Children.ExtraBedCharge,
+		}
+	}
+	return policyResp
+}
+
+var petPolicies = map[hotels.PetPolicy]proto.PetPolicy{
+	hotels.PetsUnknown:    proto.PetPolicy_PETS_UNKNOWN,
+	hotels.PetsAllowed:    proto.PetPolicy_PETS_ALLOWED,
+	hotels.PetsNotAllowed: proto.PetPolicy_PETS_NOT_ALLOWED,
+	hotels.PetsOnRequest:  proto.PetPolicy_PETS_ON_REQUEST,
+}
+
+// formatCountry returns the ISO 3166-1 alpha-2 code or the display name of a country
+func formatCountry(country string, countryFormat proto.CountryFormat) string {
+	if countryFormat == proto.CountryFormat_COUNTRY_FORMAT_NAME {
+		return countries.Default().CountryName(country)
+	}
+	return country
 }
 
 func constructRoomImageDetails(imageDetails []hotels.HotelImageDetails) []*proto.Room {
@@ -10,11 +10,17 @@ import (
 	"hotelsDataMerge/external"
 	"hotelsDataMerge/internal/hotels"
 	"hotelsDataMerge/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 )
 
 type mockHotels struct {
-	hotels []hotels.Hotel
-	err    error
+	hotels       []hotels.Hotel
+	destinations []hotels.Destination
+	export       hotels.Export
+	err          error
 }
 
 func (m *mockHotels) GetHotels(hotelIDs []string, destinationID uint64) ([]hotels.Hotel, error) {
@@ -24,6 +30,23 @@ func (m *mockHotels) GetHotels(hotelIDs []string, destinationID uint64) ([]hotel
 	return m.hotels, nil
 }
 
+func (m *mockHotels) ListDestinations() []hotels.Destination {
+	return m.destinations
+}
+
+func (m *mockHotels) GetHotel(hotelID string) (hotels.Hotel, bool) {
+	for _, hotel := range m.hotels {
+		if hotel.Id == hotelID {
+			return hotel, true
+		}
+	}
+	return hotels.Hotel{}, false
+}
+
+func (m *mockHotels) ExportHotels() hotels.Export {
+	return m.export
+}
+
 var (
 	testHotel = hotels.Hotel{
 		Id:            "SjyX",
@@ -92,9 +115,9 @@ var (
 )
 
 func setupTestMaps() {
-	hotels.SaveMaps(map[string]hotels.Hotel{})
+	hotels.SaveMaps(context.Background(), map[string]hotels.Hotel{})
 
-	hotels.SaveMaps(map[string]hotels.Hotel{
+	hotels.SaveMaps(context.Background(), map[string]hotels.Hotel{
 		"SjyX":     testHotel,
 		"NilLoc":   testHotelWithNilLocation,
 		"EmptyStr": testHotelWithEmptyStrings,
@@ -107,6 +130,7 @@ func Test_hotelsDataMergeService_GetHotels(t *testing.T) {
 	type fields struct {
 		logger                            *slog.Logger
 		hotels                            hotels.IntHotels
+		maxHotelIDs                       int
 		UnimplementedHotelDataMergeServer proto.UnimplementedHotelDataMergeServer
 	}
 	type args struct {
@@ -119,6 +143,7 @@ func Test_hotelsDataMergeService_GetHotels(t *testing.T) {
 		args         args
 		wantResp     *proto.GetHotelsResponse
 		wantErr      bool
+		wantCode     codes.Code
 		setupMutex   func()
 		cleanupMutex func()
 	}{
@@ -338,6 +363,7 @@ func Test_hotelsDataMergeService_GetHotels(t *testing.T) {
 			},
 			wantResp: nil,
 			wantErr:  true,
+			wantCode: codes.Unavailable,
 			setupMutex: func() {
 				external.FetchSuppliersMutex.Lock()
 			},
@@ -363,6 +389,7 @@ func Test_hotelsDataMergeService_GetHotels(t *testing.T) {
 			},
 			wantResp: nil,
 			wantErr:  true,
+			wantCode: codes.InvalidArgument,
 		},
 		{
 			name: "Error - Validation: invalid hotel ID",
@@ -382,6 +409,28 @@ func Test_hotelsDataMergeService_GetHotels(t *testing.T) {
 			},
 			wantResp: nil,
 			wantErr:  true,
+			wantCode: codes.NotFound,
+		},
+		{
+			name: "Error - Validation: too many hotel IDs",
+			fields: fields{
+				logger: slog.Default(),
+				hotels: &mockHotels{
+					hotels: []hotels.Hotel{testHotel},
+					err:    nil,
+				},
+				maxHotelIDs: 1,
+			},
+			args: args{
+				ctx: context.Background(),
+				req: &proto.GetHotelsRequest{
+					HotelIDs:      []string{"SjyX", "SjyX"},
+					DestinationId: 0,
+				},
+			},
+			wantResp: nil,
+			wantErr:  true,
+			wantCode: codes.InvalidArgument,
 		},
 		{
 			name: "Error - Validation: invalid destination ID",
@@ -401,6 +450,27 @@ func Test_hotelsDataMergeService_GetHotels(t *testing.T) {
 			},
 			wantResp: nil,
 			wantErr:  true,
+			wantCode: codes.NotFound,
+		},
+		{
+			name: "Error - Validation: unknown read mask path",
+			fields: fields{
+				logger: slog.Default(),
+				hotels: &mockHotels{
+					hotels: []hotels.Hotel{testHotel},
+					err:    nil,
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				req: &proto.GetHotelsRequest{
+					HotelIDs: []string{"SjyX"},
+					ReadMask: &fieldmaskpb.FieldMask{Paths: []string{"rating"}},
+				},
+			},
+			wantResp: nil,
+			wantErr:  true,
+			wantCode: codes.InvalidArgument,
 		},
 		{
 			name: "Error - Hotels service error",
@@ -420,6 +490,7 @@ func Test_hotelsDataMergeService_GetHotels(t *testing.T) {
 			},
 			wantResp: nil,
 			wantErr:  true,
+			wantCode: codes.Internal,
 		},
 	}
 
@@ -435,6 +506,7 @@ func Test_hotelsDataMergeService_GetHotels(t *testing.T) {
 			h := &hotelsDataMergeService{
 				logger:                            tt.fields.logger,
 				hotels:                            tt.fields.hotels,
+				maxHotelIDs:                       tt.fields.maxHotelIDs,
 				UnimplementedHotelDataMergeServer: tt.fields.UnimplementedHotelDataMergeServer,
 			}
 			gotResp, err := h.GetHotels(tt.args.ctx, tt.args.req)
@@ -442,6 +514,9 @@ func Test_hotelsDataMergeService_GetHotels(t *testing.T) {
 				t.Errorf("GetHotels() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
+			if code := status.Code(err); code != tt.wantCode {
+				t.Errorf("GetHotels() code = %v, wantCode %v", code, tt.wantCode)
+			}
 			if !reflect.DeepEqual(gotResp, tt.wantResp) {
 				if gotResp == nil && tt.wantResp == nil {
 					return
@@ -519,6 +594,86 @@ func Test_hotelsDataMergeService_GetHotels(t *testing.T) {
 	}
 }
 
+func Test_formatCountry(t *testing.T) {
+	tests := []struct {
+		name          string
+		country       string
+		countryFormat proto.CountryFormat
+		want          string
+	}{
+		{name: "Success - Country code", country: "SG", countryFormat: proto.CountryFormat_COUNTRY_FORMAT_CODE, want: "SG"},
+		{name: "Success - Country name", country: "SG", countryFormat: proto.CountryFormat_COUNTRY_FORMAT_NAME, want: "Singapore"},
+		{name: "Success - Unknown country kept as is", country: "Test Country", countryFormat: proto.CountryFormat_COUNTRY_FORMAT_NAME, want: "Test Country"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := formatCountry(tt.country, tt.countryFormat); got != tt.want {
+				t.Errorf("formatCountry() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_constructBookingPolicy(t *testing.T) {
+	childrenAllowed := true
+	tests := []struct {
+		name   string
+		policy *hotels.BookingPolicy
+		want   *proto.BookingPolicy
+	}{
+		{
+			name: "Success - Full policy",
+			policy: &hotels.BookingPolicy{
+				CheckInFrom:        "15:00",
+				CheckOutUntil:      "11:00",
+				Pets:               hotels.PetsNotAllowed,
+				Cancellation:       &hotels.CancellationPolicy{FreeCancellation: true, FreeCancellationHours: 48},
+				PhotoIDRequired:    true,
+				CreditCardRequired: true,
+				Children: &hotels.ChildPolicy{
+					ChildrenAllowed:  &childrenAllowed,
+					FreeStayUnderAge: 12,
+					MaxCribs:         1,
+					ExtraBedCharge:   "SGD 82.39 per person per night",
+				},
+				UnrecognizedSentences: []string{"WiFi is available in all areas and is free of charge."},
+			},
+			want: &proto.BookingPolicy{
+				CheckInFrom:        "15:00",
+				CheckOutUntil:      "11:00",
+				Pets:               proto.PetPolicy_PETS_NOT_ALLOWED,
+				Cancellation:       &proto.CancellationPolicy{FreeCancellation: true, FreeCancellationHours: 48},
+				PhotoIdRequired:    true,
+				CreditCardRequired: true,
+				Children: &proto.ChildPolicy{
+					ChildrenAllowed:  &childrenAllowed,
+					FreeStayUnderAge: 12,
+					MaxCribs:         1,
+					ExtraBedCharge:   "SGD 82.39 per person per night",
+				},
+				UnrecognizedSentences: []string{"WiFi is available in all areas and is free of charge."},
+			},
+		},
+		{
+			name:   "Success - Pets only",
+			policy: &hotels.BookingPolicy{Pets: hotels.PetsOnRequest},
+			want:   &proto.BookingPolicy{Pets: proto.PetPolicy_PETS_ON_REQUEST},
+		},
+		{
+			name:   "Success - No policy",
+			policy: nil,
+			want:   nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := constructBookingPolicy(tt.policy); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("constructBookingPolicy() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func Test_constructRoomImageDetails(t *testing.T) {
 	imageDetails := []hotels.HotelImageDetails{
 		{Link: "http://example.com/room1.jpg", Description: "Room 1"},
@@ -578,7 +733,7 @@ func Test_constructResponse_EmptyHotels(t *testing.T) {
 		logger: slog.Default(),
 	}
 
-	result := h.constructResponse([]hotels.Hotel{})
+	result := h.constructResponse([]hotels.Hotel{}, proto.CountryFormat_COUNTRY_FORMAT_CODE)
 
 	if result != nil {
 		t.Errorf("constructResponse() with empty hotels should return nil, got %v", result)
@@ -608,7 +763,7 @@ func Test_constructResponse_MultipleHotels(t *testing.T) {
 		},
 	}
 
-	result := h.constructResponse(hotels)
+	result := h.constructResponse(hotels, proto.CountryFormat_COUNTRY_FORMAT_CODE)
 
 	//nolint:staticcheck
 	if result == nil {
@@ -0,0 +1,35 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"hotelsDataMerge/internal/health"
+)
+
+// HealthzHandler answers liveness probes: it only checks the process is serving HTTP
+func HealthzHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+	})
+}
+
+// ReadyzHandler answers readiness probes with the readiness report, with 503 Service Unavailable
+// until a fresh enough snapshot is loaded and every critical supplier is healthy
+func ReadyzHandler(intHealth health.IntHealth) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		report := intHealth.Readiness()
+		httpStatus := http.StatusOK
+		if !report.Ready {
+			httpStatus = http.StatusServiceUnavailable
+		}
+		writeJSON(w, httpStatus, report)
+	})
+}
+
+func writeJSON(w http.ResponseWriter, httpStatus int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(httpStatus)
+	_ = json.NewEncoder(w).Encode(body)
+}
@@ -0,0 +1,77 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"hotelsDataMerge/internal/health"
+
+	grpchealth "google.golang.org/grpc/health"
+)
+
+type mockHealth struct {
+	report health.Report
+}
+
+func (m *mockHealth) Readiness() health.Report {
+	return m.report
+}
+
+func (m *mockHealth) UpdateGRPCStatus(ctx context.Context, svr *grpchealth.Server, services []string, interval time.Duration) {
+}
+
+func TestHealthzHandler(t *testing.T) {
+	w := httptest.NewRecorder()
+	HealthzHandler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+	if w.Code != http.StatusOK {
+		t.Errorf("HealthzHandler() status = %v, want %v", w.Code, http.StatusOK)
+	}
+}
+
+func TestReadyzHandler(t *testing.T) {
+	tests := []struct {
+		name       string
+		report     health.Report
+		wantStatus int
+	}{
+		{
+			name: "Success - Ready",
+			report: health.Report{
+				Ready:     true,
+				Snapshot:  &health.SnapshotReport{Version: 2, Age: "1m0s", HotelCount: 3},
+				Suppliers: []health.SupplierReport{{Supplier: "acme", State: "ok", Healthy: true, RecordCount: 3}},
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name: "Error - Not ready",
+			report: health.Report{
+				Ready:     false,
+				Reasons:   []string{"no snapshot loaded yet"},
+				Suppliers: []health.SupplierReport{{Supplier: "acme"}},
+			},
+			wantStatus: http.StatusServiceUnavailable,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			ReadyzHandler(&mockHealth{report: tt.report}).ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+			if w.Code != tt.wantStatus {
+				t.Errorf("ReadyzHandler() status = %v, want %v", w.Code, tt.wantStatus)
+			}
+			var got health.Report
+			if err := json.Unmarshal(w.Body.Bytes(), &got); err != nil {
+				t.Fatalf("ReadyzHandler() body = %s, error = %v", w.Body.String(), err)
+			}
+			if !reflect.DeepEqual(got, tt.report) {
+				t.Errorf("ReadyzHandler() body = %+v, want %+v", got, tt.report)
+			}
+		})
+	}
+}
@@ -10,12 +10,15 @@ import (
 type hotelsDataMergeService struct {
 	logger *slog.Logger
 	hotels hotels.IntHotels
+	// maxHotelIDs is the maximum number of hotelIDs in a GetHotels request; 0 means no maximum
+	maxHotelIDs int
 	proto.UnimplementedHotelDataMergeServer
 }
 
-func NewHotelsDataMergeService(logger *slog.Logger) proto.HotelDataMergeServer {
+func NewHotelsDataMergeService(logger *slog.Logger, maxHotelIDs int) proto.HotelDataMergeServer {
 	return &hotelsDataMergeService{
-		logger: logger,
-		hotels: hotels.Initialize(logger),
+		logger:      logger,
+		hotels:      hotels.Initialize(logger),
+		maxHotelIDs: maxHotelIDs,
 	}
 }
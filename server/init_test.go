@@ -11,7 +11,8 @@ import (
 
 func TestNewHotelsDataMergeService(t *testing.T) {
 	type args struct {
-		logger *slog.Logger
+		logger      *slog.Logger
+		maxHotelIDs int
 	}
 	tests := []struct {
 		name string
@@ -20,16 +21,17 @@ func TestNewHotelsDataMergeService(t *testing.T) {
 	}{
 		{
 			name: "Success",
-			args: args{logger: slog.Default()},
+			args: args{logger: slog.Default(), maxHotelIDs: 100},
 			want: &hotelsDataMergeService{
-				logger: slog.Default(),
-				hotels: hotels.Initialize(slog.Default()),
+				logger:      slog.Default(),
+				hotels:      hotels.Initialize(slog.Default()),
+				maxHotelIDs: 100,
 			},
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := NewHotelsDataMergeService(tt.args.logger); !reflect.DeepEqual(got, tt.want) {
+			if got := NewHotelsDataMergeService(tt.args.logger, tt.args.maxHotelIDs); !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("NewHotelsDataMergeService() = %v, want %v", got, tt.want)
 			}
 		})
@@ -0,0 +1,162 @@
+package server
+
+import (
+	"cmp"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"hotelsDataMerge/external"
+	"hotelsDataMerge/internal/hotels"
+	"hotelsDataMerge/proto"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+const (
+	defaultDestinationsPageSize = 50
+	maxDestinationsPageSize     = 1000
+)
+
+var listDestinationsMethodName = "[ListDestinations]"
+
+func (h *hotelsDataMergeService) ListDestinations(ctx context.Context, req *proto.ListDestinationsRequest) (resp *proto.ListDestinationsResponse, err error) {
+	if !external.FetchSuppliersMutex.TryRLock() {
+		h.logger.ErrorContext(ctx, fmt.Sprintf("%s Cannot acquire read lock - suppliers data update in progress", listDestinationsMethodName))
+		return resp, errDataUpdateInProgress
+	}
+	defer external.FetchSuppliersMutex.RUnlock()
+
+	pageSize, offset, err := parsePagination(req)
+	if err != nil {
+		h.logger.ErrorContext(ctx, fmt.Sprintf("%s Invalid request. %s", listDestinationsMethodName, err))
+		return resp, err
+	}
+
+	destinations := h.hotels.ListDestinations()
+	sortDestinations(destinations, req.SortBy, req.Descending, req.CountryFormat)
+
+	resp = &proto.ListDestinationsResponse{
+		Destinations: make([]*proto.Destination, 0, pageSize),
+		TotalSize:    int32(len(destinations)),
+	}
+	end := min(offset+pageSize, len(destinations))
+	for _, destination := range destinations[min(offset, end):end] {
+		resp.Destinations = append(resp.Destinations, constructDestination(destination, req.CountryFormat))
+	}
+	if end < len(destinations) {
+		resp.NextPageToken = encodePageToken(end, req)
+	}
+	return resp, nil
+}
+
+// parsePagination returns the page size and the offset of the first destination of the page
+func parsePagination(req *proto.ListDestinationsRequest) (pageSize, offset int, err error) {
+	pageSize = int(req.PageSize)
+	switch {
+	case pageSize < 0:
+		return 0, 0, newInvalidArgumentError("invalid page size", &errdetails.BadRequest_FieldViolation{
+			Field:       "page_size",
+			Description: "page_size must not be negative",
+		})
+	case pageSize == 0:
+		pageSize = defaultDestinationsPageSize
+	case pageSize > maxDestinationsPageSize:
+		pageSize = maxDestinationsPageSize
+	}
+
+	if len(req.PageToken) > 0 {
+		offset, err = decodePageToken(req.PageToken, req)
+		if err != nil {
+			return 0, 0, newInvalidArgumentError("invalid page token", &errdetails.BadRequest_FieldViolation{
+				Field:       "page_token",
+				Description: err.Error(),
+			})
+		}
+	}
+	return pageSize, offset, nil
+}
+
+// encodePageToken encodes the offset together with the sort order and the country format, which
+// changes the order by country, so that a token cannot be reused with a different order
+func encodePageToken(offset int, req *proto.ListDestinationsRequest) string {
+	token := fmt.Sprintf("%d:%d:%t:%d", offset, req.SortBy, req.Descending, req.CountryFormat)
+	return base64.RawURLEncoding.EncodeToString([]byte(token))
+}
+
+func decodePageToken(pageToken string, req *proto.ListDestinationsRequest) (int, error) {
+	data, err := base64.RawURLEncoding.DecodeString(pageToken)
+	if err != nil {
+		return 0, fmt.Errorf("page_token is malformed")
+	}
+	parts := strings.Split(string(data), ":")
+	if len(parts) != 4 {
+		return 0, fmt.Errorf("page_token is malformed")
+	}
+	offset, err := strconv.Atoi(parts[0])
+	if err != nil || offset < 0 {
+		return 0, fmt.Errorf("page_token is malformed")
+	}
+	if parts[1] != strconv.Itoa(int(req.SortBy)) || parts[2] != strconv.FormatBool(req.Descending) ||
+		parts[3] != strconv.Itoa(int(req.CountryFormat)) {
+		return 0, fmt.Errorf("page_token was issued for a different sort order")
+	}
+	return offset, nil
+}
+
+// sortDestinations orders destinations by the requested field, then by ID. Countries are compared
+// as they are returned, by code or by name depending on countryFormat.
+func sortDestinations(destinations []hotels.Destination, sortBy proto.DestinationSortBy, descending bool, countryFormat proto.CountryFormat) {
+	compare := func(a, b hotels.Destination) int {
+		switch sortBy {
+		case proto.DestinationSortBy_DESTINATION_SORT_BY_HOTEL_COUNT:
+			return cmp.Compare(a.HotelCount, b.HotelCount)
+		case proto.DestinationSortBy_DESTINATION_SORT_BY_CITY:
+			return strings.Compare(a.City, b.City)
+		case proto.DestinationSortBy_DESTINATION_SORT_BY_COUNTRY:
+			return strings.Compare(formatCountry(a.Country, countryFormat), formatCountry(b.Country, countryFormat))
+		}
+		return 0
+	}
+	sort.SliceStable(destinations, func(i, j int) bool {
+		order := compare(destinations[i], destinations[j])
+		if order == 0 {
+			order = cmp.Compare(destinations[i].Id, destinations[j].Id)
+		}
+		if descending {
+			return order > 0
+		}
+		return order < 0
+	})
+}
+
+func constructDestination(destination hotels.Destination, countryFormat proto.CountryFormat) *proto.Destination {
+	destinationResp := &proto.Destination{
+		Id:         destination.Id,
+		HotelCount: int32(destination.HotelCount),
+		City:       destination.City,
+	}
+	if len(destination.Country) > 0 {
+		destinationResp.Country = formatCountry(destination.Country, countryFormat)
+	}
+	if destination.BoundingBox != nil {
+		destinationResp.BoundingBox = &proto.BoundingBox{
+			SouthWest: constructLatLng(destination.BoundingBox.SouthWest),
+			NorthEast: constructLatLng(destination.BoundingBox.NorthEast),
+		}
+	}
+	if destination.Centroid != nil {
+		destinationResp.Centroid = constructLatLng(*destination.Centroid)
+	}
+	return destinationResp
+}
+
+func constructLatLng(coordinates hotels.Coordinates) *proto.LatLng {
+	return &proto.LatLng{
+		Lat: coordinates.Lat,
+		Lng: coordinates.Lng,
+	}
+}
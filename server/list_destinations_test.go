@@ -0,0 +1,193 @@
+package server
+
+import (
+	"context"
+	"log/slog"
+	"reflect"
+	"testing"
+
+	"hotelsDataMerge/external"
+	"hotelsDataMerge/internal/hotels"
+	"hotelsDataMerge/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func testDestinations() []hotels.Destination {
+	return []hotels.Destination{
+		{
+			Id:          1122,
+			HotelCount:  1,
+			City:        "Tokyo",
+			Country:     "JP",
+			BoundingBox: &hotels.BoundingBox{SouthWest: hotels.Coordinates{Lat: 35.6926, Lng: 139.690965}, NorthEast: hotels.Coordinates{Lat: 35.6926, Lng: 139.690965}},
+			Centroid:    &hotels.Coordinates{Lat: 35.6926, Lng: 139.690965},
+		},
+		{Id: 5432, HotelCount: 2, City: "Singapore", Country: "SG"},
+		{Id: 7788, HotelCount: 2, City: "Paris", Country: "FR"},
+	}
+}
+
+func destinationIDs(resp *proto.ListDestinationsResponse) []uint64 {
+	var ids []uint64
+	for _, destination := range resp.GetDestinations() {
+		ids = append(ids, destination.Id)
+	}
+	return ids
+}
+
+func Test_hotelsDataMergeService_ListDestinations(t *testing.T) {
+	tests := []struct {
+		name          string
+		req           *proto.ListDestinationsRequest
+		setupMutex    func()
+		cleanupMutex  func()
+		wantIDs       []uint64
+		wantNextPage  bool
+		wantTotalSize int32
+		wantCode      codes.Code
+	}{
+		{
+			name:          "Success - Default order",
+			req:           &proto.ListDestinationsRequest{},
+			wantIDs:       []uint64{1122, 5432, 7788},
+			wantTotalSize: 3,
+		},
+		{
+			name:          "Success - Hotel count descending, ties by ID",
+			req:           &proto.ListDestinationsRequest{SortBy: proto.DestinationSortBy_DESTINATION_SORT_BY_HOTEL_COUNT, Descending: true},
+			wantIDs:       []uint64{7788, 5432, 1122},
+			wantTotalSize: 3,
+		},
+		{
+			name:          "Success - City ascending",
+			req:           &proto.ListDestinationsRequest{SortBy: proto.DestinationSortBy_DESTINATION_SORT_BY_CITY},
+			wantIDs:       []uint64{7788, 5432, 1122},
+			wantTotalSize: 3,
+		},
+		{
+			name:          "Success - First page",
+			req:           &proto.ListDestinationsRequest{PageSize: 2},
+			wantIDs:       []uint64{1122, 5432},
+			wantNextPage:  true,
+			wantTotalSize: 3,
+		},
+		{
+			name:          "Success - Last page",
+			req:           &proto.ListDestinationsRequest{PageSize: 2, PageToken: encodePageToken(2, &proto.ListDestinationsRequest{})},
+			wantIDs:       []uint64{7788},
+			wantTotalSize: 3,
+		},
+		{
+			name:          "Success - Page past the end",
+			req:           &proto.ListDestinationsRequest{PageSize: 2, PageToken: encodePageToken(10, &proto.ListDestinationsRequest{})},
+			wantIDs:       nil,
+			wantTotalSize: 3,
+		},
+		{
+			name:     "Error - Negative page size",
+			req:      &proto.ListDestinationsRequest{PageSize: -1},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "Error - Malformed page token",
+			req:      &proto.ListDestinationsRequest{PageToken: "not a token"},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "Error - Page token from a different sort order",
+			req:      &proto.ListDestinationsRequest{SortBy: proto.DestinationSortBy_DESTINATION_SORT_BY_CITY, PageToken: encodePageToken(2, &proto.ListDestinationsRequest{})},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "Error - Page token from a different country format",
+			req:      &proto.ListDestinationsRequest{CountryFormat: proto.CountryFormat_COUNTRY_FORMAT_NAME, PageToken: encodePageToken(2, &proto.ListDestinationsRequest{})},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "Error - Mutex lock failure",
+			req:  &proto.ListDestinationsRequest{},
+			setupMutex: func() {
+				external.FetchSuppliersMutex.Lock()
+			},
+			cleanupMutex: func() {
+				external.FetchSuppliersMutex.Unlock()
+			},
+			wantCode: codes.Unavailable,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setupMutex != nil {
+				tt.setupMutex()
+			}
+			if tt.cleanupMutex != nil {
+				defer tt.cleanupMutex()
+			}
+
+			h := &hotelsDataMergeService{
+				logger: slog.Default(),
+				hotels: &mockHotels{destinations: testDestinations()},
+			}
+			got, err := h.ListDestinations(context.Background(), tt.req)
+			if code := status.Code(err); code != tt.wantCode {
+				t.Fatalf("ListDestinations() code = %v, wantCode %v (%v)", code, tt.wantCode, err)
+			}
+			if ids := destinationIDs(got); !reflect.DeepEqual(ids, tt.wantIDs) {
+				t.Errorf("ListDestinations() ids = %v, want %v", ids, tt.wantIDs)
+			}
+			if (got.GetNextPageToken() != "") != tt.wantNextPage {
+				t.Errorf("ListDestinations() next page token = %q, wantNextPage %v", got.GetNextPageToken(), tt.wantNextPage)
+			}
+			if got.GetTotalSize() != tt.wantTotalSize {
+				t.Errorf("ListDestinations() total size = %v, want %v", got.GetTotalSize(), tt.wantTotalSize)
+			}
+		})
+	}
+}
+
+func Test_sortDestinations(t *testing.T) {
+	tests := []struct {
+		name          string
+		countryFormat proto.CountryFormat
+		descending    bool
+		wantIDs       []uint64
+	}{
+		{name: "Success - Country codes", countryFormat: proto.CountryFormat_COUNTRY_FORMAT_CODE, wantIDs: []uint64{1, 2, 3}},
+		{name: "Success - Country names", countryFormat: proto.CountryFormat_COUNTRY_FORMAT_NAME, wantIDs: []uint64{2, 3, 1}},
+		{name: "Success - Country names descending", countryFormat: proto.CountryFormat_COUNTRY_FORMAT_NAME, descending: true, wantIDs: []uint64{1, 3, 2}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			destinations := []hotels.Destination{
+				{Id: 1, Country: "CH"},
+				{Id: 2, Country: "DE"},
+				{Id: 3, Country: "DE"},
+			}
+			sortDestinations(destinations, proto.DestinationSortBy_DESTINATION_SORT_BY_COUNTRY, tt.descending, tt.countryFormat)
+			var ids []uint64
+			for _, destination := range destinations {
+				ids = append(ids, destination.Id)
+			}
+			if !reflect.DeepEqual(ids, tt.wantIDs) {
+				t.Errorf("sortDestinations() ids = %v, want %v", ids, tt.wantIDs)
+			}
+		})
+	}
+}
+
+func Test_constructDestination(t *testing.T) {
+	got := constructDestination(testDestinations()[0], proto.CountryFormat_COUNTRY_FORMAT_NAME)
+	want := &proto.Destination{
+		Id:          1122,
+		HotelCount:  1,
+		City:        "Tokyo",
+		Country:     "Japan",
+		BoundingBox: &proto.BoundingBox{SouthWest: &proto.LatLng{Lat: 35.6926, Lng: 139.690965}, NorthEast: &proto.LatLng{Lat: 35.6926, Lng: 139.690965}},
+		Centroid:    &proto.LatLng{Lat: 35.6926, Lng: 139.690965},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("constructDestination() = %v, want %v", got, want)
+	}
+}
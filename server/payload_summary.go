@@ -0,0 +1,75 @@
+package server
+
+import (
+	"fmt"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	protobuf "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const redactedValue = "[REDACTED]"
+
+func redactSet(fieldNames []string) map[string]bool {
+	redact := make(map[string]bool, len(fieldNames))
+	for _, fieldName := range fieldNames {
+		redact[fieldName] = true
+	}
+	return redact
+}
+
+// summarizePayload returns the JSON encoding of a message with the redacted fields masked,
+// truncated to maxBytes. A maxBytes of zero or less disables the truncation.
+func summarizePayload(payload any, redact map[string]bool, maxBytes int) string {
+	message, ok := payload.(protobuf.Message)
+	if !ok || message == nil || !message.ProtoReflect().IsValid() {
+		return ""
+	}
+	if len(redact) > 0 {
+		message = protobuf.Clone(message)
+		redactFields(message.ProtoReflect(), redact)
+	}
+	data, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(message)
+	if err != nil {
+		return fmt.Sprintf("<%s>", message.ProtoReflect().Descriptor().FullName())
+	}
+	if maxBytes <= 0 || len(data) <= maxBytes {
+		return string(data)
+	}
+	cut := maxBytes
+	for cut > 0 && !utf8.RuneStart(data[cut]) {
+		cut--
+	}
+	return fmt.Sprintf("%s...(truncated, %d bytes)", data[:cut], len(data))
+}
+
+// redactFields masks the string fields named in redact and clears the other fields with those names
+func redactFields(message protoreflect.Message, redact map[string]bool) {
+	message.Range(func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		switch {
+		case redact[string(field.Name())]:
+			if field.Kind() == protoreflect.StringKind && !field.IsList() && !field.IsMap() {
+				message.Set(field, protoreflect.ValueOfString(redactedValue))
+			} else {
+				message.Clear(field)
+			}
+		case field.Kind() != protoreflect.MessageKind && field.Kind() != protoreflect.GroupKind:
+		case field.IsList():
+			list := value.List()
+			for i := 0; i < list.Len(); i++ {
+				redactFields(list.Get(i).Message(), redact)
+			}
+		case field.IsMap():
+			if field.MapValue().Kind() == protoreflect.MessageKind {
+				value.Map().Range(func(_ protoreflect.MapKey, mapValue protoreflect.Value) bool {
+					redactFields(mapValue.Message(), redact)
+					return true
+				})
+			}
+		default:
+			redactFields(value.Message(), redact)
+		}
+		return true
+	})
+}
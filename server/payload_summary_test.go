@@ -0,0 +1,92 @@
+package server
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"hotelsDataMerge/proto"
+)
+
+func Test_summarizePayload(t *testing.T) {
+	tests := []struct {
+		name     string
+		payload  any
+		redact   map[string]bool
+		maxBytes int
+		want     string
+		// wantTruncated checks the summary is cut to maxBytes on a character boundary instead of comparing it,
+		// as protojson does not guarantee its whitespace
+		wantTruncated bool
+	}{
+		{
+			name:     "Success - Summarize request",
+			payload:  &proto.GetHotelRequest{Id: "iJhz"},
+			maxBytes: 512,
+			want:     `{"id":"iJhz"}`,
+		},
+		{
+			name:     "Success - Redact string field",
+			payload:  &proto.GetHotelRequest{Id: "iJhz"},
+			redact:   map[string]bool{"id": true},
+			maxBytes: 512,
+			want:     `{"id":"[REDACTED]"}`,
+		},
+		{
+			name: "Success - Redact nested and repeated fields",
+			payload: &proto.GetHotelsResponse{Hotels: []*proto.Hotel{
+				{Id: "iJhz", Description: "long text", BookingConditions: []string{"no pets"}},
+			}},
+			redact:   map[string]bool{"description": true, "booking_conditions": true},
+			maxBytes: 512,
+			want:     `{"hotels":[{"id":"iJhz","description":"[REDACTED]"}]}`,
+		},
+		{
+			name:          "Success - Truncate long payload",
+			payload:       &proto.GetHotelRequest{Id: strings.Repeat("a", 50)},
+			maxBytes:      10,
+			wantTruncated: true,
+		},
+		{
+			name:          "Success - Truncate on a character boundary",
+			payload:       &proto.GetHotelRequest{Id: "ééééé"},
+			maxBytes:      10,
+			wantTruncated: true,
+		},
+		{
+			name:     "Success - Nil message",
+			payload:  (*proto.GetHotelRequest)(nil),
+			maxBytes: 512,
+			want:     "",
+		},
+		{
+			name:     "Success - Not a message",
+			payload:  "raw",
+			maxBytes: 512,
+			want:     "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := summarizePayload(tt.payload, tt.redact, tt.maxBytes)
+			if tt.wantTruncated {
+				kept, _, found := strings.Cut(got, "...(truncated, ")
+				if !found || len(kept) > tt.maxBytes || len(kept) < tt.maxBytes-3 || !utf8.ValidString(kept) {
+					t.Errorf("summarizePayload() = %q, want a valid summary truncated to %d bytes", got, tt.maxBytes)
+				}
+				return
+			}
+			if got = strings.ReplaceAll(got, " ", ""); got != tt.want {
+				t.Errorf("summarizePayload() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_summarizePayload_DoesNotModifyMessage(t *testing.T) {
+	req := &proto.GetHotelRequest{Id: "iJhz"}
+	summarizePayload(req, map[string]bool{"id": true}, 512)
+	if req.Id != "iJhz" {
+		t.Errorf("summarizePayload() modified the request: id = %q", req.Id)
+	}
+}
@@ -0,0 +1,148 @@
+package server
+
+import (
+	"context"
+	"log/slog"
+	"math"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"hotelsDataMerge/internal/auth"
+	"hotelsDataMerge/internal/metrics"
+	"hotelsDataMerge/internal/ratelimit"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+const (
+	// retryAfterHeader carries the whole seconds a rate-limited client should wait, as in HTTP Retry-After
+	retryAfterHeader = "retry-after"
+	// forwardedForHeader is set by the gateway to the address of the HTTP client
+	forwardedForHeader = "x-forwarded-for"
+	// authFailureClientPrefix keys the buckets charged with failed authentications, apart from those of
+	// unauthenticated clients
+	authFailureClientPrefix = "auth-failure:ip:"
+)
+
+// RateLimitUnaryInterceptor rejects calls beyond the client's limit for the method with RESOURCE_EXHAUSTED,
+// a RetryInfo detail and a retry-after header. It must run after authentication, since clients are
+// identified by their authenticated ID and otherwise by their IP. A nil limiter disables rate limiting.
+func RateLimitUnaryInterceptor(logger *slog.Logger, limiter ratelimit.IntLimiter, appMetrics *metrics.Metrics) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if err := rateLimit(ctx, logger, limiter, appMetrics, info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// RateLimitStreamInterceptor is the streaming counterpart of RateLimitUnaryInterceptor; a stream takes one token
+func RateLimitStreamInterceptor(logger *slog.Logger, limiter ratelimit.IntLimiter, appMetrics *metrics.Metrics) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := rateLimit(ss.Context(), logger, limiter, appMetrics, info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
+
+func rateLimit(ctx context.Context, logger *slog.Logger, limiter ratelimit.IntLimiter, appMetrics *metrics.Metrics, fullMethod string) error {
+	if limiter == nil || publicMethods[fullMethod] {
+		return nil
+	}
+	client := clientIdentity(ctx)
+	allowed, retryAfter := limiter.Allow(fullMethod, client)
+	if allowed {
+		return nil
+	}
+	return rateLimitedError(ctx, logger, appMetrics, fullMethod, client, retryAfter)
+}
+
+// AuthFailureLimitUnaryInterceptor charges calls rejected as UNAUTHENTICATED or PERMISSION_DENIED to the
+// client IP, and rejects the calls of an IP that has used up its limit before they are authenticated, so
+// that credentials cannot be guessed without limit. It must run before authentication. A nil limiter
+// disables it.
+func AuthFailureLimitUnaryInterceptor(logger *slog.Logger, limiter ratelimit.IntLimiter, appMetrics *metrics.Metrics) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+		err = limitAuthFailures(ctx, logger, limiter, appMetrics, info.FullMethod, func() error {
+			resp, err = handler(ctx, req)
+			return err
+		})
+		return resp, err
+	}
+}
+
+// AuthFailureLimitStreamInterceptor is the streaming counterpart of AuthFailureLimitUnaryInterceptor
+func AuthFailureLimitStreamInterceptor(logger *slog.Logger, limiter ratelimit.IntLimiter, appMetrics *metrics.Metrics) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return limitAuthFailures(ss.Context(), logger, limiter, appMetrics, info.FullMethod, func() error {
+			return handler(srv, ss)
+		})
+	}
+}
+
+func limitAuthFailures(ctx context.Context, logger *slog.Logger, limiter ratelimit.IntLimiter, appMetrics *metrics.Metrics, fullMethod string, call func() error) error {
+	if limiter == nil || publicMethods[fullMethod] {
+		return call()
+	}
+	client := authFailureClientPrefix + clientIP(ctx)
+	if exhausted, retryAfter := limiter.Exhausted(fullMethod, client); exhausted {
+		return rateLimitedError(ctx, logger, appMetrics, fullMethod, client, retryAfter)
+	}
+	err := call()
+	if code := status.Code(err); code == codes.Unauthenticated || code == codes.PermissionDenied {
+		limiter.Allow(fullMethod, client)
+	}
+	return err
+}
+
+// rateLimitedError counts and logs a rejected call, sets its retry-after header and returns its error
+func rateLimitedError(ctx context.Context, logger *slog.Logger, appMetrics *metrics.Metrics, fullMethod, client string, retryAfter time.Duration) error {
+	appMetrics.ObserveRateLimited(fullMethod)
+	logger.WarnContext(ctx, "[RateLimit] Request rejected", "method", fullMethod, "client", client, "retryAfter", retryAfter)
+	retryAfterSeconds := int(math.Ceil(retryAfter.Seconds()))
+	_ = grpc.SetHeader(ctx, metadata.Pairs(retryAfterHeader, strconv.Itoa(retryAfterSeconds)))
+	return withDetails(status.Newf(codes.ResourceExhausted, "rate limit exceeded, retry in %d seconds", retryAfterSeconds),
+		&errdetails.RetryInfo{RetryDelay: durationpb.New(retryAfter.Round(time.Millisecond))},
+	)
+}
+
+// clientIdentity returns the authenticated client ID or, without authentication, the client IP
+func clientIdentity(ctx context.Context) string {
+	if principal, ok := auth.FromContext(ctx); ok {
+		return "client:" + principal.ClientID
+	}
+	return "ip:" + clientIP(ctx)
+}
+
+// clientIP returns the IP of the peer or, when the peer is the gateway on the loopback interface,
+// the IP of the HTTP client it forwarded. The gateway appends that IP to any X-Forwarded-For sent by
+// the client, so only the last entry is trusted.
+func clientIP(ctx context.Context) string {
+	var ip string
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		ip = p.Addr.String()
+		if host, _, err := net.SplitHostPort(ip); err == nil {
+			ip = host
+		}
+	}
+	if parsed := net.ParseIP(ip); parsed == nil || !parsed.IsLoopback() {
+		return ip
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	if forwarded := md.Get(forwardedForHeader); len(forwarded) > 0 {
+		entries := strings.Split(forwarded[len(forwarded)-1], ",")
+		if last := strings.TrimSpace(entries[len(entries)-1]); len(last) > 0 {
+			return last
+		}
+	}
+	return ip
+}
@@ -0,0 +1,220 @@
+package server
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net"
+	"testing"
+	"time"
+
+	"hotelsDataMerge/internal/auth"
+	"hotelsDataMerge/internal/ratelimit"
+	"hotelsDataMerge/proto"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+type mockLimiter struct {
+	allowed    bool
+	retryAfter time.Duration
+	gotMethod  string
+	gotClient  string
+}
+
+func (m *mockLimiter) Allow(fullMethod string, client string) (bool, time.Duration) {
+	m.gotMethod = fullMethod
+	m.gotClient = client
+	return m.allowed, m.retryAfter
+}
+
+func (m *mockLimiter) Exhausted(fullMethod string, client string) (bool, time.Duration) {
+	return !m.allowed, m.retryAfter
+}
+
+func TestAuthFailureLimitUnaryInterceptor(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	limiter, err := ratelimit.Initialize(ratelimit.Config{Default: ratelimit.Limit{RequestsPerSecond: 0.001, Burst: 2}})
+	if err != nil {
+		t.Fatalf("Initialize() error = %v", err)
+	}
+	authenticator := &mockAuthenticator{principals: map[string]auth.Principal{
+		"reader-key": {ClientID: "reader", Method: auth.MethodAPIKey, Scopes: []string{auth.ScopeHotelsRead}},
+	}}
+	limitInterceptor := AuthFailureLimitUnaryInterceptor(logger, limiter, nil)
+	authInterceptor := AuthUnaryInterceptor(logger, authenticator)
+	info := &grpc.UnaryServerInfo{FullMethod: proto.HotelDataMerge_GetHotels_FullMethodName}
+	handler := func(ctx context.Context, req any) (any, error) { return "ok", nil }
+
+	tests := []struct {
+		name     string
+		ip       string
+		apiKey   string
+		wantCode codes.Code
+	}{
+		{name: "Error - First bad key", ip: "203.0.113.7", apiKey: "guess-1", wantCode: codes.Unauthenticated},
+		{name: "Error - Second bad key", ip: "203.0.113.7", apiKey: "guess-2", wantCode: codes.Unauthenticated},
+		{name: "Error - Bad keys beyond the limit", ip: "203.0.113.7", apiKey: "guess-3", wantCode: codes.ResourceExhausted},
+		{name: "Error - Good key from the limited IP", ip: "203.0.113.7", apiKey: "reader-key", wantCode: codes.ResourceExhausted},
+		{name: "Error - Bad key from another IP", ip: "198.51.100.2", apiKey: "guess-1", wantCode: codes.Unauthenticated},
+		{name: "Success - Good key from another IP", ip: "198.51.100.2", apiKey: "reader-key", wantCode: codes.OK},
+		{name: "Success - Good keys are not charged", ip: "198.51.100.2", apiKey: "reader-key", wantCode: codes.OK},
+		{name: "Error - Bad key after good ones", ip: "198.51.100.2", apiKey: "guess-2", wantCode: codes.Unauthenticated},
+		{name: "Error - Limited after its own failures", ip: "198.51.100.2", apiKey: "guess-3", wantCode: codes.ResourceExhausted},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := grpc.NewContextWithServerTransportStream(context.Background(), &mockServerTransportStream{})
+			ctx = peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(tt.ip), Port: 50000}})
+			ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(apiKeyHeader, tt.apiKey))
+
+			_, err := limitInterceptor(ctx, nil, info, func(ctx context.Context, req any) (any, error) {
+				return authInterceptor(ctx, req, info, handler)
+			})
+			if code := status.Code(err); code != tt.wantCode {
+				t.Errorf("AuthFailureLimitUnaryInterceptor() code = %v, wantCode %v", code, tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestRateLimitUnaryInterceptor(t *testing.T) {
+	tests := []struct {
+		name           string
+		limiter        *mockLimiter
+		wantCode       codes.Code
+		wantRetryAfter string
+		wantRetryDelay time.Duration
+	}{
+		{
+			name:     "Success - Within the limit",
+			limiter:  &mockLimiter{allowed: true},
+			wantCode: codes.OK,
+		},
+		{
+			name:           "Error - Limit exceeded",
+			limiter:        &mockLimiter{allowed: false, retryAfter: 1500 * time.Millisecond},
+			wantCode:       codes.ResourceExhausted,
+			wantRetryAfter: "2",
+			wantRetryDelay: 1500 * time.Millisecond,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+			stream := &mockServerTransportStream{}
+			ctx := grpc.NewContextWithServerTransportStream(context.Background(), stream)
+			ctx = auth.NewContext(ctx, auth.Principal{ClientID: "partner-a"})
+
+			handler := func(ctx context.Context, req any) (any, error) { return "ok", nil }
+			info := &grpc.UnaryServerInfo{FullMethod: proto.HotelDataMerge_GetHotels_FullMethodName}
+			_, err := RateLimitUnaryInterceptor(logger, tt.limiter, nil)(ctx, nil, info, handler)
+
+			st := status.Convert(err)
+			if st.Code() != tt.wantCode {
+				t.Fatalf("RateLimitUnaryInterceptor() code = %v, wantCode %v", st.Code(), tt.wantCode)
+			}
+			if tt.limiter.gotMethod != info.FullMethod || tt.limiter.gotClient != "client:partner-a" {
+				t.Errorf("Allow() called with %q, %q", tt.limiter.gotMethod, tt.limiter.gotClient)
+			}
+			var gotRetryAfter string
+			if values := stream.header.Get(retryAfterHeader); len(values) > 0 {
+				gotRetryAfter = values[0]
+			}
+			if gotRetryAfter != tt.wantRetryAfter {
+				t.Errorf("RateLimitUnaryInterceptor() retry-after = %q, want %q", gotRetryAfter, tt.wantRetryAfter)
+			}
+			var gotRetryDelay time.Duration
+			for _, detail := range st.Details() {
+				if retryInfo, ok := detail.(*errdetails.RetryInfo); ok {
+					gotRetryDelay = retryInfo.RetryDelay.AsDuration()
+				}
+			}
+			if gotRetryDelay != tt.wantRetryDelay {
+				t.Errorf("RateLimitUnaryInterceptor() retry delay = %v, want %v", gotRetryDelay, tt.wantRetryDelay)
+			}
+		})
+	}
+}
+
+func TestRateLimitUnaryInterceptor_Disabled(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	handler := func(ctx context.Context, req any) (any, error) { return "ok", nil }
+	var limiter ratelimit.IntLimiter
+	got, err := RateLimitUnaryInterceptor(logger, limiter, nil)(context.Background(), nil, &grpc.UnaryServerInfo{}, handler)
+	if err != nil || got != "ok" {
+		t.Errorf("RateLimitUnaryInterceptor() = %v, %v, want ok, nil", got, err)
+	}
+}
+
+func TestRateLimitUnaryInterceptor_HealthCheck(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	handler := func(ctx context.Context, req any) (any, error) { return "ok", nil }
+	limiter := &mockLimiter{allowed: false, retryAfter: time.Second}
+	info := &grpc.UnaryServerInfo{FullMethod: healthpb.Health_Check_FullMethodName}
+	got, err := RateLimitUnaryInterceptor(logger, limiter, nil)(context.Background(), nil, info, handler)
+	if err != nil || got != "ok" {
+		t.Errorf("RateLimitUnaryInterceptor() = %v, %v, want ok, nil", got, err)
+	}
+}
+
+func Test_clientIdentity(t *testing.T) {
+	remotePeer := &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("203.0.113.7"), Port: 51234}}
+	gatewayPeer := &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: 51234}}
+
+	tests := []struct {
+		name      string
+		principal *auth.Principal
+		peer      *peer.Peer
+		md        metadata.MD
+		want      string
+	}{
+		{
+			name:      "Success - Authenticated client",
+			principal: &auth.Principal{ClientID: "partner-a"},
+			peer:      remotePeer,
+			want:      "client:partner-a",
+		},
+		{
+			name: "Success - Peer IP",
+			peer: remotePeer,
+			md:   metadata.Pairs(forwardedForHeader, "198.51.100.1"),
+			want: "ip:203.0.113.7",
+		},
+		{
+			name: "Success - Client IP forwarded by the gateway",
+			peer: gatewayPeer,
+			md:   metadata.Pairs(forwardedForHeader, "198.51.100.1, 192.0.2.10"),
+			want: "ip:192.0.2.10",
+		},
+		{
+			name: "Success - Loopback peer without X-Forwarded-For",
+			peer: gatewayPeer,
+			want: "ip:127.0.0.1",
+		},
+		{
+			name: "Success - No peer",
+			want: "ip:",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := metadata.NewIncomingContext(context.Background(), tt.md)
+			if tt.peer != nil {
+				ctx = peer.NewContext(ctx, tt.peer)
+			}
+			if tt.principal != nil {
+				ctx = auth.NewContext(ctx, *tt.principal)
+			}
+			if got := clientIdentity(ctx); got != tt.want {
+				t.Errorf("clientIdentity() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
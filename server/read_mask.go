@@ -0,0 +1,127 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"hotelsDataMerge/proto"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+const (
+	readMaskField   = "read_mask"
+	fieldsQueryName = "fields"
+)
+
+// maskTree holds the requested sub-fields of every selected field; a nil subtree selects the whole field
+type maskTree map[protoreflect.Name]maskTree
+
+// parseReadMask validates the read mask paths against the Hotel message, accepting both proto
+// (booking_conditions) and JSON (bookingConditions) field names. It returns nil when every field is selected.
+func parseReadMask(mask *fieldmaskpb.FieldMask) (maskTree, error) {
+	if len(mask.GetPaths()) == 0 {
+		return nil, nil
+	}
+
+	tree := make(maskTree)
+	var violations []*errdetails.BadRequest_FieldViolation
+	for _, path := range mask.GetPaths() {
+		path = strings.TrimSpace(path)
+		if err := tree.add((&proto.Hotel{}).ProtoReflect().Descriptor(), path); err != nil {
+			violations = append(violations, &errdetails.BadRequest_FieldViolation{
+				Field:       readMaskField,
+				Description: err.Error(),
+			})
+		}
+	}
+	if len(violations) > 0 {
+		return nil, newInvalidArgumentError("invalid read mask", violations...)
+	}
+	return tree, nil
+}
+
+func (t maskTree) add(message protoreflect.MessageDescriptor, path string) error {
+	node := t
+	segments := strings.Split(path, ".")
+	for index, segment := range segments {
+		if message == nil {
+			return fmt.Errorf("field path %q: %q has no sub-fields", path, segments[index-1])
+		}
+		field := message.Fields().ByName(protoreflect.Name(segment))
+		if field == nil {
+			field = message.Fields().ByJSONName(segment)
+		}
+		if field == nil {
+			return fmt.Errorf("field path %q: %s has no field %q", path, message.Name(), segment)
+		}
+
+		subtree, selected := node[field.Name()]
+		if selected && subtree == nil {
+			// The whole field is already selected
+			return nil
+		}
+		if index == len(segments)-1 {
+			node[field.Name()] = nil
+			return nil
+		}
+		if subtree == nil {
+			subtree = make(maskTree)
+			node[field.Name()] = subtree
+		}
+		node = subtree
+		message = field.Message()
+	}
+	return nil
+}
+
+// prune clears every field of the message that is not selected by the tree
+func (t maskTree) prune(message protoreflect.Message) {
+	var unselected []protoreflect.FieldDescriptor
+	message.Range(func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		subtree, selected := t[field.Name()]
+		switch {
+		case !selected:
+			unselected = append(unselected, field)
+		case subtree == nil:
+		case field.IsList():
+			list := value.List()
+			for i := 0; i < list.Len(); i++ {
+				subtree.prune(list.Get(i).Message())
+			}
+		default:
+			subtree.prune(value.Message())
+		}
+		return true
+	})
+	for _, field := range unselected {
+		message.Clear(field)
+	}
+}
+
+// applyReadMask prunes every hotel of the response down to the fields selected by the tree
+func applyReadMask(resp *proto.GetHotelsResponse, tree maskTree) {
+	if resp == nil || tree == nil {
+		return
+	}
+	for _, hotel := range resp.Hotels {
+		tree.prune(hotel.ProtoReflect())
+	}
+}
+
+// FieldsQueryParam lets REST clients select fields with "?fields=id,name" by rewriting
+// the parameter to the read_mask query parameter understood by the gateway
+func FieldsQueryParam(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if fields, ok := query[fieldsQueryName]; ok && !query.Has(readMaskField) {
+			query.Del(fieldsQueryName)
+			query.Set(readMaskField, strings.Join(fields, ","))
+			r.URL.RawQuery = query.Encode()
+		}
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,135 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"hotelsDataMerge/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	protobuf "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func Test_parseReadMask(t *testing.T) {
+	tests := []struct {
+		name     string
+		mask     *fieldmaskpb.FieldMask
+		wantNil  bool
+		wantCode codes.Code
+	}{
+		{name: "Success - No mask", mask: nil, wantNil: true},
+		{name: "Success - Empty mask", mask: &fieldmaskpb.FieldMask{}, wantNil: true},
+		{name: "Success - Proto field names", mask: &fieldmaskpb.FieldMask{Paths: []string{"id", "booking_conditions", "images.rooms"}}},
+		{name: "Success - JSON field names", mask: &fieldmaskpb.FieldMask{Paths: []string{"bookingConditions", "bookingPolicy.checkInFrom"}}},
+		{name: "Error - Unknown field", mask: &fieldmaskpb.FieldMask{Paths: []string{"id", "rating"}}, wantNil: true, wantCode: codes.InvalidArgument},
+		{name: "Error - Unknown sub-field", mask: &fieldmaskpb.FieldMask{Paths: []string{"location.zip"}}, wantNil: true, wantCode: codes.InvalidArgument},
+		{name: "Error - Sub-field of a scalar", mask: &fieldmaskpb.FieldMask{Paths: []string{"name.first"}}, wantNil: true, wantCode: codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseReadMask(tt.mask)
+			if code := status.Code(err); code != tt.wantCode {
+				t.Errorf("parseReadMask() code = %v, wantCode %v", code, tt.wantCode)
+			}
+			if (got == nil) != tt.wantNil {
+				t.Errorf("parseReadMask() = %v, wantNil %v", got, tt.wantNil)
+			}
+		})
+	}
+}
+
+func Test_applyReadMask(t *testing.T) {
+	newResponse := func() *proto.GetHotelsResponse {
+		return &proto.GetHotelsResponse{
+			Hotels: []*proto.Hotel{
+				{
+					Id:          "iJhz",
+					Name:        "Beach Villas Singapore",
+					Description: "Surrounded by tropical gardens",
+					Location:    &proto.Location{City: "Singapore", Country: "SG"},
+					Images: &proto.Image{
+						Rooms: []*proto.Room{{Link: "https://example.com/room.jpg", Description: "Double room"}},
+						Site:  []*proto.Site{{Link: "https://example.com/site.jpg", Description: "Front"}},
+					},
+					BookingConditions: []string{"Pets are not allowed."},
+				},
+			},
+		}
+	}
+	tests := []struct {
+		name  string
+		paths []string
+		want  *proto.GetHotelsResponse
+	}{
+		{
+			name:  "Success - No mask returns every field",
+			paths: nil,
+			want:  newResponse(),
+		},
+		{
+			name:  "Success - Id, name and room images",
+			paths: []string{"id", "name", "images.rooms.link"},
+			want: &proto.GetHotelsResponse{
+				Hotels: []*proto.Hotel{
+					{
+						Id:   "iJhz",
+						Name: "Beach Villas Singapore",
+						Images: &proto.Image{
+							Rooms: []*proto.Room{{Link: "https://example.com/room.jpg"}},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:  "Success - Whole field wins over sub-field",
+			paths: []string{"location.city", "location"},
+			want: &proto.GetHotelsResponse{
+				Hotels: []*proto.Hotel{
+					{Location: &proto.Location{City: "Singapore", Country: "SG"}},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tree, err := parseReadMask(&fieldmaskpb.FieldMask{Paths: tt.paths})
+			if err != nil {
+				t.Fatalf("parseReadMask() error = %v", err)
+			}
+			got := newResponse()
+			applyReadMask(got, tree)
+			if !protobuf.Equal(got, tt.want) {
+				t.Errorf("applyReadMask() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFieldsQueryParam(t *testing.T) {
+	tests := []struct {
+		name      string
+		target    string
+		wantQuery string
+	}{
+		{name: "Success - Rewrite fields", target: "/v1/hotels?destinationId=5432&fields=id,name", wantQuery: "destinationId=5432&read_mask=id%2Cname"},
+		{name: "Success - Repeated fields", target: "/v1/hotels?fields=id&fields=name", wantQuery: "read_mask=id%2Cname"},
+		{name: "Success - read_mask takes precedence", target: "/v1/hotels?fields=id&read_mask=name", wantQuery: "fields=id&read_mask=name"},
+		{name: "Success - No fields", target: "/v1/hotels?destinationId=5432", wantQuery: "destinationId=5432"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotQuery string
+			handler := FieldsQueryParam(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotQuery = r.URL.RawQuery
+			}))
+			handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, tt.target, nil))
+			if gotQuery != tt.wantQuery {
+				t.Errorf("FieldsQueryParam() query = %v, want %v", gotQuery, tt.wantQuery)
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package server
+
+import (
+	"context"
+	"log/slog"
+	"runtime/debug"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var errInternal = status.Error(codes.Internal, "internal error")
+
+// RecoveryUnaryInterceptor turns a panic in a handler into an INTERNAL error instead of crashing the process
+func RecoveryUnaryInterceptor(logger *slog.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				logPanic(ctx, logger, info.FullMethod, r)
+				resp, err = nil, errInternal
+			}
+		}()
+		return handler(ctx, req)
+	}
+}
+
+// RecoveryStreamInterceptor is the streaming counterpart of RecoveryUnaryInterceptor
+func RecoveryStreamInterceptor(logger *slog.Logger) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				logPanic(ss.Context(), logger, info.FullMethod, r)
+				err = errInternal
+			}
+		}()
+		return handler(srv, ss)
+	}
+}
+
+func logPanic(ctx context.Context, logger *slog.Logger, fullMethod string, r any) {
+	logger.ErrorContext(ctx, "[gRPC] Recovered from panic",
+		"method", fullMethod,
+		"panic", r,
+		"stack", string(debug.Stack()),
+	)
+}
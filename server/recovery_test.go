@@ -0,0 +1,80 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRecoveryUnaryInterceptor(t *testing.T) {
+	tests := []struct {
+		name       string
+		handler    grpc.UnaryHandler
+		want       any
+		wantCode   codes.Code
+		wantLogged bool
+	}{
+		{
+			name:     "Success - Handler returns normally",
+			handler:  func(ctx context.Context, req any) (any, error) { return "ok", nil },
+			want:     "ok",
+			wantCode: codes.OK,
+		},
+		{
+			name:     "Success - Handler error is kept",
+			handler:  func(ctx context.Context, req any) (any, error) { return nil, status.Error(codes.NotFound, "missing") },
+			want:     nil,
+			wantCode: codes.NotFound,
+		},
+		{
+			name: "Error - Panic becomes INTERNAL",
+			handler: func(ctx context.Context, req any) (any, error) {
+				var hotels map[string]string
+				hotels["iJhz"] = "boom"
+				return "unreachable", nil
+			},
+			want:       nil,
+			wantCode:   codes.Internal,
+			wantLogged: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			logger := slog.New(slog.NewTextHandler(&buf, nil))
+
+			got, err := RecoveryUnaryInterceptor(logger)(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/proto.HotelDataMerge/GetHotel"}, tt.handler)
+			if code := status.Code(err); code != tt.wantCode {
+				t.Errorf("RecoveryUnaryInterceptor() code = %v, wantCode %v", code, tt.wantCode)
+			}
+			if got != tt.want {
+				t.Errorf("RecoveryUnaryInterceptor() = %v, want %v", got, tt.want)
+			}
+			if logged := strings.Contains(buf.String(), "Recovered from panic"); logged != tt.wantLogged {
+				t.Errorf("RecoveryUnaryInterceptor() logged panic = %v, want %v", logged, tt.wantLogged)
+			}
+		})
+	}
+}
+
+type mockServerStream struct {
+	grpc.ServerStream
+}
+
+func (m *mockServerStream) Context() context.Context { return context.Background() }
+
+func TestRecoveryStreamInterceptor(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(&bytes.Buffer{}, nil))
+	handler := func(srv any, stream grpc.ServerStream) error { panic("boom") }
+
+	err := RecoveryStreamInterceptor(logger)(nil, &mockServerStream{}, &grpc.StreamServerInfo{FullMethod: "/proto.HotelDataMerge/Watch"}, handler)
+	if code := status.Code(err); code != codes.Internal {
+		t.Errorf("RecoveryStreamInterceptor() code = %v, wantCode %v", code, codes.Internal)
+	}
+}
@@ -0,0 +1,113 @@
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"log/slog"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	requestIDHeader = "x-request-id"
+	// maxRequestIDLength bounds the request IDs accepted from clients, which end up in every log line
+	maxRequestIDLength = 128
+)
+
+type requestIDKey struct{}
+
+// RequestIDFromContext returns the ID of the request being served, if any
+func RequestIDFromContext(ctx context.Context) (string, bool) {
+	requestID, ok := ctx.Value(requestIDKey{}).(string)
+	return requestID, ok
+}
+
+// RequestIDUnaryInterceptor reuses the x-request-id sent by the client, or generates one, stores it
+// in the context and returns it in the x-request-id response header
+func RequestIDUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		requestID := incomingRequestID(ctx)
+		_ = grpc.SetHeader(ctx, metadata.Pairs(requestIDHeader, requestID))
+		return handler(context.WithValue(ctx, requestIDKey{}, requestID), req)
+	}
+}
+
+// RequestIDStreamInterceptor is the streaming counterpart of RequestIDUnaryInterceptor
+func RequestIDStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		requestID := incomingRequestID(ss.Context())
+		_ = ss.SetHeader(metadata.Pairs(requestIDHeader, requestID))
+		return handler(srv, &contextServerStream{
+			ServerStream: ss,
+			ctx:          context.WithValue(ss.Context(), requestIDKey{}, requestID),
+		})
+	}
+}
+
+func incomingRequestID(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, requestID := range md.Get(requestIDHeader) {
+		if validRequestID(requestID) {
+			return requestID
+		}
+	}
+	return newRequestID()
+}
+
+// validRequestID accepts non-empty printable ASCII IDs of bounded length, so that
+// client-sent IDs cannot inject lines or control characters into the logs
+func validRequestID(requestID string) bool {
+	if len(requestID) == 0 || len(requestID) > maxRequestIDLength {
+		return false
+	}
+	for _, r := range requestID {
+		if r < '!' || r > '~' {
+			return false
+		}
+	}
+	return true
+}
+
+func newRequestID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// contextServerStream overrides the context of a server stream
+type contextServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextServerStream) Context() context.Context {
+	return s.ctx
+}
+
+// requestIDLogHandler adds the request ID, when there is one, to every record logged with a context
+type requestIDLogHandler struct {
+	slog.Handler
+}
+
+// NewRequestIDLogHandler wraps a slog handler so that log lines written while serving
+// a request carry its requestId
+func NewRequestIDLogHandler(handler slog.Handler) slog.Handler {
+	return &requestIDLogHandler{Handler: handler}
+}
+
+func (h *requestIDLogHandler) Handle(ctx context.Context, record slog.Record) error {
+	if requestID, ok := RequestIDFromContext(ctx); ok {
+		record.AddAttrs(slog.String("requestId", requestID))
+	}
+	return h.Handler.Handle(ctx, record)
+}
+
+func (h *requestIDLogHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &requestIDLogHandler{Handler: h.Handler.WithAttrs(attrs)}
+}
+
+func (h *requestIDLogHandler) WithGroup(name string) slog.Handler {
+	return &requestIDLogHandler{Handler: h.Handler.WithGroup(name)}
+}
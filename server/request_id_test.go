@@ -0,0 +1,90 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestRequestIDUnaryInterceptor(t *testing.T) {
+	tests := []struct {
+		name          string
+		incoming      []string
+		wantRequestID string
+		wantGenerated bool
+	}{
+		{
+			name:          "Success - Propagate client request ID",
+			incoming:      []string{"req-123"},
+			wantRequestID: "req-123",
+		},
+		{
+			name:          "Success - Generate request ID when missing",
+			incoming:      nil,
+			wantGenerated: true,
+		},
+		{
+			name:          "Success - Replace request ID with control characters",
+			incoming:      []string{"req\nforged log line"},
+			wantGenerated: true,
+		},
+		{
+			name:          "Success - Replace request ID that is too long",
+			incoming:      []string{strings.Repeat("a", maxRequestIDLength+1)},
+			wantGenerated: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stream := &mockServerTransportStream{}
+			ctx := grpc.NewContextWithServerTransportStream(context.Background(), stream)
+			if len(tt.incoming) > 0 {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(requestIDHeader, tt.incoming[0]))
+			}
+
+			var handlerRequestID string
+			handler := func(ctx context.Context, req any) (any, error) {
+				handlerRequestID, _ = RequestIDFromContext(ctx)
+				return nil, nil
+			}
+			if _, err := RequestIDUnaryInterceptor()(ctx, nil, &grpc.UnaryServerInfo{}, handler); err != nil {
+				t.Fatalf("RequestIDUnaryInterceptor() error = %v", err)
+			}
+
+			if tt.wantGenerated {
+				if len(handlerRequestID) != 32 || handlerRequestID == strings.Join(tt.incoming, "") {
+					t.Errorf("RequestIDUnaryInterceptor() request ID = %q, want a generated ID", handlerRequestID)
+				}
+			} else if handlerRequestID != tt.wantRequestID {
+				t.Errorf("RequestIDUnaryInterceptor() request ID = %q, want %q", handlerRequestID, tt.wantRequestID)
+			}
+			if got := stream.header.Get(requestIDHeader); len(got) != 1 || got[0] != handlerRequestID {
+				t.Errorf("RequestIDUnaryInterceptor() header = %v, want %q", got, handlerRequestID)
+			}
+		})
+	}
+}
+
+func TestNewRequestIDLogHandler(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(NewRequestIDLogHandler(slog.NewTextHandler(&buf, nil))).With("component", "test")
+
+	logger.InfoContext(context.WithValue(context.Background(), requestIDKey{}, "req-123"), "with request")
+	logger.InfoContext(context.Background(), "without request")
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("logged %d lines, want 2", len(lines))
+	}
+	if !strings.Contains(lines[0], "requestId=req-123") || !strings.Contains(lines[0], "component=test") {
+		t.Errorf("log line = %q, want requestId and component", lines[0])
+	}
+	if strings.Contains(lines[1], "requestId") {
+		t.Errorf("log line = %q, want no requestId", lines[1])
+	}
+}